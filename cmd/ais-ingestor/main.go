@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	_ "github.com/joho/godotenv/autoload"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/ais"
+)
+
+func main() {
+	tcpAddress := flag.String("tcp", "", "address to accept AIS NMEA streams on over TCP, e.g. :10110")
+	udpAddress := flag.String("udp", "", "address to receive AIS NMEA datagrams on over UDP, e.g. :10110")
+	replayPath := flag.String("replay", "", "raw NMEA or NDJSON file to replay and exit, - reads stdin")
+	flag.Parse()
+
+	if *tcpAddress == "" && *udpAddress == "" && *replayPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	common := di.MustInitCommonServices(ctx)
+	vesselModule := di.NewVesselModule(ctx, common)
+	listener := ais.NewListener(vesselModule.AISIngestor.Ingest, common.Logger)
+
+	if *replayPath != "" {
+		if err := replay(ctx, listener, *replayPath); err != nil {
+			common.Logger.Fatal().Err(err).Str("ais.replay", *replayPath).Msg("error replaying ais feed")
+		}
+
+		common.Logger.Info().Str("ais.replay", *replayPath).Msg("ais feed replayed successfully")
+		return
+	}
+
+	wg := &sync.WaitGroup{}
+	listen := func(network, address string, listenFunc func(context.Context, string) error) {
+		if address == "" {
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			common.Logger.Info().Str("ais.network", network).Str("ais.address", address).Msg("starting ais listener")
+			if err := listenFunc(ctx, address); err != nil {
+				common.Logger.Fatal().Err(err).Str("ais.network", network).Msg("error listening ais")
+			}
+		}()
+	}
+
+	listen("tcp", *tcpAddress, listener.ListenTCP)
+	listen("udp", *udpAddress, listener.ListenUDP)
+
+	wg.Wait()
+	common.Logger.Info().Msg("ais ingestor stopped")
+}
+
+func replay(ctx context.Context, listener *ais.Listener, path string) error {
+	var feed io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+
+		feed = file
+	}
+
+	return listener.Consume(ctx, feed)
+}
@@ -8,6 +8,8 @@ import (
 	_ "github.com/joho/godotenv/autoload"
 
 	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	portcommands "github.com/soulcodex/deus-cargo-tracker/internal/port/application/commands"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
 )
 
 func main() {
@@ -16,7 +18,8 @@ func main() {
 
 	common := di.MustInitCommonServices(ctx)
 	_ = di.NewVesselModule(ctx, common) // for practical purposes only
-	_ = di.NewCargoModule(ctx, common)  // for practical purposes only
+	cargoModule := di.NewCargoModule(ctx, common)
+	_ = di.NewPortModule(ctx, common)
 
 	migrationsApplied, err := common.DBMigrator.Up()
 	if err != nil {
@@ -25,6 +28,13 @@ func main() {
 	}
 	common.Logger.Info().Int("count", migrationsApplied).Msg("database migrations applied successfully")
 
+	importErr := bus.DispatchBlocking(common.CommandBus, common.Mutex)(ctx, &portcommands.ImportPortsCommand{})
+	if importErr != nil {
+		common.Logger.Fatal().Err(importErr).Msg("failed to import ports registry")
+		panic(importErr)
+	}
+	common.Logger.Info().Msg("ports registry imported successfully")
+
 	go func() {
 		common.Logger.Info().
 			Str("http.host", common.Config.HTTPHost).
@@ -36,6 +46,8 @@ func main() {
 		}
 	}()
 
+	go cargoModule.DelayDetector.Run(ctx)
+
 	common.Logger.Info().Msg("cargo tracker HTTP started successfully")
 	<-ctx.Done()
 }
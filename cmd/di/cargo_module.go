@@ -13,25 +13,111 @@ import (
 )
 
 type CargoModule struct {
-	Repository cargodomain.CargoRepository
+	Repository    cargodomain.CargoRepository
+	DelayDetector *cargoentrypoint.CargoDelayDetectorWorker
 }
 
 func NewCargoModule(_ context.Context, common *CommonServices) *CargoModule {
-	cargoRepo := cargopersistence.NewPostgresCargoRepository(common.Config.PostgresSchema, common.DBPool)
-	createCargoHTTPHandler := cargoentrypoint.HandlePOSTCreateCargoV1HTTP(common.CommandBus, common.ResponseMiddleware)
-	fetchCargoByIDHTTPHandler := cargoentrypoint.HandleGETFetchCargoByIDV1HTTP(common.QueryBus, common.ResponseMiddleware)
-	updateCargoStatusHTTPHandler := cargoentrypoint.HandlePATCHUpdateCargoStatusV1HTTP(
+	statusTransitions, err := cargoinfra.LoadStatusTransitionsFromFile(common.Config.CargoStatusTransitionsPath)
+	if err != nil {
+		panic(err)
+	}
+
+	cargoRepo := cargopersistence.NewPostgresCargoRepository(common.Config.PostgresSchema, common.DBPool, statusTransitions)
+	cargoTrackingReader := cargopersistence.NewPostgresCargoTrackingReader(common.Config.PostgresSchema, common.DBPool)
+
+	registerCargoHTTPRoutes(common)
+	registerCargoCommandHandlers(common, cargoRepo, statusTransitions)
+	registerCargoQueryHandlers(common, cargoRepo, cargoTrackingReader, statusTransitions)
+
+	return &CargoModule{
+		Repository: cargoRepo,
+		DelayDetector: cargoentrypoint.NewCargoDelayDetectorWorker(
+			common.QueryBus,
+			common.CommandBus,
+			common.Mutex,
+			common.Logger,
+			common.Config.CargoDelayThreshold,
+			common.Config.CargoDelayCheckInterval,
+		),
+	}
+}
+
+func registerCargoHTTPRoutes(common *CommonServices) {
+	common.Router.Post("/cargoes", cargoentrypoint.HandlePOSTCreateCargoV1HTTP(
 		common.CommandBus,
 		common.Mutex,
 		common.ResponseMiddleware,
-	)
-	cargoVesselChecker := cargoinfra.NewQueryBusVesselChecker(common.QueryBus)
-	cargoCreator := cargodomain.NewCargoCreator(cargoRepo, cargoVesselChecker, common.ULIDProvider)
-	cargoUpdater := cargodomain.NewCargoUpdater(cargoRepo, common.EventPublisher)
+	))
+	common.Router.Post("/cargoes:batch", cargoentrypoint.HandlePOSTCreateCargoesBatchV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Get("/cargoes", cargoentrypoint.HandleGETSearchCargoesV1HTTP(common.QueryBus, common.ResponseMiddleware))
+	common.Router.Get("/cargoes/{cargo_id}", cargoentrypoint.HandleGETFetchCargoByIDV1HTTP(common.QueryBus, common.ResponseMiddleware))
+	common.Router.Get("/cargoes/{cargo_id}/tracking", cargoentrypoint.HandleGETSearchCargoTrackingV1HTTP(
+		common.QueryBus,
+		common.ResponseMiddleware,
+	))
+	common.Router.Patch("/cargoes/{cargo_id}/update-status", cargoentrypoint.HandlePATCHUpdateCargoStatusV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Patch("/cargoes/{cargo_id}/vessel", cargoentrypoint.HandlePATCHUpdateCargoVesselV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Patch("/cargoes/{cargo_id}/items", cargoentrypoint.HandlePATCHUpdateCargoItemsV1HTTP(
+		common.QueryBus,
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Patch("/cargoes/{cargo_id}/metadata", cargoentrypoint.HandlePATCHUpdateCargoMetadataV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Post("/cargoes/{cargo_id}/split", cargoentrypoint.HandlePOSTSplitCargoV1HTTP(
+		common.QueryBus,
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Post("/cargoes/{cargo_id}/merge", cargoentrypoint.HandlePOSTMergeCargoesV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Patch("/cargoes/{cargo_id}/cancel", cargoentrypoint.HandlePATCHCancelCargoV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Delete("/cargoes/{cargo_id}", cargoentrypoint.HandleDELETEDeleteCargoV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Get("/cargo-statuses", cargoentrypoint.HandleGETFetchCargoStatusesV1HTTP(common.QueryBus, common.ResponseMiddleware))
+}
 
-	common.Router.Post("/cargoes", createCargoHTTPHandler)
-	common.Router.Get("/cargoes/{cargo_id}", fetchCargoByIDHTTPHandler)
-	common.Router.Patch("/cargoes/{cargo_id}/update-status", updateCargoStatusHTTPHandler)
+func registerCargoCommandHandlers(
+	common *CommonServices,
+	cargoRepo cargodomain.CargoRepository,
+	statusTransitions cargodomain.StatusTransitions,
+) {
+	cargoVesselChecker := cargoinfra.NewCachingVesselChecker(cargoinfra.NewQueryBusVesselChecker(common.QueryBus))
+	cargoCreator := cargodomain.NewCargoCreator(cargoRepo, cargoVesselChecker, common.ULIDProvider, common.EventPublisher)
+	cargoBatchCreator := cargodomain.NewCargoBatchCreator(cargoCreator)
+	cargoUpdater := cargodomain.NewCargoUpdater(cargoRepo, cargoVesselChecker, common.EventPublisher, common.Logger)
+	cargoVesselReassigner := cargodomain.NewCargoVesselReassigner(cargoRepo, cargoVesselChecker, cargoUpdater)
+	cargoItemsAmender := cargodomain.NewCargoItemsAmender(cargoRepo, cargoVesselChecker, cargoUpdater)
+	cargoSplitter := cargodomain.NewCargoSplitter(cargoRepo, cargoVesselChecker, common.ULIDProvider, cargoUpdater)
+	cargoMerger := cargodomain.NewCargoMerger(cargoRepo, common.ULIDProvider, cargoUpdater)
 
 	bus.MustRegister(
 		common.CommandBus,
@@ -39,19 +125,111 @@ func NewCargoModule(_ context.Context, common *CommonServices) *CargoModule {
 		cargocommands.NewCreateCargoCommandHandler(cargoCreator, common.TimeProvider),
 	)
 
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.CreateCargoesBatchCommand{},
+		cargocommands.NewCreateCargoesBatchCommandHandler(cargoBatchCreator, common.TimeProvider),
+	)
+
 	bus.MustRegister(
 		common.CommandBus,
 		&cargocommands.UpdateCargoStatusCommand{},
-		cargocommands.NewUpdateCargoStatusCommandHandler(cargoUpdater, common.TimeProvider, common.ULIDProvider),
+		cargocommands.NewUpdateCargoStatusCommandHandler(
+			cargoUpdater,
+			statusTransitions,
+			common.TimeProvider,
+			common.ULIDProvider,
+		),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.UpdateCargoVesselCommand{},
+		cargocommands.NewUpdateCargoVesselCommandHandler(cargoVesselReassigner, common.TimeProvider, common.ULIDProvider),
 	)
 
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.UpdateCargoItemsCommand{},
+		cargocommands.NewUpdateCargoItemsCommandHandler(cargoItemsAmender, common.TimeProvider, common.ULIDProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.SplitCargoCommand{},
+		cargocommands.NewSplitCargoCommandHandler(cargoSplitter, common.TimeProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.MergeCargoesCommand{},
+		cargocommands.NewMergeCargoesCommandHandler(cargoMerger, common.TimeProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.UpdateCargoMetadataCommand{},
+		cargocommands.NewUpdateCargoMetadataCommandHandler(cargoUpdater, common.TimeProvider, common.ULIDProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.CancelCargoCommand{},
+		cargocommands.NewCancelCargoCommandHandler(cargoUpdater, statusTransitions, common.TimeProvider, common.ULIDProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.DeleteCargoCommand{},
+		cargocommands.NewDeleteCargoCommandHandler(cargoUpdater, statusTransitions, common.TimeProvider, common.ULIDProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&cargocommands.DetectCargoDelayCommand{},
+		cargocommands.NewDetectCargoDelayCommandHandler(cargoUpdater, common.TimeProvider, common.ULIDProvider),
+	)
+}
+
+func registerCargoQueryHandlers(
+	common *CommonServices,
+	cargoRepo cargodomain.CargoRepository,
+	cargoTrackingReader cargodomain.CargoTrackingReader,
+	statusTransitions cargodomain.StatusTransitions,
+) {
 	bus.MustRegister(
 		common.QueryBus,
 		&cargoqueries.FetchCargoByID{},
 		cargoqueries.NewFetchCargoByIDHandler(cargoRepo),
 	)
 
-	return &CargoModule{
-		Repository: cargoRepo,
-	}
+	bus.MustRegister(
+		common.QueryBus,
+		&cargoqueries.SearchCargoes{},
+		cargoqueries.NewSearchCargoesHandler(cargoRepo),
+	)
+
+	bus.MustRegister(
+		common.QueryBus,
+		&cargoqueries.SearchCargoTracking{},
+		cargoqueries.NewSearchCargoTrackingHandler(cargoRepo, cargoTrackingReader),
+	)
+
+	bus.MustRegister(
+		common.QueryBus,
+		&cargoqueries.SearchStalledCargoes{},
+		cargoqueries.NewSearchStalledCargoesHandler(cargoRepo, common.TimeProvider),
+	)
+
+	bus.MustRegister(
+		common.QueryBus,
+		&cargoqueries.FetchCargoStatuses{},
+		cargoqueries.NewFetchCargoStatusesHandler(statusTransitions),
+	)
+
+	bus.MustRegister(
+		common.QueryBus,
+		&cargoqueries.FetchVesselCargoWeight{},
+		cargoqueries.NewFetchVesselCargoWeightHandler(cargoRepo),
+	)
 }
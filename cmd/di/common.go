@@ -68,6 +68,12 @@ func MustInitCommonServices(ctx context.Context) *CommonServices {
 	uuidProvider := utils.NewRandomUUIDProvider()
 	ulidProvider := utils.NewRandomULIDProvider()
 	responseMiddleware := httpserver.NewJSONAPIResponseMiddleware(appLogger)
+	router.Use(httpserver.NewIdempotencyKeyMiddleware(
+		httpserver.NewRedisIdempotencyStore(redisClient),
+		mutexService,
+		cfg.HTTPIdempotencyKeyTTL,
+		responseMiddleware,
+	).Middleware)
 
 	rabbitMQConnection := initRabbitMQConnection(cfg)
 	eventPublisher := initEventPublisher(rabbitMQConnection, cfg)
@@ -0,0 +1,50 @@
+package di
+
+import (
+	"context"
+
+	portcommands "github.com/soulcodex/deus-cargo-tracker/internal/port/application/commands"
+	portqueries "github.com/soulcodex/deus-cargo-tracker/internal/port/application/queries"
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	portinfra "github.com/soulcodex/deus-cargo-tracker/internal/port/infrastructure"
+	portentrypoint "github.com/soulcodex/deus-cargo-tracker/internal/port/infrastructure/entrypoint"
+	portpersistence "github.com/soulcodex/deus-cargo-tracker/internal/port/infrastructure/persistence"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+)
+
+type PortModule struct {
+	Repository portdomain.PortRepository
+}
+
+func NewPortModule(_ context.Context, common *CommonServices) *PortModule {
+	registry, err := portinfra.LoadPortsFromFiles(
+		common.Config.PortUNLOCODEPath,
+		common.Config.PortTimeZonesPath,
+		common.Logger,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	portRepo := portpersistence.NewPostgresPortRepository(common.Config.PostgresSchema, common.DBPool)
+
+	registerPortHTTPRoutes(common)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&portcommands.ImportPortsCommand{},
+		portcommands.NewImportPortsCommandHandler(portRepo, registry),
+	)
+
+	bus.MustRegister(common.QueryBus, &portqueries.FetchPortByLOCODEQuery{}, portqueries.NewFetchPortByLOCODEQueryHandler(portRepo))
+	bus.MustRegister(common.QueryBus, &portqueries.SearchPortsQuery{}, portqueries.NewSearchPortsQueryHandler(portRepo))
+
+	return &PortModule{
+		Repository: portRepo,
+	}
+}
+
+func registerPortHTTPRoutes(common *CommonServices) {
+	common.Router.Get("/ports", portentrypoint.HandleGETSearchPortsV1HTTP(common.QueryBus, common.ResponseMiddleware))
+	common.Router.Get("/ports/{locode}", portentrypoint.HandleGETFetchPortByLOCODEV1HTTP(common.QueryBus, common.ResponseMiddleware))
+}
@@ -3,20 +3,61 @@ package di
 import (
 	"context"
 
+	vesselcommands "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/commands"
 	vesselqueries "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/queries"
 	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselinfra "github.com/soulcodex/deus-cargo-tracker/internal/vessel/infrastructure"
 	vesselentrypoint "github.com/soulcodex/deus-cargo-tracker/internal/vessel/infrastructure/entrypoint"
 	vesselpersistence "github.com/soulcodex/deus-cargo-tracker/internal/vessel/infrastructure/persistence"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
 )
 
 type VesselModule struct {
-	Repository vesseldomain.VesselRepository
+	Repository  vesseldomain.VesselRepository
+	TrackReader vesseldomain.VesselTrackReader
+	AISIngestor *vesselentrypoint.AISPositionIngestor
 }
 
 func NewVesselModule(_ context.Context, common *CommonServices) *VesselModule {
 	vesselRepo := vesselpersistence.NewPostgresVesselRepository(common.Config.PostgresSchema, common.DBPool)
 
+	vesselTrackReader := vesselpersistence.NewPostgresVesselTrackReader(common.Config.PostgresSchema, common.DBPool)
+
+	registerVesselHTTPRoutes(common)
+	registerVesselCommandHandlers(common, vesselRepo)
+
+	fetchVesselByIDHandler := vesselqueries.NewFetchVesselByIDQueryHandler(vesselRepo)
+	fetchVesselByMMSIHandler := vesselqueries.NewFetchVesselByMMSIQueryHandler(vesselRepo)
+	fetchVesselTrackHandler := vesselqueries.NewFetchVesselTrackQueryHandler(vesselRepo, vesselTrackReader, common.TimeProvider)
+	searchVesselsHandler := vesselqueries.NewSearchVesselsQueryHandler(vesselRepo)
+
+	bus.MustRegister(common.QueryBus, &vesselqueries.FetchVesselByIDQuery{}, fetchVesselByIDHandler)
+	bus.MustRegister(common.QueryBus, &vesselqueries.FetchVesselByMMSIQuery{}, fetchVesselByMMSIHandler)
+	bus.MustRegister(common.QueryBus, &vesselqueries.FetchVesselTrackQuery{}, fetchVesselTrackHandler)
+	bus.MustRegister(common.QueryBus, &vesselqueries.SearchVesselsQuery{}, searchVesselsHandler)
+
+	return &VesselModule{
+		Repository:  vesselRepo,
+		TrackReader: vesselTrackReader,
+		AISIngestor: vesselentrypoint.NewAISPositionIngestor(
+			common.QueryBus,
+			common.CommandBus,
+			common.Mutex,
+			common.Logger,
+		),
+	}
+}
+
+func registerVesselHTTPRoutes(common *CommonServices) {
+	common.Router.Post("/vessels", vesselentrypoint.HandlePOSTCreateVesselV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Get("/vessels", vesselentrypoint.HandleGETSearchVesselsV1HTTP(
+		common.QueryBus,
+		common.ResponseMiddleware,
+	))
 	common.Router.Get(
 		"/vessels/{vessel_id}",
 		vesselentrypoint.HandleGETFetchVesselByIDV1HTTP(
@@ -24,12 +65,54 @@ func NewVesselModule(_ context.Context, common *CommonServices) *VesselModule {
 			common.ResponseMiddleware,
 		),
 	)
+	common.Router.Patch("/vessels/{vessel_id}", vesselentrypoint.HandlePATCHUpdateVesselV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Delete("/vessels/{vessel_id}", vesselentrypoint.HandleDELETEDeleteVesselV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Post("/vessels/{vessel_id}/positions", vesselentrypoint.HandlePOSTReportVesselPositionV1HTTP(
+		common.CommandBus,
+		common.Mutex,
+		common.ResponseMiddleware,
+	))
+	common.Router.Get("/vessels/{vessel_id}/track", vesselentrypoint.HandleGETFetchVesselTrackV1HTTP(
+		common.QueryBus,
+		common.ResponseMiddleware,
+	))
+}
 
-	fetchVesselByIDHandler := vesselqueries.NewFetchVesselByIDQueryHandler(vesselRepo)
+func registerVesselCommandHandlers(common *CommonServices, vesselRepo vesseldomain.VesselRepository) {
+	vesselCreator := vesseldomain.NewVesselCreator(vesselRepo)
+	cargoChecker := vesselinfra.NewQueryBusCargoChecker(common.QueryBus)
+	vesselUpdater := vesseldomain.NewVesselUpdater(vesselRepo, cargoChecker)
+	vesselDeleter := vesseldomain.NewVesselDeleter(vesselUpdater, cargoChecker)
 
-	bus.MustRegister(common.QueryBus, &vesselqueries.FetchVesselByIDQuery{}, fetchVesselByIDHandler)
+	bus.MustRegister(
+		common.CommandBus,
+		&vesselcommands.CreateVesselCommand{},
+		vesselcommands.NewCreateVesselCommandHandler(vesselCreator, common.TimeProvider),
+	)
 
-	return &VesselModule{
-		Repository: vesselRepo,
-	}
+	bus.MustRegister(
+		common.CommandBus,
+		&vesselcommands.UpdateVesselCommand{},
+		vesselcommands.NewUpdateVesselCommandHandler(vesselUpdater, common.TimeProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&vesselcommands.ReportVesselPositionCommand{},
+		vesselcommands.NewReportVesselPositionCommandHandler(vesselUpdater, common.TimeProvider, common.ULIDProvider),
+	)
+
+	bus.MustRegister(
+		common.CommandBus,
+		&vesselcommands.DeleteVesselCommand{},
+		vesselcommands.NewDeleteVesselCommandHandler(vesselDeleter, common.TimeProvider),
+	)
 }
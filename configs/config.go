@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
@@ -41,6 +42,27 @@ type HTTPConfig struct {
 	HTTPPort         int    `env:"PORT" envDefault:"8080"`
 	HTTPReadTimeout  int    `env:"READ_TIMEOUT" envDefault:"30"`
 	HTTPWriteTimeout int    `env:"WRITE_TIMEOUT" envDefault:"30"`
+	// HTTPIdempotencyKeyTTL is how long the response to a request carrying an Idempotency-Key
+	// header is replayed to its retries.
+	HTTPIdempotencyKeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+}
+
+type CargoConfig struct {
+	// CargoStatusTransitionsPath points to a JSON file describing the cargo status state machine,
+	// the built-in transitions are used when it's empty.
+	CargoStatusTransitionsPath string `env:"STATUS_TRANSITIONS_PATH" envDefault:""`
+	// CargoDelayThreshold is how long a cargo can stay in transit without a status change before
+	// its delay is detected, checked every CargoDelayCheckInterval.
+	CargoDelayThreshold     time.Duration `env:"DELAY_THRESHOLD" envDefault:"72h"`
+	CargoDelayCheckInterval time.Duration `env:"DELAY_CHECK_INTERVAL" envDefault:"5m"`
+}
+
+type PortConfig struct {
+	// PortUNLOCODEPath points to a UN/LOCODE CSV file, laid out as the official headerless code list,
+	// the port registry is imported from, and PortTimeZonesPath to a locode,time_zone CSV file with the
+	// time zone of its ports. The bundled ones are used when they're empty.
+	PortUNLOCODEPath  string `env:"UNLOCODE_PATH" envDefault:""`
+	PortTimeZonesPath string `env:"TIME_ZONES_PATH" envDefault:""`
 }
 
 type UncategorizedConfig struct {
@@ -54,6 +76,8 @@ type Config struct {
 	PostgresConfig      `envPrefix:"POSTGRES_"`
 	DBMigrationsConfig  `envPrefix:"MIGRATIONS_"`
 	RabbitMQConfig      `envPrefix:"RABBITMQ_"`
+	CargoConfig         `envPrefix:"CARGO_"`
+	PortConfig          `envPrefix:"PORT_"`
 	UncategorizedConfig `envPrefix:""`
 }
 
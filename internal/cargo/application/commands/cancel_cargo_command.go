@@ -0,0 +1,65 @@
+package cargocommands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type CancelCargoCommand struct {
+	ID     string
+	Reason string
+	// ExpectedVersion is the version the cargo must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *CancelCargoCommand) Type() string {
+	return "cancel_cargo_command"
+}
+
+func (c *CancelCargoCommand) BlockingKey() string {
+	return "cargo_update:" + c.ID
+}
+
+type CancelCargoCommandHandler struct {
+	updater      *cargodomain.CargoUpdater
+	transitions  cargodomain.StatusTransitions
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewCancelCargoCommandHandler(
+	updater *cargodomain.CargoUpdater,
+	transitions cargodomain.StatusTransitions,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *CancelCargoCommandHandler {
+	return &CancelCargoCommandHandler{
+		updater:      updater,
+		transitions:  transitions,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *CancelCargoCommandHandler) Handle(ctx context.Context, cmd *CancelCargoCommand) (interface{}, error) {
+	trackingID, at := h.idProvider.New().String(), h.timeProvider.Now()
+
+	updates := []cargodomain.CargoUpdateOpt{
+		cargodomain.WithExpectedVersion(cmd.ExpectedVersion),
+		cargodomain.WithCancellation(h.transitions, trackingID, cmd.Reason, at),
+	}
+
+	if err := h.updater.Update(ctx, cmd.ID, updates...); err != nil {
+		if errors.Is(err, cargodomain.ErrStatusUnchanged) {
+			return struct{}{}, nil
+		}
+
+		return nil, fmt.Errorf("error cancelling cargo: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -3,24 +3,49 @@ package cargocommands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
 )
 
+type CargoParty struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Address string `json:"address"`
+}
+
 type CreateCargoCommand struct {
 	ID       string
 	VesselID string
 	Items    []struct {
 		Name   string `json:"name"`
-		Weight uint64 `json:"weight"`
+		Weight struct {
+			Value float64 `json:"value"`
+			Unit  string  `json:"unit"`
+		} `json:"weight"`
+		UNNumber    string `json:"un_number"`
+		HazardClass string `json:"hazard_class"`
+		Length      uint64 `json:"length"`
+		Width       uint64 `json:"width"`
+		Height      uint64 `json:"height"`
 	}
+	Shipper   *CargoParty
+	Consignee *CargoParty
+	Metadata  map[string]string
 }
 
 func (c *CreateCargoCommand) Type() string {
 	return "create_cargo_command"
 }
 
+// BlockingKey serializes cargo creations by vessel so the capacity check
+// can't be bypassed by concurrent requests loading the same vessel.
+func (c *CreateCargoCommand) BlockingKey() string {
+	return "vessel_load:" + c.VesselID
+}
+
 type CreateCargoCommandHandler struct {
 	creator      *cargodomain.CargoCreator
 	timeProvider utils.DateTimeProvider
@@ -37,14 +62,9 @@ func NewCreateCargoCommandHandler(
 }
 
 func (h *CreateCargoCommandHandler) Handle(ctx context.Context, cmd *CreateCargoCommand) (interface{}, error) {
-	input := cargodomain.CargoCreateInput{
-		ID:       cmd.ID,
-		VesselID: cmd.VesselID,
-		Items: []struct {
-			Name   string
-			Weight uint64
-		}(cmd.Items),
-		At: h.timeProvider.Now(),
+	input, err := newCargoCreateInput(cmd, h.timeProvider.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error creating cargo: %w", err)
 	}
 
 	if _, err := h.creator.Create(ctx, input); err != nil {
@@ -53,3 +73,36 @@ func (h *CreateCargoCommandHandler) Handle(ctx context.Context, cmd *CreateCargo
 
 	return struct{}{}, nil
 }
+
+// newCargoCreateInput normalizes every item weight to grams as the domain expects.
+func newCargoCreateInput(cmd *CreateCargoCommand, at time.Time) (cargodomain.CargoCreateInput, error) {
+	items := make([]struct {
+		Name        string
+		Weight      uint64
+		UNNumber    string
+		HazardClass string
+		Length      uint64
+		Width       uint64
+		Height      uint64
+	}, len(cmd.Items))
+	for i, item := range cmd.Items {
+		weight, err := domainweight.NewWeight(item.Weight.Value, item.Weight.Unit)
+		if err != nil {
+			return cargodomain.CargoCreateInput{}, err
+		}
+
+		items[i].Name, items[i].Weight = item.Name, weight.Grams()
+		items[i].UNNumber, items[i].HazardClass = item.UNNumber, item.HazardClass
+		items[i].Length, items[i].Width, items[i].Height = item.Length, item.Width, item.Height
+	}
+
+	return cargodomain.CargoCreateInput{
+		ID:        cmd.ID,
+		VesselID:  cmd.VesselID,
+		Items:     items,
+		Shipper:   (*cargodomain.CargoPartyInput)(cmd.Shipper),
+		Consignee: (*cargodomain.CargoPartyInput)(cmd.Consignee),
+		Metadata:  cmd.Metadata,
+		At:        at,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package cargocommands
+
+import (
+	"context"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+// CreateCargoesBatchCommand creates every cargo or none of them.
+type CreateCargoesBatchCommand struct {
+	Cargoes []*CreateCargoCommand
+}
+
+func (c *CreateCargoesBatchCommand) Type() string {
+	return "create_cargoes_batch_command"
+}
+
+// BlockingKeys holds every vessel loaded by the batch the same way a single creation does.
+func (c *CreateCargoesBatchCommand) BlockingKeys() []string {
+	keys := make([]string, len(c.Cargoes))
+	for i, cargo := range c.Cargoes {
+		keys[i] = cargo.BlockingKey()
+	}
+
+	return keys
+}
+
+type CreateCargoesBatchCommandHandler struct {
+	creator      *cargodomain.CargoBatchCreator
+	timeProvider utils.DateTimeProvider
+}
+
+func NewCreateCargoesBatchCommandHandler(
+	creator *cargodomain.CargoBatchCreator,
+	timeProvider utils.DateTimeProvider,
+) *CreateCargoesBatchCommandHandler {
+	return &CreateCargoesBatchCommandHandler{
+		creator:      creator,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *CreateCargoesBatchCommandHandler) Handle(ctx context.Context, cmd *CreateCargoesBatchCommand) (interface{}, error) {
+	at := h.timeProvider.Now()
+
+	inputs := make([]cargodomain.CargoCreateInput, len(cmd.Cargoes))
+	failures := make(map[int]error)
+	for i, cargo := range cmd.Cargoes {
+		input, err := newCargoCreateInput(cargo, at)
+		if err != nil {
+			failures[i] = err
+			continue
+		}
+
+		inputs[i] = input
+	}
+
+	// The batch can't be checked any further when some weights can't even be read.
+	if len(failures) > 0 {
+		return nil, fmt.Errorf("error creating cargoes batch: %w", cargodomain.NewCargoBatchRejectedError(len(inputs), failures))
+	}
+
+	if _, err := h.creator.Create(ctx, inputs); err != nil {
+		return nil, fmt.Errorf("error creating cargoes batch: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,59 @@
+package cargocommands
+
+import (
+	"context"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type DeleteCargoCommand struct {
+	ID string
+	// ExpectedVersion is the version the cargo must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *DeleteCargoCommand) Type() string {
+	return "delete_cargo_command"
+}
+
+func (c *DeleteCargoCommand) BlockingKey() string {
+	return "cargo_update:" + c.ID
+}
+
+type DeleteCargoCommandHandler struct {
+	updater      *cargodomain.CargoUpdater
+	transitions  cargodomain.StatusTransitions
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewDeleteCargoCommandHandler(
+	updater *cargodomain.CargoUpdater,
+	transitions cargodomain.StatusTransitions,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *DeleteCargoCommandHandler {
+	return &DeleteCargoCommandHandler{
+		updater:      updater,
+		transitions:  transitions,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *DeleteCargoCommandHandler) Handle(ctx context.Context, cmd *DeleteCargoCommand) (interface{}, error) {
+	trackingID, at := h.idProvider.New().String(), h.timeProvider.Now()
+
+	updates := []cargodomain.CargoUpdateOpt{
+		cargodomain.WithExpectedVersion(cmd.ExpectedVersion),
+		cargodomain.WithSoftDeletion(h.transitions, trackingID, at),
+	}
+
+	if err := h.updater.Update(ctx, cmd.ID, updates...); err != nil {
+		return nil, fmt.Errorf("error deleting cargo: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,67 @@
+package cargocommands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type DetectCargoDelayCommand struct {
+	ID          string
+	StatusSince time.Time
+	Threshold   time.Duration
+	// ExpectedVersion is the version the cargo was found stalled on, the delay is not flagged
+	// when the cargo changed since then, e.g. because it was already flagged by another run.
+	ExpectedVersion *uint64
+}
+
+func (c *DetectCargoDelayCommand) Type() string {
+	return "detect_cargo_delay_command"
+}
+
+// BlockingKey is shared with the rest of cargo updates so the status can't change while the delay is flagged.
+func (c *DetectCargoDelayCommand) BlockingKey() string {
+	return "cargo_update:" + c.ID
+}
+
+type DetectCargoDelayCommandHandler struct {
+	updater      *cargodomain.CargoUpdater
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewDetectCargoDelayCommandHandler(
+	updater *cargodomain.CargoUpdater,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *DetectCargoDelayCommandHandler {
+	return &DetectCargoDelayCommandHandler{
+		updater:      updater,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *DetectCargoDelayCommandHandler) Handle(ctx context.Context, cmd *DetectCargoDelayCommand) (interface{}, error) {
+	trackingID, at := h.idProvider.New().String(), h.timeProvider.Now()
+
+	updates := []cargodomain.CargoUpdateOpt{
+		cargodomain.WithExpectedVersion(cmd.ExpectedVersion),
+		cargodomain.WithDelayDetected(trackingID, cmd.StatusSince, cmd.Threshold, at),
+	}
+
+	if err := h.updater.Update(ctx, cmd.ID, updates...); err != nil {
+		// The cargo moved on since it was found stalled.
+		if errors.Is(err, cargodomain.ErrCargoNotDelayed) || errors.Is(err, cargodomain.ErrCargoVersionMismatch) {
+			return struct{}{}, nil
+		}
+
+		return nil, fmt.Errorf("error detecting cargo delay: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,59 @@
+package cargocommands
+
+import (
+	"context"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+// MergeCargoesCommand moves every item of the source cargoes into the one identified by ID.
+type MergeCargoesCommand struct {
+	ID        string
+	SourceIDs []string
+}
+
+func (c *MergeCargoesCommand) Type() string {
+	return "merge_cargoes_command"
+}
+
+// BlockingKeys holds every merged cargo the same way a single cargo update does.
+func (c *MergeCargoesCommand) BlockingKeys() []string {
+	keys := make([]string, 0, len(c.SourceIDs)+1)
+	keys = append(keys, "cargo_update:"+c.ID)
+	for _, sourceID := range c.SourceIDs {
+		keys = append(keys, "cargo_update:"+sourceID)
+	}
+
+	return keys
+}
+
+type MergeCargoesCommandHandler struct {
+	merger       *cargodomain.CargoMerger
+	timeProvider utils.DateTimeProvider
+}
+
+func NewMergeCargoesCommandHandler(
+	merger *cargodomain.CargoMerger,
+	timeProvider utils.DateTimeProvider,
+) *MergeCargoesCommandHandler {
+	return &MergeCargoesCommandHandler{
+		merger:       merger,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *MergeCargoesCommandHandler) Handle(ctx context.Context, cmd *MergeCargoesCommand) (interface{}, error) {
+	input := cargodomain.CargoMergeInput{
+		ID:        cmd.ID,
+		SourceIDs: cmd.SourceIDs,
+		At:        h.timeProvider.Now(),
+	}
+
+	if err := h.merger.Merge(ctx, input); err != nil {
+		return nil, fmt.Errorf("error merging cargoes: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,59 @@
+package cargocommands
+
+import (
+	"context"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+// SplitCargoCommand moves the named items, one per name, to a new cargo identified by SplitID.
+type SplitCargoCommand struct {
+	ID      string
+	SplitID string
+	// VesselID is the vessel the cargo is loaded on, its load is locked while the cargo is split.
+	VesselID  string
+	ItemNames []string
+}
+
+func (c *SplitCargoCommand) Type() string {
+	return "split_cargo_command"
+}
+
+// BlockingKeys holds both the split and the new cargo along with the vessel load they share, so
+// the new cargo can't be created concurrently nor the load changed while it's being split.
+func (c *SplitCargoCommand) BlockingKeys() []string {
+	return []string{"cargo_update:" + c.ID, "cargo_update:" + c.SplitID, "vessel_load:" + c.VesselID}
+}
+
+type SplitCargoCommandHandler struct {
+	splitter     *cargodomain.CargoSplitter
+	timeProvider utils.DateTimeProvider
+}
+
+func NewSplitCargoCommandHandler(
+	splitter *cargodomain.CargoSplitter,
+	timeProvider utils.DateTimeProvider,
+) *SplitCargoCommandHandler {
+	return &SplitCargoCommandHandler{
+		splitter:     splitter,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *SplitCargoCommandHandler) Handle(ctx context.Context, cmd *SplitCargoCommand) (interface{}, error) {
+	input := cargodomain.CargoSplitInput{
+		ID:        cmd.ID,
+		SplitID:   cmd.SplitID,
+		VesselID:  cmd.VesselID,
+		ItemNames: cmd.ItemNames,
+		At:        h.timeProvider.Now(),
+	}
+
+	if _, err := h.splitter.Split(ctx, input); err != nil {
+		return nil, fmt.Errorf("error splitting cargo: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,81 @@
+package cargocommands
+
+import (
+	"context"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type UpdateCargoItemsCommand struct {
+	ID string
+	// VesselID is the vessel the cargo is loaded on, its load is locked while the items change.
+	VesselID  string
+	Operation string
+	Items     []struct {
+		Name        string `json:"name"`
+		Weight      uint64 `json:"weight"`
+		UNNumber    string `json:"un_number"`
+		HazardClass string `json:"hazard_class"`
+		Length      uint64 `json:"length"`
+		Width       uint64 `json:"width"`
+		Height      uint64 `json:"height"`
+	}
+	// ExpectedVersion is the version the cargo must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *UpdateCargoItemsCommand) Type() string {
+	return "update_cargo_items_command"
+}
+
+// BlockingKeys holds the cargo along with its vessel load, so a heavier manifest can't bypass the
+// capacity check through concurrent creations or reassignments loading the same vessel.
+func (c *UpdateCargoItemsCommand) BlockingKeys() []string {
+	return []string{"cargo_update:" + c.ID, "vessel_load:" + c.VesselID}
+}
+
+type UpdateCargoItemsCommandHandler struct {
+	amender      *cargodomain.CargoItemsAmender
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewUpdateCargoItemsCommandHandler(
+	amender *cargodomain.CargoItemsAmender,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *UpdateCargoItemsCommandHandler {
+	return &UpdateCargoItemsCommandHandler{
+		amender:      amender,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *UpdateCargoItemsCommandHandler) Handle(ctx context.Context, cmd *UpdateCargoItemsCommand) (interface{}, error) {
+	input := cargodomain.CargoItemsAmendInput{
+		ID:         cmd.ID,
+		VesselID:   cmd.VesselID,
+		Operation:  cmd.Operation,
+		TrackingID: h.idProvider.New().String(),
+		Items: []struct {
+			Name        string
+			Weight      uint64
+			UNNumber    string
+			HazardClass string
+			Length      uint64
+			Width       uint64
+			Height      uint64
+		}(cmd.Items),
+		At:              h.timeProvider.Now(),
+		ExpectedVersion: cmd.ExpectedVersion,
+	}
+
+	if err := h.amender.Amend(ctx, input); err != nil {
+		return nil, fmt.Errorf("error updating cargo items: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,63 @@
+package cargocommands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+// UpdateCargoMetadataCommand sets the given metadata keys, the ones set to nil are removed.
+type UpdateCargoMetadataCommand struct {
+	ID       string
+	Metadata map[string]*string
+	// ExpectedVersion is the version the cargo must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *UpdateCargoMetadataCommand) Type() string {
+	return "update_cargo_metadata_command"
+}
+
+func (c *UpdateCargoMetadataCommand) BlockingKey() string {
+	return "cargo_update:" + c.ID
+}
+
+type UpdateCargoMetadataCommandHandler struct {
+	updater      *cargodomain.CargoUpdater
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewUpdateCargoMetadataCommandHandler(
+	updater *cargodomain.CargoUpdater,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *UpdateCargoMetadataCommandHandler {
+	return &UpdateCargoMetadataCommandHandler{
+		updater:      updater,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *UpdateCargoMetadataCommandHandler) Handle(ctx context.Context, cmd *UpdateCargoMetadataCommand) (interface{}, error) {
+	trackingID, at := h.idProvider.New().String(), h.timeProvider.Now()
+
+	updates := []cargodomain.CargoUpdateOpt{
+		cargodomain.WithExpectedVersion(cmd.ExpectedVersion),
+		cargodomain.WithMetadataChanges(trackingID, cmd.Metadata, at),
+	}
+
+	if err := h.updater.Update(ctx, cmd.ID, updates...); err != nil {
+		if errors.Is(err, cargodomain.ErrMetadataUnchanged) {
+			return struct{}{}, nil
+		}
+
+		return nil, fmt.Errorf("error updating cargo metadata: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -12,6 +12,8 @@ import (
 type UpdateCargoStatusCommand struct {
 	ID        string
 	NewStatus string
+	// ExpectedVersion is the version the cargo must be on, nil when any version is fine.
+	ExpectedVersion *uint64
 }
 
 func (c *UpdateCargoStatusCommand) Type() string {
@@ -24,17 +26,20 @@ func (c *UpdateCargoStatusCommand) BlockingKey() string {
 
 type UpdateCargoStatusCommandHandler struct {
 	updater      *cargodomain.CargoUpdater
+	transitions  cargodomain.StatusTransitions
 	timeProvider utils.DateTimeProvider
 	idProvider   utils.ULIDProvider
 }
 
 func NewUpdateCargoStatusCommandHandler(
 	updater *cargodomain.CargoUpdater,
+	transitions cargodomain.StatusTransitions,
 	timeProvider utils.DateTimeProvider,
 	idProvider utils.ULIDProvider,
 ) *UpdateCargoStatusCommandHandler {
 	return &UpdateCargoStatusCommandHandler{
 		updater:      updater,
+		transitions:  transitions,
 		timeProvider: timeProvider,
 		idProvider:   idProvider,
 	}
@@ -44,7 +49,8 @@ func (h *UpdateCargoStatusCommandHandler) Handle(ctx context.Context, cmd *Updat
 	trackingID, at := h.idProvider.New().String(), h.timeProvider.Now()
 
 	updates := []cargodomain.CargoUpdateOpt{
-		cargodomain.WithStatus(trackingID, cmd.NewStatus, at),
+		cargodomain.WithExpectedVersion(cmd.ExpectedVersion),
+		cargodomain.WithStatus(h.transitions, trackingID, cmd.NewStatus, at),
 	}
 
 	if err := h.updater.Update(ctx, cmd.ID, updates...); err != nil {
@@ -0,0 +1,65 @@
+package cargocommands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type UpdateCargoVesselCommand struct {
+	ID       string
+	VesselID string
+	// ExpectedVersion is the version the cargo must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *UpdateCargoVesselCommand) Type() string {
+	return "update_cargo_vessel_command"
+}
+
+// BlockingKeys holds the cargo along with the destination vessel load, so the capacity check
+// can't be bypassed by concurrent creations or reassignments loading the same vessel.
+func (c *UpdateCargoVesselCommand) BlockingKeys() []string {
+	return []string{"cargo_update:" + c.ID, "vessel_load:" + c.VesselID}
+}
+
+type UpdateCargoVesselCommandHandler struct {
+	reassigner   *cargodomain.CargoVesselReassigner
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewUpdateCargoVesselCommandHandler(
+	reassigner *cargodomain.CargoVesselReassigner,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *UpdateCargoVesselCommandHandler {
+	return &UpdateCargoVesselCommandHandler{
+		reassigner:   reassigner,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *UpdateCargoVesselCommandHandler) Handle(ctx context.Context, cmd *UpdateCargoVesselCommand) (interface{}, error) {
+	input := cargodomain.CargoVesselReassignInput{
+		ID:              cmd.ID,
+		VesselID:        cmd.VesselID,
+		TrackingID:      h.idProvider.New().String(),
+		At:              h.timeProvider.Now(),
+		ExpectedVersion: cmd.ExpectedVersion,
+	}
+
+	if err := h.reassigner.Reassign(ctx, input); err != nil {
+		if errors.Is(err, cargodomain.ErrVesselUnchanged) {
+			return struct{}{}, nil
+		}
+
+		return nil, fmt.Errorf("error updating cargo vessel: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -3,6 +3,7 @@ package cargoqueries
 import (
 	"context"
 	"fmt"
+	"time"
 
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 )
@@ -10,6 +11,8 @@ import (
 type FetchCargoByID struct {
 	ID       string
 	Tracking bool
+	// AsOf rebuilds the status the cargo had at the given instant from its tracking.
+	AsOf *time.Time
 }
 
 func (q *FetchCargoByID) Type() string {
@@ -33,7 +36,7 @@ func (h *FetchCargoByIDHandler) Handle(ctx context.Context, q *FetchCargoByID) (
 	}
 
 	opts := make([]cargodomain.CargoFindingOpt, 0)
-	if q.Tracking {
+	if q.Tracking || q.AsOf != nil {
 		opts = append(opts, cargodomain.WithTracking())
 	}
 
@@ -42,5 +45,20 @@ func (h *FetchCargoByIDHandler) Handle(ctx context.Context, q *FetchCargoByID) (
 		return CargoResponse{}, fmt.Errorf("error fetching cargo: %w", err)
 	}
 
-	return NewCargoResponse(cargo.Primitives()), nil
+	response := NewCargoResponse(cargo.Primitives())
+	if q.AsOf == nil {
+		return response, nil
+	}
+
+	state, err := cargo.StateAsOf(*q.AsOf)
+	if err != nil {
+		return CargoResponse{}, fmt.Errorf("error rebuilding cargo state: %w", err)
+	}
+
+	response.AsOf = newCargoStateAsOfResponse(cargoID.String(), state)
+	if !q.Tracking {
+		response.Tracking = make([]CargoTrackingResponseItem, 0)
+	}
+
+	return response, nil
 }
@@ -0,0 +1,25 @@
+package cargoqueries
+
+import (
+	"context"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+)
+
+type FetchCargoStatuses struct{}
+
+func (q *FetchCargoStatuses) Type() string {
+	return "fetch_cargo_statuses"
+}
+
+type FetchCargoStatusesHandler struct {
+	transitions cargodomain.StatusTransitions
+}
+
+func NewFetchCargoStatusesHandler(transitions cargodomain.StatusTransitions) *FetchCargoStatusesHandler {
+	return &FetchCargoStatusesHandler{transitions: transitions}
+}
+
+func (h *FetchCargoStatusesHandler) Handle(_ context.Context, _ *FetchCargoStatuses) (CargoStatusesResponse, error) {
+	return NewCargoStatusesResponse(h.transitions), nil
+}
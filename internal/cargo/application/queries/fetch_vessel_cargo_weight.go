@@ -0,0 +1,49 @@
+package cargoqueries
+
+import (
+	"context"
+	"fmt"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+)
+
+// FetchVesselCargoWeight sums the weight of the active cargoes loaded on the given vessel.
+type FetchVesselCargoWeight struct {
+	VesselID string
+}
+
+func (q *FetchVesselCargoWeight) Type() string {
+	return "fetch_vessel_cargo_weight"
+}
+
+type VesselCargoWeightResponse struct {
+	VesselID string
+	Weight   uint64 // in grams
+}
+
+type FetchVesselCargoWeightHandler struct {
+	repository cargodomain.CargoRepository
+}
+
+func NewFetchVesselCargoWeightHandler(repository cargodomain.CargoRepository) *FetchVesselCargoWeightHandler {
+	return &FetchVesselCargoWeightHandler{
+		repository: repository,
+	}
+}
+
+func (h *FetchVesselCargoWeightHandler) Handle(
+	ctx context.Context,
+	q *FetchVesselCargoWeight,
+) (VesselCargoWeightResponse, error) {
+	vesselID, err := cargodomain.NewVesselID(q.VesselID)
+	if err != nil {
+		return VesselCargoWeightResponse{}, fmt.Errorf("invalid vessel id: %w", err)
+	}
+
+	weight, err := h.repository.ActiveWeightByVessel(ctx, vesselID)
+	if err != nil {
+		return VesselCargoWeightResponse{}, fmt.Errorf("error calculating vessel cargo weight: %w", err)
+	}
+
+	return VesselCargoWeightResponse{VesselID: vesselID.String(), Weight: weight}, nil
+}
@@ -4,59 +4,215 @@ import (
 	"time"
 
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
 )
 
 type CargoResponseItem struct {
-	Name   string
-	Weight uint64
+	Name        string
+	Weight      uint64
+	UNNumber    string
+	HazardClass string
+	Length      uint64
+	Width       uint64
+	Height      uint64
 }
 
 type CargoTrackingResponseItem struct {
-	ID           string
-	EntryType    string
-	StatusBefore *string
-	StatusAfter  *string
-	CreatedAt    time.Time
+	ID              string
+	EntryType       string
+	StatusBefore    *string
+	StatusAfter     *string
+	Details         map[string]any
+	VesselLatitude  *float64
+	VesselLongitude *float64
+	CreatedAt       time.Time
 }
+type CargoPartyResponse struct {
+	Name    string
+	Email   string
+	Address string
+}
+
+func newCargoPartyResponse(p *cargodomain.PartyPrimitives) *CargoPartyResponse {
+	if p == nil {
+		return nil
+	}
+
+	return &CargoPartyResponse{Name: p.Name, Email: p.Email, Address: p.Address}
+}
+
 type CargoResponse struct {
-	ID        string
-	VesselID  string
-	Items     []CargoResponseItem
-	Tracking  []CargoTrackingResponseItem
-	Status    string
-	Weight    uint64
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID               string
+	VesselID         string
+	Items            []CargoResponseItem
+	Tracking         []CargoTrackingResponseItem
+	Shipper          *CargoPartyResponse
+	Consignee        *CargoPartyResponse
+	Metadata         map[string]string
+	Status           string
+	AsOf             *CargoStateAsOfResponse
+	Weight           uint64
+	Volume           uint64
+	ChargeableWeight uint64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Version          uint64
+}
+
+// CargoStateAsOfResponse is the cargo status rebuilt at a given instant along with the
+// last tracking entry recorded up to it.
+type CargoStateAsOfResponse struct {
+	At       time.Time
+	Status   string
+	Tracking CargoTrackingResponseItem
+}
+
+func newCargoStateAsOfResponse(cargoID string, state cargodomain.CargoStateAsOf) *CargoStateAsOfResponse {
+	tracking := cargotrackingdomain.TrackingPrimitives{cargotrackingdomain.NewTrackingItemPrimitives(cargoID, state.Tracking)}
+
+	return &CargoStateAsOfResponse{
+		At:       state.At,
+		Status:   state.Status.String(),
+		Tracking: newCargoTrackingResponseItems(tracking)[0],
+	}
 }
 
 func NewCargoResponse(p cargodomain.CargoPrimitives) CargoResponse {
 	cargoItems := make([]CargoResponseItem, len(p.Items))
 	for i, item := range p.Items {
 		cargoItems[i] = CargoResponseItem{
-			Name:   item.Name,
-			Weight: item.Weight,
+			Name:        item.Name,
+			Weight:      item.Weight,
+			UNNumber:    item.UNNumber,
+			HazardClass: item.HazardClass,
+			Length:      item.Length,
+			Width:       item.Width,
+			Height:      item.Height,
 		}
 	}
 
-	trackingItems := make([]CargoTrackingResponseItem, len(p.Tracking))
-	for i, item := range p.Tracking {
+	return CargoResponse{
+		ID:               p.ID,
+		VesselID:         p.VesselID,
+		Items:            cargoItems,
+		Tracking:         newCargoTrackingResponseItems(p.Tracking),
+		Shipper:          newCargoPartyResponse(p.Shipper),
+		Consignee:        newCargoPartyResponse(p.Consignee),
+		Metadata:         p.Metadata,
+		Status:           p.Status,
+		Weight:           p.Weight,
+		Volume:           p.Volume,
+		ChargeableWeight: p.ChargeableWeight,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+		Version:          p.Version,
+	}
+}
+
+func newCargoTrackingResponseItems(tracking cargotrackingdomain.TrackingPrimitives) []CargoTrackingResponseItem {
+	trackingItems := make([]CargoTrackingResponseItem, len(tracking))
+	for i, item := range tracking {
 		trackingItems[i] = CargoTrackingResponseItem{
-			ID:           item.ID,
-			EntryType:    item.EntryType,
-			StatusBefore: item.StatusBefore,
-			StatusAfter:  item.StatusAfter,
-			CreatedAt:    item.CreatedAt,
+			ID:              item.ID,
+			EntryType:       item.EntryType,
+			StatusBefore:    item.StatusBefore,
+			StatusAfter:     item.StatusAfter,
+			Details:         item.Details,
+			VesselLatitude:  item.VesselLatitude,
+			VesselLongitude: item.VesselLongitude,
+			CreatedAt:       item.CreatedAt,
 		}
 	}
 
-	return CargoResponse{
-		ID:        p.ID,
-		VesselID:  p.VesselID,
-		Items:     cargoItems,
-		Tracking:  trackingItems,
-		Status:    p.Status,
-		Weight:    p.Weight,
-		CreatedAt: p.CreatedAt,
-		UpdatedAt: p.UpdatedAt,
+	return trackingItems
+}
+
+type CargoTrackingResponse struct {
+	Items      []CargoTrackingResponseItem
+	NextCursor string
+}
+
+func NewCargoTrackingResponse(cargoID cargodomain.CargoID, result cargotrackingdomain.TrackingSearchResult) CargoTrackingResponse {
+	nextCursor := ""
+	if result.NextCursor != nil {
+		nextCursor = result.NextCursor.String()
+	}
+
+	return CargoTrackingResponse{
+		Items:      newCargoTrackingResponseItems(cargotrackingdomain.NewTrackingPrimitives(cargoID.String(), result.Tracking)),
+		NextCursor: nextCursor,
 	}
 }
+
+type CargoesResponse struct {
+	Items      []CargoResponse
+	NextCursor string
+}
+
+func NewCargoesResponse(result cargodomain.CargoSearchResult) CargoesResponse {
+	items := make([]CargoResponse, len(result.Cargoes))
+	for i, cargo := range result.Cargoes {
+		items[i] = NewCargoResponse(cargo.Primitives())
+	}
+
+	nextCursor := ""
+	if result.NextCursor != nil {
+		nextCursor = result.NextCursor.String()
+	}
+
+	return CargoesResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	}
+}
+
+type CargoStatusResponse struct {
+	Status      string
+	Transitions []string
+	Initial     bool
+	Final       bool
+}
+
+type CargoStatusesResponse struct {
+	Items []CargoStatusResponse
+}
+
+func NewCargoStatusesResponse(transitions cargodomain.StatusTransitions) CargoStatusesResponse {
+	statuses := transitions.Statuses()
+	items := make([]CargoStatusResponse, len(statuses))
+	for i, status := range statuses {
+		targets := transitions.From(status)
+		rawTargets := make([]string, len(targets))
+		for j, target := range targets {
+			rawTargets[j] = target.String()
+		}
+
+		items[i] = CargoStatusResponse{
+			Status:      status.String(),
+			Transitions: rawTargets,
+			Initial:     status.Equals(cargodomain.StatusPending),
+			Final:       transitions.IsFinal(status),
+		}
+	}
+
+	return CargoStatusesResponse{Items: items}
+}
+
+type StalledCargoResponse struct {
+	ID          string
+	StatusSince time.Time
+	Version     uint64
+}
+
+type StalledCargoesResponse struct {
+	Items []StalledCargoResponse
+}
+
+func NewStalledCargoesResponse(stalled []cargodomain.StalledCargo) StalledCargoesResponse {
+	items := make([]StalledCargoResponse, len(stalled))
+	for i, cargo := range stalled {
+		items[i] = StalledCargoResponse{ID: cargo.ID.String(), StatusSince: cargo.StatusSince, Version: cargo.Version}
+	}
+
+	return StalledCargoesResponse{Items: items}
+}
@@ -0,0 +1,67 @@
+package cargoqueries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+)
+
+type SearchCargoTracking struct {
+	CargoID       string
+	EntryTypes    []string
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+	Cursor        string
+	PageSize      uint64
+}
+
+func (q *SearchCargoTracking) Type() string {
+	return "search_cargo_tracking"
+}
+
+type SearchCargoTrackingHandler struct {
+	repository cargodomain.CargoRepository
+	reader     cargodomain.CargoTrackingReader
+}
+
+func NewSearchCargoTrackingHandler(
+	repository cargodomain.CargoRepository,
+	reader cargodomain.CargoTrackingReader,
+) *SearchCargoTrackingHandler {
+	return &SearchCargoTrackingHandler{
+		repository: repository,
+		reader:     reader,
+	}
+}
+
+func (h *SearchCargoTrackingHandler) Handle(ctx context.Context, q *SearchCargoTracking) (CargoTrackingResponse, error) {
+	cargoID, err := cargodomain.NewCargoID(q.CargoID)
+	if err != nil {
+		return CargoTrackingResponse{}, fmt.Errorf("invalid cargo id: %w", err)
+	}
+
+	criteria, err := cargotrackingdomain.NewTrackingSearchCriteria(
+		cargotrackingdomain.WithEntryTypeFilter(q.EntryTypes...),
+		cargotrackingdomain.WithCreatedAtRange(q.CreatedAtFrom, q.CreatedAtTo),
+		cargotrackingdomain.WithCursor(q.Cursor),
+		cargotrackingdomain.WithPageSize(q.PageSize),
+	)
+	if err != nil {
+		return CargoTrackingResponse{}, fmt.Errorf("invalid tracking search criteria: %w", err)
+	}
+
+	// The timeline of deleted or unknown cargoes is not exposed.
+	if _, err := h.repository.Find(ctx, cargoID); err != nil {
+		return CargoTrackingResponse{}, fmt.Errorf("error fetching cargo: %w", err)
+	}
+
+	result, err := h.reader.SearchTracking(ctx, cargoID, criteria)
+	if err != nil {
+		return CargoTrackingResponse{}, fmt.Errorf("error searching cargo tracking: %w", err)
+	}
+
+	return NewCargoTrackingResponse(cargoID, result), nil
+}
@@ -0,0 +1,58 @@
+package cargoqueries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+)
+
+type SearchCargoes struct {
+	Statuses      []string
+	VesselID      string
+	Shipper       string
+	Metadata      map[string]string
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+	Sort          string
+	Cursor        string
+	PageSize      uint64
+}
+
+func (q *SearchCargoes) Type() string {
+	return "search_cargoes"
+}
+
+type SearchCargoesHandler struct {
+	repository cargodomain.CargoRepository
+}
+
+func NewSearchCargoesHandler(repository cargodomain.CargoRepository) *SearchCargoesHandler {
+	return &SearchCargoesHandler{
+		repository: repository,
+	}
+}
+
+func (h *SearchCargoesHandler) Handle(ctx context.Context, q *SearchCargoes) (CargoesResponse, error) {
+	criteria, err := cargodomain.NewCargoSearchCriteria(
+		cargodomain.WithStatusFilter(q.Statuses...),
+		cargodomain.WithVesselFilter(q.VesselID),
+		cargodomain.WithShipperFilter(q.Shipper),
+		cargodomain.WithMetadataFilter(q.Metadata),
+		cargodomain.WithCreatedAtRange(q.CreatedAtFrom, q.CreatedAtTo),
+		cargodomain.WithSorting(q.Sort),
+		cargodomain.WithCursor(q.Cursor),
+		cargodomain.WithPageSize(q.PageSize),
+	)
+	if err != nil {
+		return CargoesResponse{}, fmt.Errorf("invalid search criteria: %w", err)
+	}
+
+	result, err := h.repository.Search(ctx, criteria)
+	if err != nil {
+		return CargoesResponse{}, fmt.Errorf("error searching cargoes: %w", err)
+	}
+
+	return NewCargoesResponse(result), nil
+}
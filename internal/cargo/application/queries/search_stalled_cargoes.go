@@ -0,0 +1,45 @@
+package cargoqueries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+// SearchStalledCargoes looks for the in transit cargoes whose status didn't change for longer than Threshold.
+type SearchStalledCargoes struct {
+	Threshold time.Duration
+}
+
+func (q *SearchStalledCargoes) Type() string {
+	return "search_stalled_cargoes"
+}
+
+type SearchStalledCargoesHandler struct {
+	repository   cargodomain.CargoRepository
+	timeProvider utils.DateTimeProvider
+}
+
+func NewSearchStalledCargoesHandler(
+	repository cargodomain.CargoRepository,
+	timeProvider utils.DateTimeProvider,
+) *SearchStalledCargoesHandler {
+	return &SearchStalledCargoesHandler{
+		repository:   repository,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *SearchStalledCargoesHandler) Handle(ctx context.Context, q *SearchStalledCargoes) (StalledCargoesResponse, error) {
+	changedBefore := h.timeProvider.Now().Add(-q.Threshold)
+
+	stalled, err := h.repository.SearchStalled(ctx, changedBefore, cargodomain.MaxStalledCargoesPerSearch)
+	if err != nil {
+		return StalledCargoesResponse{}, fmt.Errorf("error searching stalled cargoes: %w", err)
+	}
+
+	return NewStalledCargoesResponse(stalled), nil
+}
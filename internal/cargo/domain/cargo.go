@@ -15,40 +15,66 @@ type Cargo struct {
 	vesselID  VesselID
 	items     Items
 	tracking  cargotrackingdomain.Tracking
+	shipper   *Party
+	consignee *Party
+	metadata  Metadata
 	status    Status
 	createdAt time.Time
 	updatedAt time.Time
 	deletedAt *time.Time
+	// version is the one the cargo was loaded with, zero when it was never saved.
+	version uint64
+
+	// vesselPosition is not persisted, it's stamped on the tracking entries recorded from now on.
+	vesselPosition *cargotrackingdomain.VesselPosition
 }
 
 func NewCargo(
 	id CargoID,
-	vesselID VesselID,
+	vessel CargoVessel,
 	trackingID cargotrackingdomain.TrackingID,
 	items Items,
+	parties CargoParties,
+	metadata Metadata,
 	at time.Time,
-) *Cargo {
+) (*Cargo, error) {
 	cargo := &Cargo{
-		AggregateRoot: domain.NewAggregateRoot(),
-		id:            id,
-		vesselID:      vesselID,
-		items:         items,
-		tracking:      make(cargotrackingdomain.Tracking, 0),
-		status:        StatusPending,
-		createdAt:     at,
-		updatedAt:     at,
-		deletedAt:     nil,
+		AggregateRoot:  domain.NewAggregateRoot(),
+		id:             id,
+		vesselID:       vessel.ID(),
+		items:          items,
+		tracking:       make(cargotrackingdomain.Tracking, 0),
+		shipper:        parties.Shipper,
+		consignee:      parties.Consignee,
+		metadata:       metadata,
+		status:         StatusPending,
+		createdAt:      at,
+		updatedAt:      at,
+		deletedAt:      nil,
+		vesselPosition: vessel.Position(),
 	}
 
 	cargo.appendTracking(cargotrackingdomain.NewTrackingOnCargoCreated(trackingID, cargo.status.String(), at))
 
-	return cargo
+	event, err := NewCargoCreatedV1DomainEvent(id, cargo.vesselID, items, at)
+	if err != nil {
+		return nil, err
+	}
+	cargo.RecordEvent(event)
+
+	return cargo, nil
 }
 
 func NewCargoFromPrimitives(p CargoPrimitives) *Cargo {
 	items := make(Items, len(p.Items))
 	for i, item := range p.Items {
-		items[i] = newItem(item.Name, item.Weight)
+		items[i] = newItem(
+			item.Name,
+			item.Weight,
+			item.UNNumber,
+			HazardClass(item.HazardClass),
+			NewItemDimensions(item.Length, item.Width, item.Height),
+		)
 	}
 
 	trackingItems := make(cargotrackingdomain.Tracking, len(p.Tracking))
@@ -62,10 +88,14 @@ func NewCargoFromPrimitives(p CargoPrimitives) *Cargo {
 		vesselID:      VesselID(p.VesselID),
 		items:         items,
 		tracking:      trackingItems,
+		shipper:       newPartyFromPrimitives(p.Shipper),
+		consignee:     newPartyFromPrimitives(p.Consignee),
+		metadata:      Metadata(p.Metadata),
 		status:        Status(p.Status),
 		createdAt:     p.CreatedAt,
 		updatedAt:     p.UpdatedAt,
 		deletedAt:     p.DeletedAt,
+		version:       p.Version,
 	}
 }
 
@@ -81,6 +111,10 @@ func (c *Cargo) VesselID() VesselID {
 	return c.vesselID
 }
 
+func (c *Cargo) Version() uint64 {
+	return c.version
+}
+
 func (c *Cargo) Tracking() cargotrackingdomain.Tracking {
 	return c.tracking
 }
@@ -90,7 +124,12 @@ func (c *Cargo) appendTracking(item cargotrackingdomain.TrackingItem) {
 		c.tracking = make(cargotrackingdomain.Tracking, 0)
 	}
 
-	c.tracking = append(c.tracking, item)
+	c.tracking = append(c.tracking, item.WithVesselPosition(c.vesselPosition))
+}
+
+// locate keeps the position of the vessel carrying the cargo to stamp it on new tracking entries.
+func (c *Cargo) locate(vessel CargoVessel) {
+	c.vesselPosition = vessel.Position()
 }
 
 func (c *Cargo) Update(_ context.Context, updates ...CargoUpdateOpt) error {
@@ -0,0 +1,61 @@
+package cargodomain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+)
+
+// CargoBatchCreator creates several cargoes all or nothing, when any of them can't be
+// created the whole batch is rejected and none of them is saved.
+type CargoBatchCreator struct {
+	creator *CargoCreator
+}
+
+func NewCargoBatchCreator(creator *CargoCreator) *CargoBatchCreator {
+	return &CargoBatchCreator{creator: creator}
+}
+
+func (bc *CargoBatchCreator) Create(ctx context.Context, inputs []CargoCreateInput) ([]*Cargo, error) {
+	cargoes := make([]*Cargo, 0, len(inputs))
+	failures := make(map[int]error)
+	pending := make(map[VesselID]uint64)
+	seen := make(map[CargoID]struct{}, len(inputs))
+
+	for i, input := range inputs {
+		cargo, err := bc.creator.build(ctx, input, pending)
+		if err != nil {
+			failures[i] = err
+			continue
+		}
+
+		if _, duplicated := seen[cargo.ID()]; duplicated {
+			failures[i] = NewCargoAlreadyExistsError(cargo.ID(), cargo.VesselID())
+			continue
+		}
+
+		seen[cargo.ID()] = struct{}{}
+		pending[cargo.VesselID()] += cargo.items.Weight()
+		cargoes = append(cargoes, cargo)
+	}
+
+	if len(failures) > 0 {
+		return nil, NewCargoBatchRejectedError(len(inputs), failures)
+	}
+
+	events := make([]domain.Event, 0, len(cargoes))
+	for _, cargo := range cargoes {
+		events = append(events, cargo.PullEvents()...)
+	}
+
+	if saveErr := bc.creator.repository.SaveAll(ctx, cargoes...); saveErr != nil {
+		return nil, fmt.Errorf("error saving cargoes: %w", saveErr)
+	}
+
+	if publishErr := bc.creator.publisher.Publish(ctx, events...); publishErr != nil {
+		return nil, fmt.Errorf("error publishing cargo events: %w", publishErr)
+	}
+
+	return cargoes, nil
+}
@@ -0,0 +1,150 @@
+package cargodomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+	messagingmock "github.com/soulcodex/deus-cargo-tracker/pkg/messaging/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+func TestCargoBatchCreator_Create(t *testing.T) {
+	ctx, timeProvider := context.Background(), utils.NewFixedTimeProvider()
+	vesselID, otherVesselID := utils.NewULID().String(), utils.NewULID().String()
+
+	const vesselCapacity = 1 // in kilograms
+
+	newInput := func(vesselID string, weight uint64) cargodomain.CargoCreateInput {
+		return cargodomain.CargoCreateInput{
+			ID:       utils.NewULID().String(),
+			VesselID: vesselID,
+			Items:    rawCargoItems{{Name: "Fuel", Weight: weight}},
+			At:       timeProvider.Now(),
+		}
+	}
+
+	tests := []struct {
+		name             string
+		inputs           func() []cargodomain.CargoCreateInput
+		setupMocks       func(repo *cargodomainmock.CargoRepositoryMock)
+		expectedFailures []int
+		expectedError    string
+	}{
+		{
+			name: "should create every cargo on a single save",
+			inputs: func() []cargodomain.CargoCreateInput {
+				return []cargodomain.CargoCreateInput{
+					newInput(vesselID, 400),
+					newInput(vesselID, 400),
+					newInput(otherVesselID, 800),
+				}
+			},
+		},
+		{
+			name: "should reject the batch when the cargoes exceed the vessel capacity together",
+			inputs: func() []cargodomain.CargoCreateInput {
+				return []cargodomain.CargoCreateInput{
+					newInput(vesselID, 600),
+					newInput(otherVesselID, 600),
+					newInput(vesselID, 600),
+				}
+			},
+			expectedFailures: []int{2},
+		},
+		{
+			name: "should reject the batch when a cargo is repeated",
+			inputs: func() []cargodomain.CargoCreateInput {
+				input := newInput(vesselID, 100)
+				return []cargodomain.CargoCreateInput{input, newInput(vesselID, 100), input}
+			},
+			expectedFailures: []int{2},
+		},
+		{
+			name: "should report every invalid cargo of the batch",
+			inputs: func() []cargodomain.CargoCreateInput {
+				invalidVessel, invalidItems := newInput("", 100), newInput(vesselID, 0)
+				return []cargodomain.CargoCreateInput{invalidVessel, newInput(vesselID, 100), invalidItems}
+			},
+			expectedFailures: []int{0, 2},
+		},
+		{
+			name: "should fail when saving the batch fails",
+			inputs: func() []cargodomain.CargoCreateInput {
+				return []cargodomain.CargoCreateInput{newInput(vesselID, 100)}
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock) {
+				repo.SaveAllFunc = func(_ context.Context, _ ...*cargodomain.Cargo) error {
+					return errors.New("transaction aborted")
+				}
+			},
+			expectedError: "error saving cargoes: transaction aborted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &cargodomainmock.CargoRepositoryMock{
+				FindFunc: func(_ context.Context, id cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, cargodomain.NewCargoNotExistsError(id)
+				},
+				ActiveWeightByVesselFunc: func(_ context.Context, _ cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				},
+				SaveAllFunc: func(_ context.Context, _ ...*cargodomain.Cargo) error {
+					return nil
+				},
+			}
+			checker := &cargodomainmock.CargoVesselCheckerMock{
+				CheckFunc: func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				},
+			}
+			publisher := &messagingmock.PublisherMock{
+				PublishFunc: func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				},
+			}
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(repo)
+			}
+
+			creator := cargodomain.NewCargoCreator(repo, checker, utils.NewFixedULIDProvider(), publisher)
+			inputs := tt.inputs()
+			cargoes, err := cargodomain.NewCargoBatchCreator(creator).Create(ctx, inputs)
+
+			switch {
+			case tt.expectedFailures != nil:
+				rejected, ok := cargodomain.AsCargoBatchRejectedError(err)
+				require.True(t, ok)
+				assert.Nil(t, cargoes)
+				assert.Len(t, rejected.Failures(), len(tt.expectedFailures))
+				for _, position := range tt.expectedFailures {
+					assert.Contains(t, rejected.Failures(), position)
+				}
+				assert.Empty(t, repo.SaveAllCalls())
+				assert.Empty(t, publisher.PublishCalls())
+			case tt.expectedError != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Empty(t, publisher.PublishCalls())
+			default:
+				require.NoError(t, err)
+				require.Len(t, cargoes, len(inputs))
+				require.Len(t, repo.SaveAllCalls(), 1)
+				assert.Len(t, repo.SaveAllCalls()[0].Cargoes, len(inputs))
+				assert.Empty(t, repo.SaveCalls())
+
+				require.Len(t, publisher.PublishCalls(), 1)
+				assert.Len(t, publisher.PublishCalls()[0].Messages, len(inputs))
+			}
+		})
+	}
+}
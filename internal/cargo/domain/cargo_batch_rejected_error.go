@@ -0,0 +1,37 @@
+package cargodomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const cargoBatchRejectedErrorMsg = "cargo batch rejected."
+
+type CargoBatchRejectedError struct {
+	domain.BaseError
+
+	failures map[int]error
+}
+
+func NewCargoBatchRejectedError(size int, failures map[int]error) *CargoBatchRejectedError {
+	return &CargoBatchRejectedError{
+		BaseError: domain.NewError(
+			cargoBatchRejectedErrorMsg,
+			errutil.WithMetadataKeyValue("domain.cargo.batch_size", size),
+			errutil.WithMetadataKeyValue("domain.cargo.batch_failures", len(failures)),
+		),
+		failures: failures,
+	}
+}
+
+// Failures returns the error of every rejected cargo indexed by its position on the batch.
+func (e *CargoBatchRejectedError) Failures() map[int]error {
+	return e.failures
+}
+
+func AsCargoBatchRejectedError(err error) (*CargoBatchRejectedError, bool) {
+	var self *CargoBatchRejectedError
+	return self, errors.As(err, &self)
+}
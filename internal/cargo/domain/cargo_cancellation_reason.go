@@ -0,0 +1,50 @@
+package cargodomain
+
+import (
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	CancellationReasonCustomerRequest   CancellationReason = "customer_request"
+	CancellationReasonDamaged           CancellationReason = "damaged"
+	CancellationReasonVesselUnavailable CancellationReason = "vessel_unavailable"
+	CancellationReasonCustomsRejected   CancellationReason = "customs_rejected"
+	CancellationReasonOther             CancellationReason = "other"
+)
+
+var (
+	validCancellationReasons = map[CancellationReason]struct{}{
+		CancellationReasonCustomerRequest:   {},
+		CancellationReasonDamaged:           {},
+		CancellationReasonVesselUnavailable: {},
+		CancellationReasonCustomsRejected:   {},
+		CancellationReasonOther:             {},
+	}
+
+	ErrInvalidCancellationReasonProvided = domainvalidation.NewError("invalid cargo cancellation reason provided")
+)
+
+type CancellationReason string
+
+func NewCancellationReason(reason string) (CancellationReason, error) {
+	reason = strings.ToLower(reason)
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[CancellationReason](),
+		domainvalidation.InMap(validCancellationReasons),
+	)
+
+	r := CancellationReason(reason)
+
+	if err := validator.Validate(r); err != nil {
+		return "", ErrInvalidCancellationReasonProvided.Wrap(err)
+	}
+
+	return r, nil
+}
+
+func (r CancellationReason) String() string {
+	return string(r)
+}
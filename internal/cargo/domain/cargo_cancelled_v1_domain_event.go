@@ -0,0 +1,66 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoCancelledV1DomainEventName = "cargo-cancelled-v1"
+)
+
+type CargoCancelledV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	previousStatus string
+	reason         string
+	occurredOn     time.Time
+}
+
+func (e *CargoCancelledV1DomainEvent) PreviousStatus() string {
+	return e.previousStatus
+}
+
+func (e *CargoCancelledV1DomainEvent) Reason() string {
+	return e.reason
+}
+
+func (e *CargoCancelledV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+func NewCargoCancelledV1DomainEvent(
+	id CargoID,
+	previousStatus Status,
+	reason CancellationReason,
+	occurredOn time.Time,
+) (*CargoCancelledV1DomainEvent, error) {
+	attributes := map[string]any{
+		"previous_status": previousStatus.String(),
+		"reason":          reason.String(),
+		"occurred_on":     occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo cancelled v1 domain event: %w", err)
+	}
+
+	return &CargoCancelledV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoCancelledV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		previousStatus: previousStatus.String(),
+		reason:         reason.String(),
+		occurredOn:     occurredOn,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoCreatedV1DomainEventName = "cargo-created-v1"
+)
+
+type CargoCreatedV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	vesselID   string
+	weight     uint64
+	occurredOn time.Time
+}
+
+func (e *CargoCreatedV1DomainEvent) VesselID() string {
+	return e.vesselID
+}
+
+func (e *CargoCreatedV1DomainEvent) Weight() uint64 {
+	return e.weight
+}
+
+func (e *CargoCreatedV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+func NewCargoCreatedV1DomainEvent(
+	id CargoID,
+	vesselID VesselID,
+	items Items,
+	occurredOn time.Time,
+) (*CargoCreatedV1DomainEvent, error) {
+	attributes := map[string]any{
+		"vessel_id":   vesselID.String(),
+		"items":       items.eventAttributes(),
+		"weight":      items.Weight(),
+		"occurred_on": occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo created v1 domain event: %w", err)
+	}
+
+	return &CargoCreatedV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoCreatedV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		vesselID:   vesselID.String(),
+		weight:     items.Weight(),
+		occurredOn: occurredOn,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
 )
 
@@ -17,32 +18,70 @@ type CargoCreateInput struct {
 	ID       string
 	VesselID string
 	Items    []struct {
-		Name   string
-		Weight uint64
+		Name        string
+		Weight      uint64
+		UNNumber    string
+		HazardClass string
+		Length      uint64
+		Width       uint64
+		Height      uint64
 	}
-	At time.Time
+	Shipper   *CargoPartyInput
+	Consignee *CargoPartyInput
+	Metadata  map[string]string
+	At        time.Time
 }
 type CargoCreator struct {
-	repository  CargoRepository
-	idProvider  utils.ULIDProvider
-	vesselCheck CargoVesselChecker
+	repository    CargoRepository
+	idProvider    utils.ULIDProvider
+	vesselCheck   CargoVesselChecker
+	capacityGuard *CargoVesselCapacityGuard
+	publisher     domain.EventPublisher
 }
 
-func NewCargoCreator(repository CargoRepository, checker CargoVesselChecker, idProvider utils.ULIDProvider) *CargoCreator {
+func NewCargoCreator(
+	repository CargoRepository,
+	checker CargoVesselChecker,
+	idProvider utils.ULIDProvider,
+	publisher domain.EventPublisher,
+) *CargoCreator {
 	return &CargoCreator{
-		repository:  repository,
-		vesselCheck: checker,
-		idProvider:  idProvider,
+		repository:    repository,
+		vesselCheck:   checker,
+		idProvider:    idProvider,
+		capacityGuard: NewCargoVesselCapacityGuard(repository),
+		publisher:     publisher,
 	}
 }
 
 func (cc *CargoCreator) Create(ctx context.Context, input CargoCreateInput) (*Cargo, error) {
+	cargo, err := cc.build(ctx, input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events := cargo.PullEvents()
+	if saveErr := cc.repository.Save(ctx, cargo); saveErr != nil {
+		return nil, fmt.Errorf("error saving cargo: %w", saveErr)
+	}
+
+	if publishErr := cc.publisher.Publish(ctx, events...); publishErr != nil {
+		return nil, fmt.Errorf("error publishing cargo events: %w", publishErr)
+	}
+
+	return cargo, nil
+}
+
+// build runs every creation check and builds the cargo without saving it. The pending
+// weight, in grams, is the one already headed to each vessel which isn't saved yet.
+func (cc *CargoCreator) build(ctx context.Context, input CargoCreateInput, pending map[VesselID]uint64) (*Cargo, error) {
 	vesselID, err := NewVesselID(input.VesselID)
 	if err != nil {
 		return nil, err
 	}
 
-	if vesselCheckErr := cc.vesselCheck.Check(ctx, vesselID); vesselCheckErr != nil {
+	vessel, vesselCheckErr := cc.vesselCheck.Check(ctx, vesselID)
+	if vesselCheckErr != nil {
 		return nil, fmt.Errorf("error checking vessel: %w", vesselCheckErr)
 	}
 
@@ -65,15 +104,28 @@ func (cc *CargoCreator) Create(ctx context.Context, input CargoCreateInput) (*Ca
 		return nil, fmt.Errorf("error creating cargo items: %w", err)
 	}
 
+	parties, err := newCargoPartiesFromInput(input.Shipper, input.Consignee)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := NewMetadata(input.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if guardErr := cc.capacityGuard.Guard(ctx, vessel, cargoItems.Weight()+pending[vesselID]); guardErr != nil {
+		return nil, guardErr
+	}
+
 	trackingID, err := cargotrackingdomain.NewTrackingID(cc.idProvider.New().String())
 	if err != nil {
 		return nil, fmt.Errorf("error creating tracking id: %w", err)
 	}
 
-	cargo := NewCargo(id, vesselID, trackingID, cargoItems, input.At)
-
-	if saveErr := cc.repository.Save(ctx, cargo); saveErr != nil {
-		return nil, fmt.Errorf("error saving cargo: %w", saveErr)
+	cargo, err := NewCargo(id, vessel, trackingID, cargoItems, parties, metadata, input.At)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cargo: %w", err)
 	}
 
 	return cargo, nil
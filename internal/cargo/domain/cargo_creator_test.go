@@ -10,16 +10,21 @@ import (
 
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+	messagingmock "github.com/soulcodex/deus-cargo-tracker/pkg/messaging/mock"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
 )
 
 func TestCargoCreator_Create(t *testing.T) {
 	ctx, timeProvider, idProvider := context.Background(), utils.NewFixedTimeProvider(), utils.NewFixedULIDProvider()
 
+	const vesselCapacity = 1 // in kilograms
+
 	tests := []struct {
 		name          string
 		input         cargodomain.CargoCreateInput
 		setupMocks    func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock)
+		publishErr    error
 		expectedError string
 	}{
 		{
@@ -27,43 +32,279 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
+					{Name: "Fuel", Weight: 100},
+					{Name: "Supplies", Weight: 50},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
+				repo.SaveFunc = func(ctx context.Context, c *cargodomain.Cargo) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "should create cargo with its shipper and consignee",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
 					{Name: "Fuel", Weight: 100},
+				},
+				Shipper: &cargodomain.CargoPartyInput{
+					Name:    "Acme Exports",
+					Email:   "Shipping@Acme.example",
+					Address: "1 Harbour Road, Rotterdam",
+				},
+				Consignee: &cargodomain.CargoPartyInput{
+					Name:    "Globex Imports",
+					Email:   "receiving@globex.example",
+					Address: "42 Dock Street, Valencia",
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
+				repo.SaveFunc = func(ctx context.Context, c *cargodomain.Cargo) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "should create cargo with compatible dangerous goods",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Paint", Weight: 100, UNNumber: "un1263", HazardClass: "3"},
+					{Name: "Batteries", Weight: 50, UNNumber: "UN3480", HazardClass: "9"},
 					{Name: "Supplies", Weight: 50},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
+				repo.SaveFunc = func(ctx context.Context, c *cargodomain.Cargo) error {
 					return nil
 				}
+			},
+		},
+		{
+			name: "should fail when dangerous goods UN number is malformed",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Paint", Weight: 100, UNNumber: "1263", HazardClass: "3"},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "item dangerous goods declaration is invalid",
+		},
+		{
+			name: "should fail when dangerous goods hazard class is unknown",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Paint", Weight: 100, UNNumber: "UN1263", HazardClass: "10"},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "item dangerous goods declaration is invalid",
+		},
+		{
+			name: "should fail when dangerous goods must be segregated",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Fireworks", Weight: 100, UNNumber: "UN0336", HazardClass: "1.4"},
+					{Name: "Paint", Weight: 100, UNNumber: "UN1263", HazardClass: "3"},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "items hazard classes must be segregated",
+		},
+		{
+			name: "should fail when item dimensions are partially declared",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Pillows", Weight: 100, Length: 40, Width: 30},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "item dimensions are invalid",
+		},
+		{
+			name: "should create cargo with an item of the max dimensions",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Sofa", Weight: 500, Length: 300, Width: 300, Height: 300},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
 				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return nil, nil
 				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
 				repo.SaveFunc = func(ctx context.Context, c *cargodomain.Cargo) error {
 					return nil
 				}
 			},
 		},
+		{
+			name: "should fail when items exceed the max cargo volume",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Sofa", Weight: 5000, Length: 300, Width: 300, Height: 300},
+					{Name: "Wardrobe", Weight: 5000, Length: 300, Width: 300, Height: 300},
+					{Name: "Piano", Weight: 5000, Length: 300, Width: 300, Height: 300},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "total volume of items is invalid",
+		},
+		{
+			name: "should fail when shipper contact email is invalid",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Fuel", Weight: 100},
+				},
+				Shipper: &cargodomain.CargoPartyInput{
+					Name:    "Acme Exports",
+					Email:   "not-an-email",
+					Address: "1 Harbour Road, Rotterdam",
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "invalid cargo shipper provided",
+		},
+		{
+			name: "should fail when consignee address is missing",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Fuel", Weight: 100},
+				},
+				Consignee: &cargodomain.CargoPartyInput{
+					Name:  "Globex Imports",
+					Email: "receiving@globex.example",
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+			},
+			expectedError: "invalid cargo consignee provided",
+		},
 		{
 			name: "should fail when vessel check fails",
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "Fuel", Weight: 100},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return errors.New("vessel validation failed")
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.CargoVessel{}, errors.New("vessel validation failed")
 				}
 			},
 			expectedError: "error checking vessel",
@@ -73,17 +314,14 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       "!!!invalid-id###",
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "Fuel", Weight: 100},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return nil
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
 				}
 			},
 			expectedError: "invalid cargo id",
@@ -93,17 +331,14 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "Fuel", Weight: 100},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return nil
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
 				}
 				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return &cargodomain.Cargo{}, nil
@@ -116,17 +351,14 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "Fuel", Weight: 100},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return nil
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
 				}
 				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return nil, errors.New("db lookup failed")
@@ -139,17 +371,14 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "", Weight: 0},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return nil
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
 				}
 				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return nil, nil
@@ -162,10 +391,7 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "A", Weight: 100}, {Name: "B", Weight: 100}, {Name: "C", Weight: 100},
 					{Name: "D", Weight: 100}, {Name: "E", Weight: 100}, {Name: "F", Weight: 100},
 					{Name: "G", Weight: 100}, {Name: "H", Weight: 100}, {Name: "I", Weight: 100},
@@ -174,8 +400,8 @@ func TestCargoCreator_Create(t *testing.T) {
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return nil
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
 				}
 				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return nil, nil
@@ -188,39 +414,117 @@ func TestCargoCreator_Create(t *testing.T) {
 			input: cargodomain.CargoCreateInput{
 				ID:       idProvider.New().String(),
 				VesselID: idProvider.New().String(),
-				Items: []struct {
-					Name   string
-					Weight uint64
-				}{
+				Items: rawCargoItems{
 					{Name: "Fuel", Weight: 100},
 				},
 				At: timeProvider.Now(),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
-				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) error {
-					return nil
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
 				}
 				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return nil, nil
 				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
 				repo.SaveFunc = func(ctx context.Context, c *cargodomain.Cargo) error {
 					return errors.New("db error")
 				}
 			},
 			expectedError: "error saving cargo: db error",
 		},
+		{
+			name: "should fail if cargo events can't be published",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Fuel", Weight: 100},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
+				repo.SaveFunc = func(ctx context.Context, c *cargodomain.Cargo) error {
+					return nil
+				}
+			},
+			publishErr:    errors.New("broker unavailable"),
+			expectedError: "error publishing cargo events: broker unavailable",
+		},
+		{
+			name: "should fail when cargo exceeds the vessel remaining capacity",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Fuel", Weight: 300},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 800, nil
+				}
+			},
+			expectedError: "cargo exceeds vessel capacity",
+		},
+		{
+			name: "should fail if vessel loaded weight can't be calculated",
+			input: cargodomain.CargoCreateInput{
+				ID:       idProvider.New().String(),
+				VesselID: idProvider.New().String(),
+				Items: rawCargoItems{
+					{Name: "Fuel", Weight: 100},
+				},
+				At: timeProvider.Now(),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, checker *cargodomainmock.CargoVesselCheckerMock) {
+				checker.CheckFunc = func(ctx context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				repo.FindFunc = func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return nil, nil
+				}
+				repo.ActiveWeightByVesselFunc = func(ctx context.Context, id cargodomain.VesselID) (uint64, error) {
+					return 0, errors.New("db error")
+				}
+			},
+			expectedError: "error calculating vessel loaded weight: db error",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &cargodomainmock.CargoRepositoryMock{}
 			checker := &cargodomainmock.CargoVesselCheckerMock{}
+			publisher := &messagingmock.PublisherMock{
+				PublishFunc: func(_ context.Context, _ ...messaging.Message) error {
+					return tt.publishErr
+				},
+			}
 
 			if tt.setupMocks != nil {
 				tt.setupMocks(repo, checker)
 			}
 
-			creator := cargodomain.NewCargoCreator(repo, checker, idProvider)
+			creator := cargodomain.NewCargoCreator(repo, checker, idProvider, publisher)
 			cargo, err := creator.Create(ctx, tt.input)
 
 			if tt.expectedError != "" {
@@ -229,7 +533,16 @@ func TestCargoCreator_Create(t *testing.T) {
 				assert.Nil(t, cargo)
 			} else {
 				require.NoError(t, err)
-				assert.NotNil(t, cargo)
+				require.NotNil(t, cargo)
+
+				primitives := cargo.Primitives()
+				assert.Equal(t, tt.input.Shipper != nil, primitives.Shipper != nil)
+				assert.Equal(t, tt.input.Consignee != nil, primitives.Consignee != nil)
+
+				require.Len(t, publisher.PublishCalls(), 1)
+				events := publisher.PublishCalls()[0].Messages
+				require.Len(t, events, 1)
+				assert.Equal(t, cargodomain.CargoCreatedV1DomainEventName, events[0].Type())
 			}
 		})
 	}
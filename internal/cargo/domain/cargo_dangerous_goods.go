@@ -0,0 +1,135 @@
+package cargodomain
+
+import (
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	unNumberPattern = `^UN[0-9]{4}$`
+
+	explosivesHazardClassPrefix = "1."
+	explosivesSegregationGroup  = "1"
+)
+
+var (
+	validHazardClasses = map[HazardClass]struct{}{
+		"1.1": {}, "1.2": {}, "1.3": {}, "1.4": {}, "1.5": {}, "1.6": {},
+		"2.1": {}, "2.2": {}, "2.3": {},
+		"3":   {},
+		"4.1": {}, "4.2": {}, "4.3": {},
+		"5.1": {}, "5.2": {},
+		"6.1": {}, "6.2": {},
+		"7": {},
+		"8": {},
+		"9": {},
+	}
+
+	// hazardClassSegregation is a simplified version of the IMDG Code segregation table,
+	// classes listed here must be at least "separated from" each other so they can't
+	// travel in the same cargo. Every explosives division shares the same group.
+	hazardClassSegregation = map[string][]string{
+		explosivesSegregationGroup: {
+			"2.1", "2.2", "2.3", "3", "4.1", "4.2", "4.3", "5.1", "5.2", "6.1", "6.2", "7", "8",
+		},
+		"2.1": {"4.2", "5.1", "5.2", "6.2", "7"},
+		"2.3": {"5.2"},
+		"3":   {"4.2", "5.1", "5.2", "6.2", "7"},
+		"4.1": {"5.1", "5.2", "6.2", "7"},
+		"4.2": {"5.1", "5.2", "6.2", "7"},
+		"4.3": {"5.1", "5.2", "6.2", "7"},
+		"5.1": {"5.2", "6.2", "7", "8"},
+		"5.2": {"6.2", "7", "8"},
+		"6.2": {"7", "8"},
+		"7":   {"8"},
+	}
+)
+
+// HazardClass is the IMDG class (or division) of a dangerous good, e.g. "3" or "2.1".
+type HazardClass string
+
+func (h HazardClass) String() string {
+	return string(h)
+}
+
+func (h HazardClass) segregationGroup() string {
+	if strings.HasPrefix(h.String(), explosivesHazardClassPrefix) {
+		return explosivesSegregationGroup
+	}
+
+	return h.String()
+}
+
+// isCompatibleWith tells whether both classes can be stowed together on the same cargo.
+func (h HazardClass) isCompatibleWith(other HazardClass) bool {
+	group, otherGroup := h.segregationGroup(), other.segregationGroup()
+
+	for _, segregated := range hazardClassSegregation[group] {
+		if segregated == otherGroup {
+			return false
+		}
+	}
+
+	for _, segregated := range hazardClassSegregation[otherGroup] {
+		if segregated == group {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeUNNumber(raw string) string {
+	return strings.ToUpper(strings.TrimSpace(raw))
+}
+
+func normalizeHazardClass(raw string) HazardClass {
+	return HazardClass(strings.TrimSpace(raw))
+}
+
+func dangerousGoodsValidator() *domainvalidation.Validator[Item] {
+	return domainvalidation.NewValidator(
+		func(i Item) *domainvalidation.Error {
+			if err := domainvalidation.NewValidator(
+				domainvalidation.NotEmpty[string](),
+				domainvalidation.Regex(unNumberPattern),
+			).Validate(i.unNumber); err != nil {
+				return domainvalidation.NewError("item UN number is invalid").Wrap(err)
+			}
+
+			return nil
+		},
+		func(i Item) *domainvalidation.Error {
+			if err := domainvalidation.NewValidator(
+				domainvalidation.NotEmpty[HazardClass](),
+				domainvalidation.InMap(validHazardClasses),
+			).Validate(i.hazardClass); err != nil {
+				return domainvalidation.NewError("item hazard class is invalid").Wrap(err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func hazardClassesSegregationRule(i Items) *domainvalidation.Error {
+	dangerous := make([]Item, 0, len(i))
+	for _, item := range i {
+		if item.IsDangerous() {
+			dangerous = append(dangerous, item)
+		}
+	}
+
+	for idx, item := range dangerous {
+		for _, other := range dangerous[idx+1:] {
+			if !item.hazardClass.isCompatibleWith(other.hazardClass) {
+				return domainvalidation.NewError("items hazard classes must be segregated").
+					WithRuleName("hazard_class_segregation").
+					WithRuleValue([]string{item.hazardClass.String(), other.hazardClass.String()})
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package cargodomain
+
+import (
+	"time"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+)
+
+const (
+	// MaxStalledCargoesPerSearch bounds the stalled cargoes flagged on every detection run,
+	// the remaining ones are picked by the following runs.
+	MaxStalledCargoesPerSearch uint64 = 100
+)
+
+var (
+	ErrCargoNotDelayed = domain.NewError("cargo is not delayed")
+)
+
+// StalledCargo is an in transit cargo which status didn't change since StatusSince and
+// whose delay was not flagged yet, as of its Version.
+type StalledCargo struct {
+	ID          CargoID
+	StatusSince time.Time
+	Version     uint64
+}
+
+// WithDelayDetected flags an in transit cargo kept in that status for longer than the given threshold.
+// The cargo status is not changed, hence neither its update time.
+func WithDelayDetected(trackingID string, statusSince time.Time, threshold time.Duration, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		if !c.status.Equals(StatusInTransit) || at.Sub(statusSince) < threshold {
+			return ErrCargoNotDelayed
+		}
+
+		newTrackingID, err := cargotrackingdomain.NewTrackingID(trackingID)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		tracking := cargotrackingdomain.NewTrackingOnCargoDelayDetected(
+			newTrackingID,
+			at,
+			c.status.String(),
+			statusSince,
+			threshold,
+		)
+		c.appendTracking(tracking)
+
+		event, err := NewCargoDelayedV1DomainEvent(c.id, c.status, statusSince, threshold, at)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		c.RecordEvent(event)
+
+		return nil
+	}
+}
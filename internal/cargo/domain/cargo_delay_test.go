@@ -0,0 +1,80 @@
+package cargodomain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestCargo_WithDelayDetected(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 8, 0, 0, 0, time.UTC)
+	updatedAt := now.Add(-96 * time.Hour)
+	threshold := 72 * time.Hour
+
+	tests := []struct {
+		name          string
+		status        string
+		statusSince   time.Time
+		expectedError error
+	}{
+		{
+			name:        "should flag an in transit cargo stalled for longer than the threshold",
+			status:      "in_transit",
+			statusSince: now.Add(-threshold - time.Minute),
+		},
+		{
+			name:          "should not flag a cargo within the threshold",
+			status:        "in_transit",
+			statusSince:   now.Add(-threshold + time.Minute),
+			expectedError: cargodomain.ErrCargoNotDelayed,
+		},
+		{
+			name:          "should not flag a cargo no longer in transit",
+			status:        "at_port",
+			statusSince:   now.Add(-threshold - time.Minute),
+			expectedError: cargodomain.ErrCargoNotDelayed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cargo := cargotest.NewCargoMother(
+				cargotest.WithID(utils.NewULID().String()),
+				cargotest.WithStatus(tt.status),
+				cargotest.WithTimestamps(updatedAt, updatedAt),
+			).Build(t)
+
+			trackingID := utils.NewULID().String()
+			err := cargo.Update(context.Background(), cargodomain.WithDelayDetected(trackingID, tt.statusSince, threshold, now))
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, cargo.Tracking())
+				assert.Empty(t, cargo.PullEvents())
+				return
+			}
+
+			require.NoError(t, err)
+
+			primitives := cargo.Primitives()
+			require.Len(t, primitives.Tracking, 1)
+			assert.Equal(t, trackingID, primitives.Tracking[0].ID)
+			assert.Equal(t, cargotrackingdomain.TrackingEntryTypeDelayDetected.String(), primitives.Tracking[0].EntryType)
+			assert.Equal(t, tt.status, *primitives.Tracking[0].StatusAfter)
+			assert.Equal(t, tt.statusSince.Format(time.RFC3339), primitives.Tracking[0].Details["status_since"])
+			assert.Equal(t, updatedAt, primitives.UpdatedAt, "the update time must be kept")
+
+			events := cargo.PullEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, cargodomain.CargoDelayedV1DomainEventName, events[0].Type())
+		})
+	}
+}
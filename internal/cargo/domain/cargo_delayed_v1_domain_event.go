@@ -0,0 +1,74 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoDelayedV1DomainEventName = "cargo-delayed-v1"
+)
+
+type CargoDelayedV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	status      string
+	statusSince time.Time
+	threshold   time.Duration
+	occurredOn  time.Time
+}
+
+func (e *CargoDelayedV1DomainEvent) Status() string {
+	return e.status
+}
+
+func (e *CargoDelayedV1DomainEvent) StatusSince() time.Time {
+	return e.statusSince
+}
+
+func (e *CargoDelayedV1DomainEvent) Threshold() time.Duration {
+	return e.threshold
+}
+
+func (e *CargoDelayedV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+func NewCargoDelayedV1DomainEvent(
+	id CargoID,
+	status Status,
+	statusSince time.Time,
+	threshold time.Duration,
+	occurredOn time.Time,
+) (*CargoDelayedV1DomainEvent, error) {
+	attributes := map[string]any{
+		"status":            status.String(),
+		"status_since":      statusSince.Format(time.RFC3339),
+		"threshold_seconds": int64(threshold.Seconds()),
+		"occurred_on":       occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo delayed v1 domain event: %w", err)
+	}
+
+	return &CargoDelayedV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoDelayedV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		status:      status.String(),
+		statusSince: statusSince,
+		threshold:   threshold,
+		occurredOn:  occurredOn,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package cargodomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const cargoExceedsVesselCapacityErrorMsg = "cargo exceeds vessel capacity."
+
+type CargoExceedsVesselCapacityError struct {
+	domain.BaseError
+}
+
+func NewCargoExceedsVesselCapacityError(
+	vesselID VesselID,
+	capacity uint64,
+	loadedWeight uint64,
+	incomingWeight uint64,
+) *CargoExceedsVesselCapacityError {
+	return &CargoExceedsVesselCapacityError{
+		BaseError: domain.NewError(
+			cargoExceedsVesselCapacityErrorMsg,
+			errutil.WithMetadataKeyValue("domain.cargo.vessel_id", vesselID.String()),
+			errutil.WithMetadataKeyValue("domain.cargo.vessel_capacity", capacity),
+			errutil.WithMetadataKeyValue("domain.cargo.vessel_loaded_weight", loadedWeight),
+			errutil.WithMetadataKeyValue("domain.cargo.weight", incomingWeight),
+		),
+	}
+}
+
+func IsCargoExceedsVesselCapacityError(err error) bool {
+	var self *CargoExceedsVesselCapacityError
+	return errors.As(err, &self)
+}
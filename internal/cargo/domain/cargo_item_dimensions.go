@@ -0,0 +1,66 @@
+package cargodomain
+
+import (
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	MinItemDimension uint64 = 1   // in centimeters
+	MaxItemDimension uint64 = 300 // in centimeters
+	// MaxCargoVolume is roughly the inner volume of a 40ft high cube container, enough room for an
+	// item of the max dimensions.
+	MaxCargoVolume uint64 = 76000000 // in cubic centimeters
+
+	// VolumetricWeightFactor follows the 1:1000 sea freight ratio, a cubic meter is charged as a ton.
+	VolumetricWeightFactor uint64 = 1 // in grams per cubic centimeter
+)
+
+// ItemDimensions are the optional outer measures of an item, a zero value means they were not declared.
+type ItemDimensions struct {
+	length uint64
+	width  uint64
+	height uint64
+}
+
+func NewItemDimensions(length, width, height uint64) ItemDimensions {
+	return ItemDimensions{length: length, width: width, height: height}
+}
+
+func (d ItemDimensions) Length() uint64 {
+	return d.length
+}
+
+func (d ItemDimensions) Width() uint64 {
+	return d.width
+}
+
+func (d ItemDimensions) Height() uint64 {
+	return d.height
+}
+
+func (d ItemDimensions) IsZero() bool {
+	return d == ItemDimensions{}
+}
+
+// Volume returns the item volume in cubic centimeters.
+func (d ItemDimensions) Volume() uint64 {
+	return d.length * d.width * d.height
+}
+
+func itemDimensionsValidator() *domainvalidation.Validator[ItemDimensions] {
+	rule := func(name string, value func(d ItemDimensions) uint64) domainvalidation.ValidationRule[ItemDimensions] {
+		return func(d ItemDimensions) *domainvalidation.Error {
+			if err := domainvalidation.WithinBounds(MinItemDimension, MaxItemDimension)(value(d)); err != nil {
+				return domainvalidation.NewError("item " + name + " is invalid").Wrap(err)
+			}
+
+			return nil
+		}
+	}
+
+	return domainvalidation.NewValidator(
+		rule("length", ItemDimensions.Length),
+		rule("width", ItemDimensions.Width),
+		rule("height", ItemDimensions.Height),
+	)
+}
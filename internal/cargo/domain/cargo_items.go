@@ -1,11 +1,15 @@
 package cargodomain
 
 import (
+	"slices"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
 	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
 )
 
 var (
 	ErrInvalidItemsProvided = domainvalidation.NewError("invalid items provided")
+	ErrCargoItemNotFound    = domain.NewError("cargo item not found")
 )
 
 const (
@@ -32,16 +36,41 @@ func NewItems(items ...Item) (Items, error) {
 }
 
 func newItemsFromRaw(items []struct {
-	Name   string
-	Weight uint64
+	Name        string
+	Weight      uint64
+	UNNumber    string
+	HazardClass string
+	Length      uint64
+	Width       uint64
+	Height      uint64
 }) (Items, error) {
-	var domainItems []Item
+	return NewItems(itemsFromRaw(items)...)
+}
+
+// itemsFromRaw maps raw items without validating them, meant for partial lists
+// that are validated once merged into the final cargo items.
+func itemsFromRaw(items []struct {
+	Name        string
+	Weight      uint64
+	UNNumber    string
+	HazardClass string
+	Length      uint64
+	Width       uint64
+	Height      uint64
+}) Items {
+	var domainItems Items
 
 	for _, item := range items {
-		domainItems = append(domainItems, newItem(item.Name, item.Weight))
+		domainItems = append(domainItems, newItem(
+			item.Name,
+			item.Weight,
+			normalizeUNNumber(item.UNNumber),
+			normalizeHazardClass(item.HazardClass),
+			NewItemDimensions(item.Length, item.Width, item.Height),
+		))
 	}
 
-	return NewItems(domainItems...)
+	return domainItems
 }
 
 func (i Items) Len() int {
@@ -58,6 +87,47 @@ func (i Items) Weight() uint64 {
 	return total
 }
 
+// Volume returns the total volume of the items declaring their dimensions, in cubic centimeters.
+func (i Items) Volume() uint64 {
+	var total uint64
+
+	for _, item := range i {
+		total += item.dimensions.Volume()
+	}
+
+	return total
+}
+
+// VolumetricWeight returns the weight the items are charged by according to the space they take.
+func (i Items) VolumetricWeight() uint64 {
+	return i.Volume() * VolumetricWeightFactor
+}
+
+// ChargeableWeight returns the greater of the actual and the volumetric weight.
+func (i Items) ChargeableWeight() uint64 {
+	return max(i.Weight(), i.VolumetricWeight())
+}
+
+// eventAttributes returns the items as they're shared on the domain events payload.
+func (i Items) eventAttributes() []map[string]any {
+	attributes := make([]map[string]any, len(i))
+	for idx, item := range i {
+		attributes[idx] = map[string]any{"name": item.name, "weight": item.weight}
+		if item.IsDangerous() {
+			attributes[idx]["un_number"] = item.unNumber
+			attributes[idx]["hazard_class"] = item.hazardClass.String()
+		}
+
+		if !item.dimensions.IsZero() {
+			attributes[idx]["length"] = item.dimensions.length
+			attributes[idx]["width"] = item.dimensions.width
+			attributes[idx]["height"] = item.dimensions.height
+		}
+	}
+
+	return attributes
+}
+
 func itemsValidator() *domainvalidation.Validator[Items] {
 	return domainvalidation.NewValidator(
 		func(i Items) *domainvalidation.Error {
@@ -76,6 +146,14 @@ func itemsValidator() *domainvalidation.Validator[Items] {
 
 			return nil
 		},
+		func(i Items) *domainvalidation.Error {
+			err := domainvalidation.Max(MaxCargoVolume)(i.Volume())
+			if err != nil {
+				return domainvalidation.NewError("total volume of items is invalid").Wrap(err)
+			}
+
+			return nil
+		},
 		func(i Items) *domainvalidation.Error {
 			for _, item := range i {
 				if err := domainvalidation.NewValidator(
@@ -91,20 +169,87 @@ func itemsValidator() *domainvalidation.Validator[Items] {
 				).Validate(item.weight); err != nil {
 					return domainvalidation.NewError("item weight is invalid").Wrap(err)
 				}
+
+				if !item.dimensions.IsZero() {
+					if err := itemDimensionsValidator().Validate(item.dimensions); err != nil {
+						return domainvalidation.NewError("item dimensions are invalid").Wrap(err)
+					}
+				}
+
+				if !item.IsDangerous() {
+					continue
+				}
+
+				if err := dangerousGoodsValidator().Validate(item); err != nil {
+					return domainvalidation.NewError("item dangerous goods declaration is invalid").Wrap(err)
+				}
 			}
 			return nil
 		},
+		hazardClassesSegregationRule,
 	)
 }
 
 type Item struct {
 	name   string
 	weight uint64
+
+	// unNumber and hazardClass are only declared for dangerous goods.
+	unNumber    string
+	hazardClass HazardClass
+	dimensions  ItemDimensions
 }
 
-func newItem(name string, weight uint64) Item {
+func newItem(name string, weight uint64, unNumber string, hazardClass HazardClass, dimensions ItemDimensions) Item {
 	return Item{
-		name:   name,
-		weight: weight,
+		name:        name,
+		weight:      weight,
+		unNumber:    unNumber,
+		hazardClass: hazardClass,
+		dimensions:  dimensions,
+	}
+}
+
+// IsDangerous tells whether the item was declared as a hazardous material.
+func (i Item) IsDangerous() bool {
+	return i.unNumber != "" || i.hazardClass != ""
+}
+
+// withAdded returns a new list with the given items appended, the receiver is left untouched.
+func (i Items) withAdded(items Items) Items {
+	added := make(Items, 0, len(i)+len(items))
+	added = append(added, i...)
+
+	return append(added, items...)
+}
+
+// withoutNames returns a new list removing one item per given name, an unknown name fails
+// so callers never silently end up with an unexpected manifest.
+func (i Items) withoutNames(names ...string) (Items, error) {
+	_, remaining, err := i.partition(names...)
+	if err != nil {
+		return nil, err
 	}
+
+	return remaining, nil
+}
+
+// partition picks one item per given name returning them along with the remaining ones, an
+// unknown name fails the same way withoutNames does. The receiver is left untouched.
+func (i Items) partition(names ...string) (Items, Items, error) {
+	picked := make(Items, 0, len(names))
+	remaining := make(Items, len(i))
+	copy(remaining, i)
+
+	for _, name := range names {
+		index := slices.IndexFunc(remaining, func(item Item) bool { return item.name == name })
+		if index < 0 {
+			return nil, nil, ErrCargoItemNotFound
+		}
+
+		picked = append(picked, remaining[index])
+		remaining = slices.Delete(remaining, index, index+1)
+	}
+
+	return picked, remaining, nil
 }
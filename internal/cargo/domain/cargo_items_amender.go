@@ -0,0 +1,106 @@
+package cargodomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CargoItemsAmendInput struct {
+	ID string
+	// VesselID is the vessel whose load the caller holds, empty when any vessel is fine.
+	VesselID   string
+	Operation  string
+	TrackingID string
+	Items      []struct {
+		Name        string
+		Weight      uint64
+		UNNumber    string
+		HazardClass string
+		Length      uint64
+		Width       uint64
+		Height      uint64
+	}
+	At time.Time
+	// ExpectedVersion rejects the amendment unless the cargo is on it, nil means any version.
+	ExpectedVersion *uint64
+}
+
+// CargoItemsAmender changes the manifest of a pending cargo ensuring its vessel
+// still has room for it whenever the cargo gets heavier.
+type CargoItemsAmender struct {
+	repository    CargoRepository
+	vesselCheck   CargoVesselChecker
+	capacityGuard *CargoVesselCapacityGuard
+	updater       *CargoUpdater
+}
+
+func NewCargoItemsAmender(
+	repository CargoRepository,
+	checker CargoVesselChecker,
+	updater *CargoUpdater,
+) *CargoItemsAmender {
+	return &CargoItemsAmender{
+		repository:    repository,
+		vesselCheck:   checker,
+		capacityGuard: NewCargoVesselCapacityGuard(repository),
+		updater:       updater,
+	}
+}
+
+func (a *CargoItemsAmender) Amend(ctx context.Context, input CargoItemsAmendInput) error {
+	cargoID, err := NewCargoID(input.ID)
+	if err != nil {
+		return ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	operation, err := NewItemsOperation(input.Operation)
+	if err != nil {
+		return ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	cargo, err := a.repository.Find(ctx, cargoID)
+	if err != nil {
+		return ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	if vesselErr := ensureCargoOnVessel(cargo, input.VesselID); vesselErr != nil {
+		return ErrCargoUpdateFailed.Wrap(vesselErr)
+	}
+
+	weightBefore := cargo.items.Weight()
+	a.updater.locate(ctx, cargo)
+	if updateErr := cargo.Update(ctx, WithExpectedVersion(input.ExpectedVersion), newItemsUpdateOpt(operation, input)); updateErr != nil {
+		return ErrCargoUpdateFailed.Wrap(updateErr)
+	}
+
+	// The current cargo weight is already loaded on the vessel, only the increment must fit.
+	if weightAfter := cargo.items.Weight(); weightAfter > weightBefore {
+		vessel, checkErr := a.vesselCheck.Check(ctx, cargo.vesselID)
+		if checkErr != nil {
+			return fmt.Errorf("error checking vessel: %w", checkErr)
+		}
+
+		if guardErr := a.capacityGuard.Guard(ctx, vessel, weightAfter-weightBefore); guardErr != nil {
+			return guardErr
+		}
+	}
+
+	return a.updater.persist(ctx, cargo)
+}
+
+func newItemsUpdateOpt(operation ItemsOperation, input CargoItemsAmendInput) CargoUpdateOpt {
+	switch operation {
+	case ItemsOperationRemove:
+		names := make([]string, len(input.Items))
+		for i, item := range input.Items {
+			names[i] = item.Name
+		}
+
+		return WithItemsRemoved(input.TrackingID, names, input.At)
+	case ItemsOperationReplace:
+		return WithItemsReplaced(input.TrackingID, input.Items, input.At)
+	default:
+		return WithItemsAdded(input.TrackingID, input.Items, input.At)
+	}
+}
@@ -0,0 +1,264 @@
+package cargodomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+	messagingmock "github.com/soulcodex/deus-cargo-tracker/pkg/messaging/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+type rawCargoItems = []struct {
+	Name        string
+	Weight      uint64
+	UNNumber    string
+	HazardClass string
+	Length      uint64
+	Width       uint64
+	Height      uint64
+}
+
+func TestCargoItemsAmender_Amend(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	const (
+		cargoID        = "01K43FJ8ZCYAVQ14ZV7EKCPMR8"
+		vesselID       = "01K4B43REGN4HBFQETVZZ484A3"
+		vesselCapacity = 10 // in kilograms
+	)
+
+	type mocks struct {
+		repo      *cargodomainmock.CargoRepositoryMock
+		checker   *cargodomainmock.CargoVesselCheckerMock
+		publisher *messagingmock.PublisherMock
+	}
+
+	newCargo := func(opts ...cargotest.CargoMotherOpt) *cargodomain.Cargo {
+		opts = append([]cargotest.CargoMotherOpt{
+			cargotest.WithID(cargoID),
+			cargotest.WithVesselID(vesselID),
+			cargotest.WithTimestamps(now, now),
+		}, opts...)
+
+		return cargotest.NewCargoMother(opts...).Build(t)
+	}
+
+	findCargo := func(m mocks, cargo *cargodomain.Cargo) {
+		m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+			return cargo, nil
+		}
+	}
+
+	persistCargo := func(m mocks) {
+		m.repo.SaveFunc = func(_ context.Context, _ *cargodomain.Cargo) error {
+			return nil
+		}
+		m.publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+			return nil
+		}
+	}
+
+	loadVessel := func(m mocks, loadedWeight uint64) {
+		m.checker.CheckFunc = func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+			return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+		}
+		m.repo.ActiveWeightByVesselFunc = func(_ context.Context, _ cargodomain.VesselID) (uint64, error) {
+			return loadedWeight, nil
+		}
+	}
+
+	assertItemsChanged := func(t *testing.T, m mocks, expectedWeight uint64) {
+		t.Helper()
+
+		require.Len(t, m.repo.SaveCalls(), 1)
+		saved := m.repo.SaveCalls()[0].C.Primitives()
+		assert.Equal(t, expectedWeight, saved.Weight)
+		require.Len(t, saved.Tracking, 1)
+		assert.Equal(t, "cargo.items_changed", saved.Tracking[0].EntryType)
+		assert.Equal(t, expectedWeight, saved.Tracking[0].Details["weight_after"])
+
+		require.Len(t, m.publisher.PublishCalls(), 1)
+		events := m.publisher.PublishCalls()[0].Messages
+		require.Len(t, events, 1)
+		assert.Equal(t, cargodomain.CargoItemsChangedV1DomainEventName, events[0].Type())
+	}
+
+	tests := []struct {
+		name          string
+		input         cargodomain.CargoItemsAmendInput
+		cargo         *cargodomain.Cargo
+		setupMocks    func(m mocks, cargo *cargodomain.Cargo)
+		assertion     func(t *testing.T, m mocks)
+		expectedError string
+	}{
+		{
+			name: "should add items when the vessel has room for them",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "add",
+				Items:     rawCargoItems{{Name: "Furniture", Weight: 2500}},
+			},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findCargo(m, cargo)
+				loadVessel(m, 3500)
+				persistCargo(m)
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assertItemsChanged(t, m, 6000)
+				require.Len(t, m.checker.CheckCalls(), 2)
+			},
+		},
+		{
+			name: "should remove items without checking the vessel capacity",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "remove",
+				Items:     rawCargoItems{{Name: "Clothing"}},
+			},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findCargo(m, cargo)
+				persistCargo(m)
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assertItemsChanged(t, m, 1500)
+				assert.Len(t, m.checker.CheckCalls(), 1)
+				assert.Empty(t, m.repo.ActiveWeightByVesselCalls())
+			},
+		},
+		{
+			name: "should replace items",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "replace",
+				Items:     rawCargoItems{{Name: "Books", Weight: 500}},
+			},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findCargo(m, cargo)
+				persistCargo(m)
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assertItemsChanged(t, m, 500)
+			},
+		},
+		{
+			name:          "should fail when operation is unknown",
+			input:         cargodomain.CargoItemsAmendInput{ID: cargoID, Operation: "shuffle"},
+			cargo:         newCargo(),
+			setupMocks:    func(_ mocks, _ *cargodomain.Cargo) {},
+			expectedError: "invalid cargo items operation provided",
+		},
+		{
+			name: "should fail when cargo is not pending",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "add",
+				Items:     rawCargoItems{{Name: "Furniture", Weight: 2500}},
+			},
+			cargo:         newCargo(cargotest.WithStatus(cargodomain.StatusInTransit.String())),
+			setupMocks:    findCargo,
+			expectedError: "items change is only allowed on pending cargoes",
+		},
+		{
+			name: "should fail when removed item does not exist",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "remove",
+				Items:     rawCargoItems{{Name: "Furniture"}},
+			},
+			cargo:         newCargo(),
+			setupMocks:    findCargo,
+			expectedError: "cargo item not found",
+		},
+		{
+			name: "should fail when resulting items are invalid",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "remove",
+				Items:     rawCargoItems{{Name: "Clothing"}, {Name: "Electronics"}},
+			},
+			cargo:         newCargo(),
+			setupMocks:    findCargo,
+			expectedError: "invalid items provided",
+		},
+		{
+			name: "should fail when cargo was moved to another vessel meanwhile",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				VesselID:  "01K4B43REGN4HBFQETVZZ484A4",
+				Operation: "add",
+				Items:     rawCargoItems{{Name: "Furniture", Weight: 2500}},
+			},
+			cargo:      newCargo(),
+			setupMocks: findCargo,
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveCalls())
+			},
+			expectedError: "cargo was moved to another vessel",
+		},
+		{
+			name: "should fail when vessel has no room for the added items",
+			input: cargodomain.CargoItemsAmendInput{
+				ID:        cargoID,
+				Operation: "add",
+				Items:     rawCargoItems{{Name: "Furniture", Weight: 2500}},
+			},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findCargo(m, cargo)
+				loadVessel(m, 9000)
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveCalls())
+			},
+			expectedError: "cargo exceeds vessel capacity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				repo:      &cargodomainmock.CargoRepositoryMock{},
+				checker:   &cargodomainmock.CargoVesselCheckerMock{},
+				publisher: &messagingmock.PublisherMock{},
+			}
+			tt.setupMocks(m, tt.cargo)
+			if m.checker.CheckFunc == nil {
+				m.checker.CheckFunc = func(_ context.Context, _ cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.CargoVessel{}, errors.New("vessel not found")
+				}
+			}
+
+			input := tt.input
+			input.TrackingID, input.At = utils.NewFixedULIDProvider().New().String(), now
+
+			updater := cargodomain.NewCargoUpdater(m.repo, m.checker, m.publisher, zerolog.Nop())
+			amender := cargodomain.NewCargoItemsAmender(m.repo, m.checker, updater)
+			err := amender.Amend(ctx, input)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.assertion != nil {
+				tt.assertion(t, m)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoItemsChangedV1DomainEventName = "cargo-items-changed-v1"
+)
+
+type CargoItemsChangedV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	operation    string
+	weightBefore uint64
+	weightAfter  uint64
+	occurredOn   time.Time
+}
+
+func (e *CargoItemsChangedV1DomainEvent) Operation() string {
+	return e.operation
+}
+
+func (e *CargoItemsChangedV1DomainEvent) WeightBefore() uint64 {
+	return e.weightBefore
+}
+
+func (e *CargoItemsChangedV1DomainEvent) WeightAfter() uint64 {
+	return e.weightAfter
+}
+
+func (e *CargoItemsChangedV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+func NewCargoItemsChangedV1DomainEvent(
+	id CargoID,
+	operation ItemsOperation,
+	itemsBefore Items,
+	itemsAfter Items,
+	occurredOn time.Time,
+) (*CargoItemsChangedV1DomainEvent, error) {
+	attributes := map[string]any{
+		"operation":     operation.String(),
+		"weight_before": itemsBefore.Weight(),
+		"weight_after":  itemsAfter.Weight(),
+		"items":         itemsAfter.eventAttributes(),
+		"occurred_on":   occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo items changed v1 domain event: %w", err)
+	}
+
+	return &CargoItemsChangedV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoItemsChangedV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		operation:    operation.String(),
+		weightBefore: itemsBefore.Weight(),
+		weightAfter:  itemsAfter.Weight(),
+		occurredOn:   occurredOn,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package cargodomain
+
+import (
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	ItemsOperationAdd     ItemsOperation = "add"
+	ItemsOperationRemove  ItemsOperation = "remove"
+	ItemsOperationReplace ItemsOperation = "replace"
+)
+
+var (
+	validItemsOperations = map[ItemsOperation]struct{}{
+		ItemsOperationAdd:     {},
+		ItemsOperationRemove:  {},
+		ItemsOperationReplace: {},
+	}
+
+	ErrInvalidItemsOperationProvided = domainvalidation.NewError("invalid cargo items operation provided")
+)
+
+// ItemsOperation is the kind of amendment applied to the items of a pending cargo.
+type ItemsOperation string
+
+func NewItemsOperation(operation string) (ItemsOperation, error) {
+	operation = strings.ToLower(operation)
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[ItemsOperation](),
+		domainvalidation.InMap(validItemsOperations),
+	)
+
+	op := ItemsOperation(operation)
+
+	if err := validator.Validate(op); err != nil {
+		return "", ErrInvalidItemsOperationProvided.Wrap(err)
+	}
+
+	return op, nil
+}
+
+func (o ItemsOperation) String() string {
+	return string(o)
+}
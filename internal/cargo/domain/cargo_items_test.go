@@ -0,0 +1,55 @@
+package cargodomain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestItems_ChargeableWeight(t *testing.T) {
+	tests := []struct {
+		name                     string
+		items                    []cargodomain.ItemsPrimitives
+		expectedVolume           uint64
+		expectedChargeableWeight uint64
+	}{
+		{
+			name: "should charge the actual weight when items have no dimensions",
+			items: []cargodomain.ItemsPrimitives{
+				{Name: "Electronics", Weight: 1500},
+				{Name: "Clothing", Weight: 2000},
+			},
+			expectedVolume:           0,
+			expectedChargeableWeight: 3500,
+		},
+		{
+			name: "should charge the actual weight when items are dense",
+			items: []cargodomain.ItemsPrimitives{
+				{Name: "Steel", Weight: 9000, Length: 10, Width: 10, Height: 10},
+			},
+			expectedVolume:           1000,
+			expectedChargeableWeight: 9000,
+		},
+		{
+			name: "should charge the volumetric weight when items are bulky",
+			items: []cargodomain.ItemsPrimitives{
+				{Name: "Pillows", Weight: 1500, Length: 40, Width: 30, Height: 20},
+				{Name: "Clothing", Weight: 2000},
+			},
+			expectedVolume:           24000,
+			expectedChargeableWeight: 24000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primitives := cargotest.NewCargoMother(cargotest.WithItems(tt.items...)).Build(t).Primitives()
+
+			assert.Equal(t, tt.expectedVolume, primitives.Volume)
+			assert.Equal(t, tt.expectedChargeableWeight, primitives.ChargeableWeight)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoMergedV1DomainEventName = "cargo-merged-v1"
+)
+
+type CargoMergedV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	sourceCargoID string
+	weightBefore  uint64
+	weightAfter   uint64
+	occurredOn    time.Time
+}
+
+func (e *CargoMergedV1DomainEvent) SourceCargoID() string {
+	return e.sourceCargoID
+}
+
+func (e *CargoMergedV1DomainEvent) WeightBefore() uint64 {
+	return e.weightBefore
+}
+
+func (e *CargoMergedV1DomainEvent) WeightAfter() uint64 {
+	return e.weightAfter
+}
+
+func (e *CargoMergedV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+// NewCargoMergedV1DomainEvent is recorded by the cargo absorbing the source one, once per source.
+func NewCargoMergedV1DomainEvent(
+	id CargoID,
+	sourceCargoID CargoID,
+	itemsBefore Items,
+	itemsAfter Items,
+	occurredOn time.Time,
+) (*CargoMergedV1DomainEvent, error) {
+	attributes := map[string]any{
+		"source_cargo_id": sourceCargoID.String(),
+		"weight_before":   itemsBefore.Weight(),
+		"weight_after":    itemsAfter.Weight(),
+		"items":           itemsAfter.eventAttributes(),
+		"occurred_on":     occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo merged v1 domain event: %w", err)
+	}
+
+	return &CargoMergedV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoMergedV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		sourceCargoID: sourceCargoID.String(),
+		weightBefore:  itemsBefore.Weight(),
+		weightAfter:   itemsAfter.Weight(),
+		occurredOn:    occurredOn,
+	}, nil
+}
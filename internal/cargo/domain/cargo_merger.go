@@ -0,0 +1,150 @@
+package cargodomain
+
+import (
+	"context"
+	"time"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+var (
+	ErrCargoMergeNotAllowed      = domain.NewError("merge is only allowed between distinct pending cargoes on the same vessel")
+	ErrCargoMergePartiesMismatch = domain.NewError("merge is only allowed between cargoes with the same shipper and consignee")
+)
+
+type CargoMergeInput struct {
+	ID        string
+	SourceIDs []string
+	At        time.Time
+}
+
+// CargoMerger consolidates pending cargoes of the same vessel into one of them, the merged ones
+// are removed once their items are moved. Every cargo is saved at once so no item is lost or
+// duplicated, and the vessel load doesn't change so its capacity isn't checked again.
+type CargoMerger struct {
+	repository CargoRepository
+	idProvider utils.ULIDProvider
+	updater    *CargoUpdater
+}
+
+func NewCargoMerger(
+	repository CargoRepository,
+	idProvider utils.ULIDProvider,
+	updater *CargoUpdater,
+) *CargoMerger {
+	return &CargoMerger{
+		repository: repository,
+		idProvider: idProvider,
+		updater:    updater,
+	}
+}
+
+func (m *CargoMerger) Merge(ctx context.Context, input CargoMergeInput) error {
+	if len(input.SourceIDs) == 0 {
+		return ErrCargoUpdateFailed.Wrap(ErrCargoMergeNotAllowed)
+	}
+
+	target, err := m.find(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+
+	m.updater.locate(ctx, target)
+	cargoes := []*Cargo{target}
+	seen := make(map[string]struct{}, len(input.SourceIDs))
+	for _, sourceID := range input.SourceIDs {
+		// Every source is found again, a repeated one would get its items merged twice.
+		if _, repeated := seen[sourceID]; repeated {
+			return ErrCargoUpdateFailed.Wrap(ErrCargoMergeNotAllowed)
+		}
+		seen[sourceID] = struct{}{}
+
+		source, findErr := m.find(ctx, sourceID)
+		if findErr != nil {
+			return findErr
+		}
+
+		trackingIDs, idsErr := newTrackingIDs(m.idProvider, 2)
+		if idsErr != nil {
+			return ErrCargoUpdateFailed.Wrap(idsErr)
+		}
+
+		m.updater.locate(ctx, source)
+		if absorbErr := target.absorb(source, trackingIDs[0], trackingIDs[1], input.At); absorbErr != nil {
+			return ErrCargoUpdateFailed.Wrap(absorbErr)
+		}
+
+		cargoes = append(cargoes, source)
+	}
+
+	return m.updater.persistAll(ctx, cargoes...)
+}
+
+func (m *CargoMerger) find(ctx context.Context, id string) (*Cargo, error) {
+	cargoID, err := NewCargoID(id)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	cargo, err := m.repository.Find(ctx, cargoID)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	return cargo, nil
+}
+
+// absorb moves every item of the source cargo into this one and removes the source, which keeps
+// its items for the record. The resulting manifest must still pass the creation validation.
+func (c *Cargo) absorb(
+	source *Cargo,
+	trackingID, sourceTrackingID cargotrackingdomain.TrackingID,
+	at time.Time,
+) error {
+	for _, cargo := range []*Cargo{c, source} {
+		if isDeleted := cargo.deletedAt != nil; isDeleted {
+			return NewCargoNotModifiableError(cargo.id, cargo.status, isDeleted)
+		}
+	}
+
+	if c.id == source.id || c.vesselID != source.vesselID {
+		return ErrCargoMergeNotAllowed
+	}
+
+	if !c.status.Equals(StatusPending) || !source.status.Equals(StatusPending) {
+		return ErrCargoMergeNotAllowed
+	}
+
+	// The merged items would otherwise be shipped on behalf of someone else.
+	if !sameParty(c.shipper, source.shipper) || !sameParty(c.consignee, source.consignee) {
+		return ErrCargoMergePartiesMismatch
+	}
+
+	merged, err := NewItems(c.items.withAdded(source.items)...)
+	if err != nil {
+		return err
+	}
+
+	event, err := NewCargoMergedV1DomainEvent(c.id, source.id, c.items, merged, at)
+	if err != nil {
+		return ErrInvalidCargoOptionProvided.Wrap(err)
+	}
+
+	c.appendTracking(cargotrackingdomain.NewTrackingOnCargoMergedInto(
+		trackingID, at, c.status.String(), source.id.String(), c.id.String(), source.items.Weight(),
+	))
+	source.appendTracking(cargotrackingdomain.NewTrackingOnCargoMergedInto(
+		sourceTrackingID, at, source.status.String(), source.id.String(), c.id.String(), source.items.Weight(),
+	))
+
+	c.items = merged
+	c.updatedAt = at
+	c.RecordEvent(event)
+
+	source.deletedAt = &at
+	source.updatedAt = at
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package cargodomain_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+	messagingmock "github.com/soulcodex/deus-cargo-tracker/pkg/messaging/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestCargoMerger_Merge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	const (
+		targetID      = "01K43FJ8ZCYAVQ14ZV7EKCPMR8"
+		sourceID      = "01K4BBCBY7MQCC5CVGKMRHBBTM"
+		vesselID      = "01K4B43REGN4HBFQETVZZ484A3"
+		otherVesselID = "01K4BBD0AJ4X6P7V5C8Y1T2N3M"
+	)
+
+	type mocks struct {
+		repo      *cargodomainmock.CargoRepositoryMock
+		checker   *cargodomainmock.CargoVesselCheckerMock
+		publisher *messagingmock.PublisherMock
+	}
+
+	newCargo := func(id string, opts ...cargotest.CargoMotherOpt) *cargodomain.Cargo {
+		opts = append([]cargotest.CargoMotherOpt{
+			cargotest.WithID(id),
+			cargotest.WithVesselID(vesselID),
+			cargotest.WithTimestamps(now, now),
+		}, opts...)
+
+		return cargotest.NewCargoMother(opts...).Build(t)
+	}
+
+	tests := []struct {
+		name          string
+		sourceIDs     []string
+		cargoes       []*cargodomain.Cargo
+		setupMocks    func(m mocks)
+		assertion     func(t *testing.T, m mocks)
+		expectedError string
+	}{
+		{
+			name:      "should merge the source cargo into the target one saving both at once",
+			sourceIDs: []string{sourceID},
+			cargoes:   []*cargodomain.Cargo{newCargo(targetID), newCargo(sourceID)},
+			setupMocks: func(m mocks) {
+				m.repo.SaveAllFunc = func(_ context.Context, _ ...*cargodomain.Cargo) error {
+					return nil
+				}
+				m.publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				}
+			},
+			assertion: func(t *testing.T, m mocks) {
+				require.Len(t, m.repo.SaveAllCalls(), 1)
+				saved := m.repo.SaveAllCalls()[0].Cargoes
+				require.Len(t, saved, 2)
+
+				target, source := saved[0].Primitives(), saved[1].Primitives()
+				assert.Equal(t, targetID, target.ID)
+				assert.Len(t, target.Items, 4)
+				assert.Equal(t, uint64(7000), target.Weight)
+				assert.Nil(t, target.DeletedAt)
+				require.Len(t, target.Tracking, 1)
+				assert.Equal(t, "cargo.merged_into", target.Tracking[0].EntryType)
+				assert.Equal(t, sourceID, target.Tracking[0].Details["source_cargo_id"])
+
+				assert.Equal(t, sourceID, source.ID)
+				assert.NotNil(t, source.DeletedAt)
+				require.Len(t, source.Tracking, 1)
+				assert.Equal(t, "cargo.merged_into", source.Tracking[0].EntryType)
+				assert.Equal(t, targetID, source.Tracking[0].Details["target_cargo_id"])
+
+				require.Len(t, m.publisher.PublishCalls(), 1)
+				events := m.publisher.PublishCalls()[0].Messages
+				require.Len(t, events, 1)
+				assert.Equal(t, cargodomain.CargoMergedV1DomainEventName, events[0].Type())
+			},
+		},
+		{
+			name:          "should fail when the cargoes are on different vessels",
+			sourceIDs:     []string{sourceID},
+			cargoes:       []*cargodomain.Cargo{newCargo(targetID), newCargo(sourceID, cargotest.WithVesselID(otherVesselID))},
+			setupMocks:    func(_ mocks) {},
+			expectedError: "merge is only allowed between distinct pending cargoes on the same vessel",
+		},
+		{
+			name:      "should fail when a cargo is not pending",
+			sourceIDs: []string{sourceID},
+			cargoes: []*cargodomain.Cargo{
+				newCargo(targetID),
+				newCargo(sourceID, cargotest.WithStatus(cargodomain.StatusInTransit.String())),
+			},
+			setupMocks:    func(_ mocks) {},
+			expectedError: "merge is only allowed between distinct pending cargoes on the same vessel",
+		},
+		{
+			name:      "should fail when the cargoes have different shippers",
+			sourceIDs: []string{sourceID},
+			cargoes: []*cargodomain.Cargo{
+				newCargo(targetID, cargotest.WithShipper("Acme Exports", "shipping@acme.example", "1 Harbour Road, Rotterdam")),
+				newCargo(sourceID, cargotest.WithShipper("Globex", "logistics@globex.example", "2 Dock Street, Antwerp")),
+			},
+			setupMocks: func(_ mocks) {},
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveAllCalls())
+			},
+			expectedError: "merge is only allowed between cargoes with the same shipper and consignee",
+		},
+		{
+			name:      "should fail when only one of the cargoes has a consignee",
+			sourceIDs: []string{sourceID},
+			cargoes: []*cargodomain.Cargo{
+				newCargo(targetID),
+				newCargo(sourceID, cargotest.WithConsignee("Initech", "receiving@initech.example", "3 Quay Lane, Hamburg")),
+			},
+			setupMocks:    func(_ mocks) {},
+			expectedError: "merge is only allowed between cargoes with the same shipper and consignee",
+		},
+		{
+			name:          "should fail when a source cargo is repeated",
+			sourceIDs:     []string{sourceID, sourceID},
+			cargoes:       []*cargodomain.Cargo{newCargo(targetID), newCargo(sourceID)},
+			setupMocks:    func(_ mocks) {},
+			expectedError: "merge is only allowed between distinct pending cargoes on the same vessel",
+		},
+		{
+			name:          "should fail when merging a cargo into itself",
+			sourceIDs:     []string{targetID},
+			cargoes:       []*cargodomain.Cargo{newCargo(targetID)},
+			setupMocks:    func(_ mocks) {},
+			expectedError: "merge is only allowed between distinct pending cargoes on the same vessel",
+		},
+		{
+			name:      "should fail when the merged items are not valid",
+			sourceIDs: []string{sourceID},
+			cargoes: []*cargodomain.Cargo{
+				newCargo(targetID),
+				newCargo(sourceID, cargotest.WithItems(slices.Repeat(
+					[]cargodomain.ItemsPrimitives{{Name: "Machinery", Weight: 500}},
+					cargodomain.MaxItemsPerCargo-1,
+				)...)),
+			},
+			setupMocks: func(_ mocks) {},
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveAllCalls())
+			},
+			expectedError: "invalid items provided",
+		},
+		{
+			name:          "should fail when a source cargo does not exist",
+			sourceIDs:     []string{sourceID},
+			cargoes:       []*cargodomain.Cargo{newCargo(targetID)},
+			setupMocks:    func(_ mocks) {},
+			expectedError: "cargo doesn't exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				repo:      &cargodomainmock.CargoRepositoryMock{},
+				checker:   &cargodomainmock.CargoVesselCheckerMock{},
+				publisher: &messagingmock.PublisherMock{},
+			}
+			m.repo.FindFunc = func(_ context.Context, id cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+				for _, cargo := range tt.cargoes {
+					if cargo.ID() == id {
+						return cargo, nil
+					}
+				}
+
+				return nil, cargodomain.NewCargoNotExistsError(id)
+			}
+			m.checker.CheckFunc = func(_ context.Context, _ cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+				return cargodomain.CargoVessel{}, errors.New("vessel not found")
+			}
+			tt.setupMocks(m)
+
+			input := cargodomain.CargoMergeInput{ID: targetID, SourceIDs: tt.sourceIDs, At: now}
+
+			updater := cargodomain.NewCargoUpdater(m.repo, m.checker, m.publisher, zerolog.Nop())
+			merger := cargodomain.NewCargoMerger(m.repo, utils.NewFixedULIDProvider(), updater)
+			err := merger.Merge(ctx, input)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.assertion != nil {
+				tt.assertion(t, m)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package cargodomain
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	// metadataKeyPattern keeps keys usable as listing filters, e.g. filter[metadata.po_number].
+	metadataKeyPattern = `^[a-z][a-z0-9_]*$`
+
+	MaxMetadataEntries  int = 20
+	MinMetadataKeyLen   int = 1
+	MaxMetadataKeyLen   int = 64
+	MinMetadataValueLen int = 1
+	MaxMetadataValueLen int = 255
+)
+
+var (
+	ErrInvalidMetadataProvided = domainvalidation.NewError("invalid cargo metadata provided")
+)
+
+// Metadata holds free-form labels attached to a cargo by its users, e.g. customer PO numbers.
+type Metadata map[string]string
+
+func NewMetadata(raw map[string]string) (Metadata, error) {
+	metadata := make(Metadata, len(raw))
+	for key, value := range raw {
+		metadata[key] = strings.TrimSpace(value)
+	}
+
+	if err := metadataValidator().Validate(metadata); err != nil {
+		return nil, ErrInvalidMetadataProvided.Wrap(err)
+	}
+
+	return metadata, nil
+}
+
+// withChanges returns a new metadata setting the given keys and removing the ones set to nil,
+// the receiver is left untouched.
+func (m Metadata) withChanges(changes map[string]*string) (Metadata, error) {
+	raw := maps.Clone(m)
+	if raw == nil {
+		raw = make(Metadata, len(changes))
+	}
+
+	for key, value := range changes {
+		if value == nil {
+			delete(raw, key)
+			continue
+		}
+
+		raw[key] = *value
+	}
+
+	return NewMetadata(raw)
+}
+
+// Keys returns the metadata keys sorted alphabetically.
+func (m Metadata) Keys() []string {
+	return slices.Sorted(maps.Keys(m))
+}
+
+func (m Metadata) Equals(other Metadata) bool {
+	return maps.Equal(m, other)
+}
+
+func metadataValidator() *domainvalidation.Validator[Metadata] {
+	return domainvalidation.NewValidator(
+		func(m Metadata) *domainvalidation.Error {
+			if err := domainvalidation.Max(MaxMetadataEntries)(len(m)); err != nil {
+				return domainvalidation.NewError("number of metadata entries is invalid").Wrap(err)
+			}
+
+			return nil
+		},
+		func(m Metadata) *domainvalidation.Error {
+			for _, key := range m.Keys() {
+				if err := metadataKeyValidator().Validate(key); err != nil {
+					return domainvalidation.NewError("metadata key is invalid").Wrap(err)
+				}
+
+				if err := domainvalidation.NewValidator(
+					domainvalidation.NotEmpty[string](),
+					domainvalidation.MinLength(MinMetadataValueLen),
+					domainvalidation.MaxLength(MaxMetadataValueLen),
+				).Validate(m[key]); err != nil {
+					return domainvalidation.NewError("metadata value is invalid").Wrap(err)
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+func metadataKeyValidator() *domainvalidation.Validator[string] {
+	return domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+		domainvalidation.MinLength(MinMetadataKeyLen),
+		domainvalidation.MaxLength(MaxMetadataKeyLen),
+		domainvalidation.Regex(metadataKeyPattern),
+	)
+}
@@ -0,0 +1,60 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoMetadataChangedV1DomainEventName = "cargo-metadata-changed-v1"
+)
+
+type CargoMetadataChangedV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	metadata   map[string]string
+	occurredOn time.Time
+}
+
+// CargoMetadata returns the cargo metadata once changed, Metadata refers to the message one.
+func (e *CargoMetadataChangedV1DomainEvent) CargoMetadata() map[string]string {
+	return maps.Clone(e.metadata)
+}
+
+func (e *CargoMetadataChangedV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+func NewCargoMetadataChangedV1DomainEvent(
+	id CargoID,
+	metadata Metadata,
+	occurredOn time.Time,
+) (*CargoMetadataChangedV1DomainEvent, error) {
+	attributes := map[string]any{
+		"metadata":    metadata,
+		"occurred_on": occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo metadata changed v1 domain event: %w", err)
+	}
+
+	return &CargoMetadataChangedV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoMetadataChangedV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		metadata:   maps.Clone(metadata),
+		occurredOn: occurredOn,
+	}, nil
+}
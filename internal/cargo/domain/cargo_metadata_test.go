@@ -0,0 +1,137 @@
+package cargodomain_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestNewMetadata(t *testing.T) {
+	tooMany := make(map[string]string, cargodomain.MaxMetadataEntries+1)
+	for i := range cargodomain.MaxMetadataEntries + 1 {
+		tooMany[fmt.Sprintf("key_%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name             string
+		raw              map[string]string
+		expectedMetadata cargodomain.Metadata
+		expectedError    bool
+	}{
+		{
+			name:             "should build empty metadata",
+			raw:              nil,
+			expectedMetadata: cargodomain.Metadata{},
+		},
+		{
+			name:             "should build metadata trimming its values",
+			raw:              map[string]string{"po_number": " PO-1234 ", "campaign_code": "BF26"},
+			expectedMetadata: cargodomain.Metadata{"po_number": "PO-1234", "campaign_code": "BF26"},
+		},
+		{
+			name:          "should fail when a key is not snake case",
+			raw:           map[string]string{"PO Number": "PO-1234"},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when a key is too long",
+			raw:           map[string]string{strings.Repeat("k", cargodomain.MaxMetadataKeyLen+1): "value"},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when a value is empty",
+			raw:           map[string]string{"po_number": "  "},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when a value is too long",
+			raw:           map[string]string{"po_number": strings.Repeat("v", cargodomain.MaxMetadataValueLen+1)},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when there are too many entries",
+			raw:           tooMany,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := cargodomain.NewMetadata(tt.raw)
+
+			if tt.expectedError {
+				require.ErrorIs(t, err, cargodomain.ErrInvalidMetadataProvided)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMetadata, metadata)
+		})
+	}
+}
+
+func TestCargo_WithMetadataChanges(t *testing.T) {
+	now := time.Now()
+	poNumber, campaign := "PO-5678", "BF26"
+
+	tests := []struct {
+		name             string
+		changes          map[string]*string
+		expectedMetadata map[string]string
+		expectedError    error
+	}{
+		{
+			name:             "should set and remove the given keys keeping the rest",
+			changes:          map[string]*string{"po_number": &poNumber, "campaign_code": nil},
+			expectedMetadata: map[string]string{"po_number": "PO-5678", "customer": "ACME"},
+		},
+		{
+			name:          "should fail when nothing changes",
+			changes:       map[string]*string{"campaign_code": &campaign, "unknown": nil},
+			expectedError: cargodomain.ErrMetadataUnchanged,
+		},
+		{
+			name:          "should fail when the resulting metadata is invalid",
+			changes:       map[string]*string{"PO Number": &poNumber},
+			expectedError: cargodomain.ErrInvalidMetadataProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cargo := cargotest.NewCargoMother(
+				cargotest.WithID(utils.NewULID().String()),
+				cargotest.WithMetadata(map[string]string{"po_number": "PO-1234", "campaign_code": "BF26", "customer": "ACME"}),
+			).Build(t)
+
+			err := cargo.Update(context.Background(), cargodomain.WithMetadataChanges(utils.NewULID().String(), tt.changes, now))
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, cargo.PullEvents())
+				return
+			}
+
+			require.NoError(t, err)
+
+			primitives := cargo.Primitives()
+			assert.Equal(t, tt.expectedMetadata, primitives.Metadata)
+			require.Len(t, primitives.Tracking, 1)
+			assert.Equal(t, []string{"po_number"}, primitives.Tracking[0].Details["keys_set"])
+			assert.Equal(t, []string{"campaign_code"}, primitives.Tracking[0].Details["keys_removed"])
+
+			events := cargo.PullEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, cargodomain.CargoMetadataChangedV1DomainEventName, events[0].Type())
+		})
+	}
+}
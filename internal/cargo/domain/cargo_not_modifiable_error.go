@@ -1,6 +1,8 @@
 package cargodomain
 
 import (
+	"errors"
+
 	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
 )
@@ -21,3 +23,8 @@ func NewCargoNotModifiableError(id CargoID, status Status, isDeleted bool) *Carg
 		),
 	}
 }
+
+func IsCargoNotModifiableError(err error) bool {
+	var self *CargoNotModifiableError
+	return errors.As(err, &self)
+}
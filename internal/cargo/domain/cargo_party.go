@@ -0,0 +1,146 @@
+package cargodomain
+
+import (
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	MinPartyNameLen    int = 1
+	MaxPartyNameLen    int = 255
+	MinPartyAddressLen int = 1
+	MaxPartyAddressLen int = 500
+)
+
+var (
+	ErrInvalidShipperProvided   = domainvalidation.NewError("invalid cargo shipper provided")
+	ErrInvalidConsigneeProvided = domainvalidation.NewError("invalid cargo consignee provided")
+)
+
+type CargoPartyInput struct {
+	Name    string
+	Email   string
+	Address string
+}
+
+// CargoParties groups the optional parties involved on a cargo shipment.
+type CargoParties struct {
+	Shipper   *Party
+	Consignee *Party
+}
+
+func newCargoPartiesFromInput(shipper, consignee *CargoPartyInput) (CargoParties, error) {
+	shipperParty, err := newPartyFromInput(shipper, ErrInvalidShipperProvided)
+	if err != nil {
+		return CargoParties{}, err
+	}
+
+	consigneeParty, err := newPartyFromInput(consignee, ErrInvalidConsigneeProvided)
+	if err != nil {
+		return CargoParties{}, err
+	}
+
+	return CargoParties{Shipper: shipperParty, Consignee: consigneeParty}, nil
+}
+
+// Party is someone involved on the cargo shipment, either the shipper sending
+// the goods or the consignee receiving them.
+type Party struct {
+	name    string
+	email   string
+	address string
+}
+
+func NewParty(name, email, address string) (Party, error) {
+	p := Party{
+		name:    strings.TrimSpace(name),
+		email:   strings.ToLower(strings.TrimSpace(email)),
+		address: strings.TrimSpace(address),
+	}
+
+	if err := partyValidator().Validate(p); err != nil {
+		return Party{}, err
+	}
+
+	return p, nil
+}
+
+func newPartyFromInput(input *CargoPartyInput, invalidErr *domainvalidation.Error) (*Party, error) {
+	if input == nil {
+		return nil, nil //nolint:nilnil // parties are optional on a cargo
+	}
+
+	party, err := NewParty(input.Name, input.Email, input.Address)
+	if err != nil {
+		return nil, invalidErr.Wrap(err)
+	}
+
+	return &party, nil
+}
+
+func newPartyFromPrimitives(p *PartyPrimitives) *Party {
+	if p == nil {
+		return nil
+	}
+
+	return &Party{name: p.Name, email: p.Email, address: p.Address}
+}
+
+func (p Party) Name() string {
+	return p.name
+}
+
+func (p Party) Email() string {
+	return p.email
+}
+
+func (p Party) Address() string {
+	return p.address
+}
+
+// sameParty tells whether two optional parties are the same one, both missing included.
+func sameParty(a, b *Party) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func partyValidator() *domainvalidation.Validator[Party] {
+	return domainvalidation.NewValidator(
+		func(p Party) *domainvalidation.Error {
+			if err := domainvalidation.NewValidator(
+				domainvalidation.NotEmpty[string](),
+				domainvalidation.MinLength(MinPartyNameLen),
+				domainvalidation.MaxLength(MaxPartyNameLen),
+			).Validate(p.name); err != nil {
+				return domainvalidation.NewError("party name is invalid").Wrap(err)
+			}
+
+			return nil
+		},
+		func(p Party) *domainvalidation.Error {
+			if err := domainvalidation.NewValidator(
+				domainvalidation.NotEmpty[string](),
+				domainvalidation.Email(),
+			).Validate(p.email); err != nil {
+				return domainvalidation.NewError("party contact email is invalid").Wrap(err)
+			}
+
+			return nil
+		},
+		func(p Party) *domainvalidation.Error {
+			if err := domainvalidation.NewValidator(
+				domainvalidation.NotEmpty[string](),
+				domainvalidation.MinLength(MinPartyAddressLen),
+				domainvalidation.MaxLength(MaxPartyAddressLen),
+			).Validate(p.address); err != nil {
+				return domainvalidation.NewError("party address is invalid").Wrap(err)
+			}
+
+			return nil
+		},
+	)
+}
@@ -0,0 +1,235 @@
+package cargodomain
+
+import (
+	"strings"
+	"time"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	DefaultCargoSearchPageSize uint64 = 20
+	MaxCargoSearchPageSize     uint64 = 100
+	MaxCargoSearchMetadata     int    = 5
+
+	CargoSortByCreatedAt CargoSortField = "created_at"
+	CargoSortByUpdatedAt CargoSortField = "updated_at"
+
+	cargoSortDescendingPrefix = "-"
+)
+
+var (
+	validCargoSortFields = map[CargoSortField]struct{}{
+		CargoSortByCreatedAt: {},
+		CargoSortByUpdatedAt: {},
+	}
+
+	ErrInvalidCargoSearchCriteria = domainvalidation.NewError("invalid cargo search criteria provided")
+)
+
+type CargoSortField string
+
+func (f CargoSortField) String() string {
+	return string(f)
+}
+
+type CargoSort struct {
+	Field      CargoSortField
+	Descending bool
+}
+
+// NewCargoSort parses a JSON:API sort expression (e.g. "created_at" or "-created_at").
+func NewCargoSort(raw string) (CargoSort, error) {
+	if raw == "" {
+		return newDefaultCargoSort(), nil
+	}
+
+	sort := CargoSort{
+		Field:      CargoSortField(strings.TrimPrefix(raw, cargoSortDescendingPrefix)),
+		Descending: strings.HasPrefix(raw, cargoSortDescendingPrefix),
+	}
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.InMap(validCargoSortFields),
+	)
+
+	if err := validator.Validate(sort.Field); err != nil {
+		return CargoSort{}, ErrInvalidCargoSearchCriteria.Wrap(err)
+	}
+
+	return sort, nil
+}
+
+func newDefaultCargoSort() CargoSort {
+	return CargoSort{Field: CargoSortByCreatedAt, Descending: false}
+}
+
+type CargoSearchOpt func(*CargoSearchCriteria) error
+type CargoSearchCriteria struct {
+	Statuses      []Status
+	VesselID      *VesselID
+	Shipper       *string
+	Metadata      map[string]string
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+	Sort          CargoSort
+	Cursor        *CargoCursor
+	PageSize      uint64
+}
+
+func newDefaultCargoSearchCriteria() *CargoSearchCriteria {
+	return &CargoSearchCriteria{
+		Statuses:      make([]Status, 0),
+		VesselID:      nil,
+		Shipper:       nil,
+		Metadata:      make(map[string]string),
+		CreatedAtFrom: nil,
+		CreatedAtTo:   nil,
+		Sort:          newDefaultCargoSort(),
+		Cursor:        nil,
+		PageSize:      DefaultCargoSearchPageSize,
+	}
+}
+
+func NewCargoSearchCriteria(opts ...CargoSearchOpt) (*CargoSearchCriteria, error) {
+	criteria := newDefaultCargoSearchCriteria()
+	for _, opt := range opts {
+		if err := opt(criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	if criteria.CreatedAtFrom != nil && criteria.CreatedAtTo != nil && criteria.CreatedAtFrom.After(*criteria.CreatedAtTo) {
+		return nil, ErrInvalidCargoSearchCriteria
+	}
+
+	// A cursor is only meaningful for the sort it was generated with.
+	if criteria.Cursor != nil && criteria.Cursor.field != criteria.Sort.Field {
+		return nil, ErrInvalidCargoSearchCriteria
+	}
+
+	return criteria, nil
+}
+
+func WithStatusFilter(statuses ...string) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		for _, raw := range statuses {
+			status, err := NewStatus(raw)
+			if err != nil {
+				return ErrInvalidCargoSearchCriteria.Wrap(err)
+			}
+
+			c.Statuses = append(c.Statuses, status)
+		}
+
+		return nil
+	}
+}
+
+func WithVesselFilter(id string) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		if id == "" {
+			return nil
+		}
+
+		vesselID, err := NewVesselID(id)
+		if err != nil {
+			return ErrInvalidCargoSearchCriteria.Wrap(err)
+		}
+
+		c.VesselID = &vesselID
+		return nil
+	}
+}
+
+// WithShipperFilter matches cargoes whose shipper name or contact email equals the given one, ignoring case.
+func WithShipperFilter(raw string) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		shipper := strings.TrimSpace(raw)
+		if shipper == "" {
+			return nil
+		}
+
+		c.Shipper = &shipper
+		return nil
+	}
+}
+
+// WithMetadataFilter matches cargoes holding every given metadata key with exactly the given value.
+func WithMetadataFilter(metadata map[string]string) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		if err := domainvalidation.Max(MaxCargoSearchMetadata)(len(metadata)); err != nil {
+			return ErrInvalidCargoSearchCriteria.Wrap(err)
+		}
+
+		for key, value := range metadata {
+			if err := metadataKeyValidator().Validate(key); err != nil {
+				return ErrInvalidCargoSearchCriteria.Wrap(err)
+			}
+
+			c.Metadata[key] = value
+		}
+
+		return nil
+	}
+}
+
+func WithCreatedAtRange(from, to *time.Time) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		c.CreatedAtFrom = from
+		c.CreatedAtTo = to
+		return nil
+	}
+}
+
+func WithSorting(raw string) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		sort, err := NewCargoSort(raw)
+		if err != nil {
+			return err
+		}
+
+		c.Sort = sort
+		return nil
+	}
+}
+
+func WithCursor(raw string) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		if raw == "" {
+			return nil
+		}
+
+		cursor, err := ParseCargoCursor(raw)
+		if err != nil {
+			return ErrInvalidCargoSearchCriteria.Wrap(err)
+		}
+
+		c.Cursor = &cursor
+		return nil
+	}
+}
+
+func WithPageSize(size uint64) CargoSearchOpt {
+	return func(c *CargoSearchCriteria) error {
+		if size == 0 {
+			return nil
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.WithinBounds(1, MaxCargoSearchPageSize),
+		)
+
+		if err := validator.Validate(size); err != nil {
+			return ErrInvalidCargoSearchCriteria.Wrap(err)
+		}
+
+		c.PageSize = size
+		return nil
+	}
+}
+
+type CargoSearchResult struct {
+	Cargoes    []*Cargo
+	NextCursor *CargoCursor
+}
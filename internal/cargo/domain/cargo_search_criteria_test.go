@@ -0,0 +1,127 @@
+package cargodomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestNewCargoSearchCriteria(t *testing.T) {
+	idProvider := utils.NewFixedULIDProvider()
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(idProvider.New().String()),
+		cargotest.WithTimestamps(before, now),
+	).Build(t)
+	createdAtCursor := cargodomain.NewCargoCursor(cargo, cargodomain.CargoSortByCreatedAt)
+
+	tests := []struct {
+		name          string
+		opts          []cargodomain.CargoSearchOpt
+		assertion     func(t *testing.T, criteria *cargodomain.CargoSearchCriteria)
+		expectedError bool
+	}{
+		{
+			name: "should build default criteria when no options are provided",
+			assertion: func(t *testing.T, criteria *cargodomain.CargoSearchCriteria) {
+				assert.Empty(t, criteria.Statuses)
+				assert.Nil(t, criteria.VesselID)
+				assert.Nil(t, criteria.Shipper)
+				assert.Nil(t, criteria.Cursor)
+				assert.Equal(t, cargodomain.CargoSortByCreatedAt, criteria.Sort.Field)
+				assert.False(t, criteria.Sort.Descending)
+				assert.Equal(t, cargodomain.DefaultCargoSearchPageSize, criteria.PageSize)
+			},
+		},
+		{
+			name: "should build criteria with every filter provided",
+			opts: []cargodomain.CargoSearchOpt{
+				cargodomain.WithStatusFilter("pending", "in_transit"),
+				cargodomain.WithVesselFilter(idProvider.New().String()),
+				cargodomain.WithShipperFilter(" Acme Exports "),
+				cargodomain.WithMetadataFilter(map[string]string{"po_number": "PO-1234"}),
+				cargodomain.WithCreatedAtRange(&before, &now),
+				cargodomain.WithSorting("-created_at"),
+				cargodomain.WithCursor(createdAtCursor.String()),
+				cargodomain.WithPageSize(50),
+			},
+			assertion: func(t *testing.T, criteria *cargodomain.CargoSearchCriteria) {
+				assert.Equal(t, []cargodomain.Status{cargodomain.StatusPending, cargodomain.StatusInTransit}, criteria.Statuses)
+				assert.NotNil(t, criteria.VesselID)
+				require.NotNil(t, criteria.Shipper)
+				assert.Equal(t, "Acme Exports", *criteria.Shipper)
+				assert.Equal(t, map[string]string{"po_number": "PO-1234"}, criteria.Metadata)
+				assert.True(t, criteria.Sort.Descending)
+				require.NotNil(t, criteria.Cursor)
+				assert.Equal(t, cargo.ID(), criteria.Cursor.ID())
+				assert.True(t, before.Equal(criteria.Cursor.Value()))
+				assert.Equal(t, uint64(50), criteria.PageSize)
+			},
+		},
+		{
+			name:          "should fail when an unknown status is provided",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithStatusFilter("sunk")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when an invalid vessel id is provided",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithVesselFilter("not-a-vessel")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when a metadata filter key is invalid",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithMetadataFilter(map[string]string{"PO Number": "PO-1234"})},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when sorting by an unknown field",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithSorting("-weight")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when page size is out of bounds",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithPageSize(cargodomain.MaxCargoSearchPageSize + 1)},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when created at range is inverted",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithCreatedAtRange(&now, &before)},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when cursor is malformed",
+			opts:          []cargodomain.CargoSearchOpt{cargodomain.WithCursor("%%%")},
+			expectedError: true,
+		},
+		{
+			name: "should fail when cursor was generated for another sorting",
+			opts: []cargodomain.CargoSearchOpt{
+				cargodomain.WithSorting("updated_at"),
+				cargodomain.WithCursor(createdAtCursor.String()),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := cargodomain.NewCargoSearchCriteria(tt.opts...)
+
+			if tt.expectedError {
+				require.ErrorIs(t, err, cargodomain.ErrInvalidCargoSearchCriteria)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.assertion(t, criteria)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package cargodomain
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	cargoCursorSeparator  = "|"
+	cargoCursorPartsCount = 3
+)
+
+var (
+	ErrInvalidCargoCursorProvided = domainvalidation.NewError("invalid cargo cursor provided")
+)
+
+// CargoCursor points to the last cargo of a page, it holds the value of the
+// sorting field and the cargo id so the next page can be fetched by keyset.
+type CargoCursor struct {
+	field CargoSortField
+	value time.Time
+	id    CargoID
+}
+
+func NewCargoCursor(c *Cargo, field CargoSortField) CargoCursor {
+	value := c.createdAt
+	if field == CargoSortByUpdatedAt {
+		value = c.updatedAt
+	}
+
+	return CargoCursor{field: field, value: value, id: c.id}
+}
+
+func ParseCargoCursor(raw string) (CargoCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return CargoCursor{}, ErrInvalidCargoCursorProvided.Wrap(err)
+	}
+
+	parts := strings.Split(string(decoded), cargoCursorSeparator)
+	if len(parts) != cargoCursorPartsCount {
+		return CargoCursor{}, ErrInvalidCargoCursorProvided
+	}
+
+	field, err := NewCargoSort(parts[0])
+	if err != nil || field.Descending {
+		return CargoCursor{}, ErrInvalidCargoCursorProvided.Wrap(err)
+	}
+
+	value, err := time.Parse(time.RFC3339Nano, parts[1])
+	if err != nil {
+		return CargoCursor{}, ErrInvalidCargoCursorProvided.Wrap(err)
+	}
+
+	id, err := NewCargoID(parts[2])
+	if err != nil {
+		return CargoCursor{}, ErrInvalidCargoCursorProvided.Wrap(err)
+	}
+
+	return CargoCursor{field: field.Field, value: value, id: id}, nil
+}
+
+func (c CargoCursor) Field() CargoSortField {
+	return c.field
+}
+
+func (c CargoCursor) Value() time.Time {
+	return c.value
+}
+
+func (c CargoCursor) ID() CargoID {
+	return c.id
+}
+
+func (c CargoCursor) String() string {
+	raw := strings.Join([]string{c.field.String(), c.value.Format(time.RFC3339Nano), c.id.String()}, cargoCursorSeparator)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
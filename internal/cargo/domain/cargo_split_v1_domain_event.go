@@ -0,0 +1,77 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoSplitV1DomainEventName = "cargo-split-v1"
+)
+
+type CargoSplitV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	splitCargoID string
+	weightBefore uint64
+	weightAfter  uint64
+	occurredOn   time.Time
+}
+
+func (e *CargoSplitV1DomainEvent) SplitCargoID() string {
+	return e.splitCargoID
+}
+
+func (e *CargoSplitV1DomainEvent) WeightBefore() uint64 {
+	return e.weightBefore
+}
+
+func (e *CargoSplitV1DomainEvent) WeightAfter() uint64 {
+	return e.weightAfter
+}
+
+func (e *CargoSplitV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+// NewCargoSplitV1DomainEvent is recorded by the split cargo, the split one records its own creation.
+func NewCargoSplitV1DomainEvent(
+	id CargoID,
+	splitCargoID CargoID,
+	itemsBefore Items,
+	itemsMoved Items,
+	occurredOn time.Time,
+) (*CargoSplitV1DomainEvent, error) {
+	weightAfter := itemsBefore.Weight() - itemsMoved.Weight()
+	attributes := map[string]any{
+		"split_cargo_id": splitCargoID.String(),
+		"weight_before":  itemsBefore.Weight(),
+		"weight_after":   weightAfter,
+		"items_moved":    itemsMoved.eventAttributes(),
+		"occurred_on":    occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo split v1 domain event: %w", err)
+	}
+
+	return &CargoSplitV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoSplitV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		splitCargoID: splitCargoID.String(),
+		weightBefore: itemsBefore.Weight(),
+		weightAfter:  weightAfter,
+		occurredOn:   occurredOn,
+	}, nil
+}
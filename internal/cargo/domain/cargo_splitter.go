@@ -0,0 +1,175 @@
+package cargodomain
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+var (
+	ErrCargoSplitNotAllowed = domain.NewError("split is only allowed on pending cargoes")
+)
+
+type CargoSplitInput struct {
+	ID      string
+	SplitID string
+	// VesselID is the vessel whose load the caller holds, empty when any vessel is fine.
+	VesselID  string
+	ItemNames []string
+	At        time.Time
+}
+
+// CargoSplitter moves some items of a pending cargo to a new one on the same vessel, e.g. when
+// a shipment is only partially loaded. Both cargoes are saved at once so none is left half split.
+type CargoSplitter struct {
+	repository  CargoRepository
+	vesselCheck CargoVesselChecker
+	idProvider  utils.ULIDProvider
+	updater     *CargoUpdater
+}
+
+func NewCargoSplitter(
+	repository CargoRepository,
+	checker CargoVesselChecker,
+	idProvider utils.ULIDProvider,
+	updater *CargoUpdater,
+) *CargoSplitter {
+	return &CargoSplitter{
+		repository:  repository,
+		vesselCheck: checker,
+		idProvider:  idProvider,
+		updater:     updater,
+	}
+}
+
+// Split returns the cargo created with the items moved, one per given item name.
+func (s *CargoSplitter) Split(ctx context.Context, input CargoSplitInput) (*Cargo, error) {
+	cargoID, err := NewCargoID(input.ID)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	splitID, err := NewCargoID(input.SplitID)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	cargo, err := s.repository.Find(ctx, cargoID)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	if vesselErr := ensureCargoOnVessel(cargo, input.VesselID); vesselErr != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(vesselErr)
+	}
+
+	existing, findErr := s.repository.Find(ctx, splitID)
+	if findErr != nil && !IsCargoNotExistsError(findErr) {
+		return nil, fmt.Errorf("error checking existing cargo: %w", findErr)
+	}
+
+	if existing != nil {
+		return nil, NewCargoAlreadyExistsError(splitID, existing.vesselID)
+	}
+
+	vessel, err := s.vesselCheck.Check(ctx, cargo.vesselID)
+	if err != nil {
+		return nil, fmt.Errorf("error checking vessel: %w", err)
+	}
+
+	trackingIDs, err := newTrackingIDs(s.idProvider, 3)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	cargo.locate(vessel)
+	split, err := cargo.splitOff(splitID, vessel, input.ItemNames, trackingIDs, input.At)
+	if err != nil {
+		return nil, ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	if persistErr := s.updater.persistAll(ctx, cargo, split); persistErr != nil {
+		return nil, persistErr
+	}
+
+	return split, nil
+}
+
+// splitOff moves the named items to a new pending cargo on the same vessel keeping the parties
+// and metadata, both manifests must still be valid so the cargo can't be emptied. The tracking
+// IDs are the ones of the split entry on the cargo, the creation and the split entry on the new one.
+func (c *Cargo) splitOff(
+	id CargoID,
+	vessel CargoVessel,
+	names []string,
+	trackingIDs []cargotrackingdomain.TrackingID,
+	at time.Time,
+) (*Cargo, error) {
+	if isDeleted := c.deletedAt != nil; isDeleted {
+		return nil, NewCargoNotModifiableError(c.id, c.status, isDeleted)
+	}
+
+	if !c.status.Equals(StatusPending) {
+		return nil, ErrCargoSplitNotAllowed
+	}
+
+	picked, remaining, err := c.items.partition(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, err := NewItems(picked...)
+	if err != nil {
+		return nil, err
+	}
+
+	kept, err := NewItems(remaining...)
+	if err != nil {
+		return nil, err
+	}
+
+	parties := CargoParties{Shipper: c.shipper, Consignee: c.consignee}
+	split, err := NewCargo(id, vessel, trackingIDs[1], moved, parties, maps.Clone(c.metadata), at)
+	if err != nil {
+		return nil, fmt.Errorf("error creating split cargo: %w", err)
+	}
+
+	event, err := NewCargoSplitV1DomainEvent(c.id, id, c.items, moved, at)
+	if err != nil {
+		return nil, ErrInvalidCargoOptionProvided.Wrap(err)
+	}
+
+	c.appendTracking(cargotrackingdomain.NewTrackingOnCargoSplitFrom(
+		trackingIDs[0], at, c.status.String(), c.id.String(), id.String(), names,
+	))
+	split.appendTracking(cargotrackingdomain.NewTrackingOnCargoSplitFrom(
+		trackingIDs[2], at, split.status.String(), c.id.String(), id.String(), names,
+	))
+
+	c.items = kept
+	c.updatedAt = at
+	c.RecordEvent(event)
+
+	return split, nil
+}
+
+// newTrackingIDs generates the given amount of tracking IDs, meant for operations recording
+// several tracking entries at once.
+func newTrackingIDs(idProvider utils.ULIDProvider, amount int) ([]cargotrackingdomain.TrackingID, error) {
+	ids := make([]cargotrackingdomain.TrackingID, amount)
+	for i := range ids {
+		id, err := cargotrackingdomain.NewTrackingID(idProvider.New().String())
+		if err != nil {
+			return nil, fmt.Errorf("error creating tracking id: %w", err)
+		}
+
+		ids[i] = id
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,202 @@
+package cargodomain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+	messagingmock "github.com/soulcodex/deus-cargo-tracker/pkg/messaging/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestCargoSplitter_Split(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	const (
+		cargoID        = "01K43FJ8ZCYAVQ14ZV7EKCPMR8"
+		splitID        = "01K4BBCBY7MQCC5CVGKMRHBBTM"
+		vesselID       = "01K4B43REGN4HBFQETVZZ484A3"
+		otherVesselID  = "01K4BBD0AJ4X6P7V5C8Y1T2N3M"
+		vesselCapacity = 10 // in kilograms
+	)
+
+	type mocks struct {
+		repo      *cargodomainmock.CargoRepositoryMock
+		checker   *cargodomainmock.CargoVesselCheckerMock
+		publisher *messagingmock.PublisherMock
+	}
+
+	newCargo := func(opts ...cargotest.CargoMotherOpt) *cargodomain.Cargo {
+		opts = append([]cargotest.CargoMotherOpt{
+			cargotest.WithID(cargoID),
+			cargotest.WithVesselID(vesselID),
+			cargotest.WithMetadata(map[string]string{"po_number": "PO-1"}),
+			cargotest.WithTimestamps(now, now),
+		}, opts...)
+
+		return cargotest.NewCargoMother(opts...).Build(t)
+	}
+
+	findOnly := func(m mocks, cargo *cargodomain.Cargo) {
+		m.repo.FindFunc = func(_ context.Context, id cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+			if id == cargo.ID() {
+				return cargo, nil
+			}
+
+			return nil, cargodomain.NewCargoNotExistsError(id)
+		}
+		m.checker.CheckFunc = func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+			return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		itemNames     []string
+		vesselID      string
+		cargo         *cargodomain.Cargo
+		setupMocks    func(m mocks, cargo *cargodomain.Cargo)
+		assertion     func(t *testing.T, m mocks)
+		expectedError string
+	}{
+		{
+			name:      "should split the given items to a new cargo saving both at once",
+			itemNames: []string{"Clothing"},
+			cargo:     newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findOnly(m, cargo)
+				m.repo.SaveAllFunc = func(_ context.Context, _ ...*cargodomain.Cargo) error {
+					return nil
+				}
+				m.publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				}
+			},
+			assertion: func(t *testing.T, m mocks) {
+				require.Len(t, m.repo.SaveAllCalls(), 1)
+				saved := m.repo.SaveAllCalls()[0].Cargoes
+				require.Len(t, saved, 2)
+
+				source, split := saved[0].Primitives(), saved[1].Primitives()
+				assert.Equal(t, cargoID, source.ID)
+				assert.Equal(t, uint64(1500), source.Weight)
+				require.Len(t, source.Tracking, 1)
+				assert.Equal(t, "cargo.split_from", source.Tracking[0].EntryType)
+				assert.Equal(t, splitID, source.Tracking[0].Details["split_cargo_id"])
+
+				assert.Equal(t, splitID, split.ID)
+				assert.Equal(t, vesselID, split.VesselID)
+				assert.Equal(t, cargodomain.StatusPending.String(), split.Status)
+				assert.Equal(t, uint64(2000), split.Weight)
+				assert.Equal(t, map[string]string{"po_number": "PO-1"}, split.Metadata)
+				require.Len(t, split.Tracking, 2)
+				assert.Equal(t, "cargo.created", split.Tracking[0].EntryType)
+				assert.Equal(t, "cargo.split_from", split.Tracking[1].EntryType)
+				assert.Equal(t, cargoID, split.Tracking[1].Details["source_cargo_id"])
+
+				require.Len(t, m.publisher.PublishCalls(), 1)
+				events := m.publisher.PublishCalls()[0].Messages
+				require.Len(t, events, 2)
+				assert.Equal(t, cargodomain.CargoSplitV1DomainEventName, events[0].Type())
+				assert.Equal(t, cargodomain.CargoCreatedV1DomainEventName, events[1].Type())
+			},
+		},
+		{
+			name:      "should fail when every item is split",
+			itemNames: []string{"Electronics", "Clothing"},
+			cargo:     newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findOnly(m, cargo)
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveAllCalls())
+			},
+			expectedError: "invalid items provided",
+		},
+		{
+			name:      "should fail when an item is not found",
+			itemNames: []string{"Furniture"},
+			cargo:     newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findOnly(m, cargo)
+			},
+			expectedError: "cargo item not found",
+		},
+		{
+			name:      "should fail when cargo is not pending",
+			itemNames: []string{"Clothing"},
+			cargo:     newCargo(cargotest.WithStatus(cargodomain.StatusInTransit.String())),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findOnly(m, cargo)
+			},
+			expectedError: "split is only allowed on pending cargoes",
+		},
+		{
+			name:      "should fail when cargo was moved to another vessel meanwhile",
+			itemNames: []string{"Clothing"},
+			vesselID:  otherVesselID,
+			cargo:     newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				findOnly(m, cargo)
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveAllCalls())
+			},
+			expectedError: "cargo was moved to another vessel",
+		},
+		{
+			name:      "should fail when split cargo already exists",
+			itemNames: []string{"Clothing"},
+			cargo:     newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+			},
+			expectedError: "cargo already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				repo:      &cargodomainmock.CargoRepositoryMock{},
+				checker:   &cargodomainmock.CargoVesselCheckerMock{},
+				publisher: &messagingmock.PublisherMock{},
+			}
+			tt.setupMocks(m, tt.cargo)
+
+			input := cargodomain.CargoSplitInput{
+				ID:        cargoID,
+				SplitID:   splitID,
+				VesselID:  tt.vesselID,
+				ItemNames: tt.itemNames,
+				At:        now,
+			}
+
+			updater := cargodomain.NewCargoUpdater(m.repo, m.checker, m.publisher, zerolog.Nop())
+			splitter := cargodomain.NewCargoSplitter(m.repo, m.checker, utils.NewFixedULIDProvider(), updater)
+			_, err := splitter.Split(ctx, input)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.assertion != nil {
+				tt.assertion(t, m)
+			}
+		})
+	}
+}
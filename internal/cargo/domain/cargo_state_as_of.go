@@ -0,0 +1,46 @@
+package cargodomain
+
+import (
+	"time"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+)
+
+var (
+	ErrCargoStateUnknownAsOf = domain.NewError("cargo state is unknown at the given instant")
+)
+
+// CargoStateAsOf is the cargo state rebuilt from its tracking at a given instant.
+type CargoStateAsOf struct {
+	At       time.Time
+	Status   Status
+	Tracking cargotrackingdomain.TrackingItem
+}
+
+// StateAsOf replays the cargo tracking recorded up to the given instant to rebuild the
+// status it had back then, the cargo must be found along with its tracking.
+func (c *Cargo) StateAsOf(at time.Time) (CargoStateAsOf, error) {
+	tracking := c.tracking.Until(at)
+	if len(tracking) == 0 {
+		return CargoStateAsOf{}, ErrCargoStateUnknownAsOf
+	}
+
+	var status *string
+	for _, item := range tracking {
+		if item.StatusAfter() != nil {
+			status = item.StatusAfter()
+		}
+	}
+
+	if status == nil {
+		return CargoStateAsOf{}, ErrCargoStateUnknownAsOf
+	}
+
+	rebuilt, err := NewStatus(*status)
+	if err != nil {
+		return CargoStateAsOf{}, err
+	}
+
+	return CargoStateAsOf{At: at, Status: rebuilt, Tracking: tracking[len(tracking)-1]}, nil
+}
@@ -0,0 +1,86 @@
+package cargodomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestCargo_StateAsOf(t *testing.T) {
+	cargoID := utils.NewULID().String()
+	createdAt := time.Date(2026, time.October, 1, 8, 0, 0, 0, time.UTC)
+
+	created := cargotrackingdomain.NewTrackingOnCargoCreated(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()), "pending", createdAt,
+	)
+	inTransit := cargotrackingdomain.NewTrackingOnCargoStatusChanged(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()), createdAt.Add(time.Hour), "pending", "in_transit",
+	)
+	delivered := cargotrackingdomain.NewTrackingOnCargoStatusChanged(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()), createdAt.Add(2*time.Hour), "in_transit", "delivered",
+	)
+
+	// Tracking is loaded out of order on purpose, it must be replayed chronologically anyway.
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(cargoID),
+		cargotest.WithStatus("delivered"),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, delivered)),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, created)),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, inTransit)),
+	).Build(t)
+
+	tests := []struct {
+		name             string
+		at               time.Time
+		expectedStatus   cargodomain.Status
+		expectedTracking cargotrackingdomain.TrackingItem
+		expectedError    error
+	}{
+		{
+			name:          "should fail when the cargo was not tracked yet",
+			at:            createdAt.Add(-time.Minute),
+			expectedError: cargodomain.ErrCargoStateUnknownAsOf,
+		},
+		{
+			name:             "should rebuild the status at the exact instant an entry was recorded",
+			at:               createdAt,
+			expectedStatus:   cargodomain.StatusPending,
+			expectedTracking: created,
+		},
+		{
+			name:             "should rebuild the status between two entries",
+			at:               createdAt.Add(90 * time.Minute),
+			expectedStatus:   cargodomain.StatusInTransit,
+			expectedTracking: inTransit,
+		},
+		{
+			name:             "should rebuild the current status after the last entry",
+			at:               createdAt.Add(24 * time.Hour),
+			expectedStatus:   cargodomain.StatusDelivered,
+			expectedTracking: delivered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := cargo.StateAsOf(tt.at)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.at, state.At)
+			assert.Equal(t, tt.expectedStatus, state.Status)
+			assert.Equal(t, tt.expectedTracking, state.Tracking)
+		})
+	}
+}
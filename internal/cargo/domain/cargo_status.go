@@ -8,16 +8,41 @@ import (
 )
 
 const (
-	StatusPending   Status = "pending"
-	StatusInTransit Status = "in_transit"
-	StatusDelivered Status = "delivered"
+	StatusPending     Status = "pending"
+	StatusLoaded      Status = "loaded"
+	StatusInTransit   Status = "in_transit"
+	StatusAtPort      Status = "at_port"
+	StatusCustomsHold Status = "customs_hold"
+	StatusDelivered   Status = "delivered"
+	StatusReturned    Status = "returned"
+	StatusLost        Status = "lost"
+	StatusCancelled   Status = "cancelled"
 )
 
 var (
+	// orderedStatuses keeps a stable order to expose the statuses, the lifecycle order.
+	orderedStatuses = []Status{
+		StatusPending,
+		StatusLoaded,
+		StatusInTransit,
+		StatusAtPort,
+		StatusCustomsHold,
+		StatusDelivered,
+		StatusReturned,
+		StatusLost,
+		StatusCancelled,
+	}
+
 	validStatuses = map[Status]struct{}{
-		StatusPending:   {},
-		StatusInTransit: {},
-		StatusDelivered: {},
+		StatusPending:     {},
+		StatusLoaded:      {},
+		StatusInTransit:   {},
+		StatusAtPort:      {},
+		StatusCustomsHold: {},
+		StatusDelivered:   {},
+		StatusReturned:    {},
+		StatusLost:        {},
+		StatusCancelled:   {},
 	}
 
 	ErrInvalidStatusProvided      = domainvalidation.NewError("invalid cargo status provided")
@@ -44,19 +69,6 @@ func NewStatus(status string) (Status, error) {
 	return c, nil
 }
 
-func (s Status) IsTransitionAllowed(newStatus Status) bool {
-	switch s {
-	case StatusPending:
-		return newStatus == StatusInTransit
-	case StatusInTransit:
-		return newStatus == StatusDelivered
-	case StatusDelivered:
-		return false
-	default:
-		return false
-	}
-}
-
 func (s Status) Equals(other Status) bool {
 	return s == other
 }
@@ -0,0 +1,152 @@
+package cargodomain
+
+import (
+	"slices"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrInvalidStatusTransitionsProvided = domain.NewError("invalid cargo status transitions provided")
+)
+
+// StatusTransitions is the declarative cargo status state machine, every status
+// is mapped to the statuses it can move to. Statuses without transitions are final,
+// their cargoes no longer load a vessel.
+type StatusTransitions map[Status][]Status
+
+func DefaultStatusTransitions() StatusTransitions {
+	return StatusTransitions{
+		StatusPending:     {StatusLoaded, StatusInTransit, StatusCancelled},
+		StatusLoaded:      {StatusInTransit, StatusCancelled},
+		StatusInTransit:   {StatusAtPort, StatusDelivered, StatusLost, StatusCancelled},
+		StatusAtPort:      {StatusCustomsHold, StatusInTransit, StatusDelivered, StatusLost},
+		StatusCustomsHold: {StatusAtPort, StatusReturned, StatusCancelled},
+		StatusDelivered:   {},
+		StatusReturned:    {},
+		StatusLost:        {},
+		StatusCancelled:   {},
+	}
+}
+
+// NewStatusTransitions builds a validated state machine from its raw representation.
+func NewStatusTransitions(raw map[string][]string) (StatusTransitions, error) {
+	transitions := make(StatusTransitions, len(raw))
+	for rawFrom, rawTargets := range raw {
+		from, err := NewStatus(rawFrom)
+		if err != nil {
+			return nil, ErrInvalidStatusTransitionsProvided.Wrap(err)
+		}
+
+		targets := make([]Status, 0, len(rawTargets))
+		for _, rawTo := range rawTargets {
+			to, toErr := NewStatus(rawTo)
+			if toErr != nil {
+				return nil, ErrInvalidStatusTransitionsProvided.Wrap(toErr)
+			}
+			targets = append(targets, to)
+		}
+
+		transitions[from] = targets
+	}
+
+	if err := transitions.Validate(); err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+// Validate ensures every known status is declared, transitions are neither
+// duplicated nor pointing to the same status and every status is reachable
+// from the initial one.
+func (t StatusTransitions) Validate() error {
+	for _, status := range orderedStatuses {
+		targets, declared := t[status]
+		if !declared {
+			return ErrInvalidStatusTransitionsProvided.Wrap(
+				errutil.NewError("status " + status.String() + " is not declared"),
+			)
+		}
+
+		for i, target := range targets {
+			if _, valid := validStatuses[target]; !valid {
+				return ErrInvalidStatusTransitionsProvided.Wrap(errutil.NewError("unknown status " + target.String()))
+			}
+
+			if target.Equals(status) || slices.Contains(targets[:i], target) {
+				return ErrInvalidStatusTransitionsProvided.Wrap(
+					errutil.NewError("status " + status.String() + " has a repeated or self transition"),
+				)
+			}
+		}
+	}
+
+	if len(t) != len(validStatuses) {
+		return ErrInvalidStatusTransitionsProvided.Wrap(errutil.NewError("unknown statuses declared"))
+	}
+
+	reachable := t.reachableFrom(StatusPending)
+	for _, status := range orderedStatuses {
+		if _, ok := reachable[status]; !ok {
+			return ErrInvalidStatusTransitionsProvided.Wrap(
+				errutil.NewError("status " + status.String() + " is not reachable"),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (t StatusTransitions) Allows(from, to Status) bool {
+	return slices.Contains(t[from], to)
+}
+
+// Statuses returns the declared statuses sorted by their lifecycle order.
+func (t StatusTransitions) Statuses() []Status {
+	statuses := make([]Status, 0, len(t))
+	for _, status := range orderedStatuses {
+		if _, ok := t[status]; ok {
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses
+}
+
+func (t StatusTransitions) From(status Status) []Status {
+	return slices.Clone(t[status])
+}
+
+func (t StatusTransitions) IsFinal(status Status) bool {
+	return len(t[status]) == 0
+}
+
+// FinalStatuses returns the statuses of cargoes that no longer load a vessel, in lifecycle order.
+func (t StatusTransitions) FinalStatuses() []Status {
+	return slices.DeleteFunc(t.Statuses(), func(status Status) bool { return !t.IsFinal(status) })
+}
+
+// ActiveStatuses returns the statuses of cargoes still loading a vessel, in lifecycle order.
+func (t StatusTransitions) ActiveStatuses() []Status {
+	return slices.DeleteFunc(t.Statuses(), t.IsFinal)
+}
+
+func (t StatusTransitions) reachableFrom(initial Status) map[Status]struct{} {
+	reachable := map[Status]struct{}{initial: {}}
+	pending := []Status{initial}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		for _, next := range t[current] {
+			if _, seen := reachable[next]; !seen {
+				reachable[next] = struct{}{}
+				pending = append(pending, next)
+			}
+		}
+	}
+
+	return reachable
+}
@@ -0,0 +1,120 @@
+package cargodomain_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+)
+
+func defaultRawStatusTransitions() map[string][]string {
+	return map[string][]string{
+		"pending":      {"loaded", "in_transit", "cancelled"},
+		"loaded":       {"in_transit", "cancelled"},
+		"in_transit":   {"at_port", "delivered", "lost", "cancelled"},
+		"at_port":      {"customs_hold", "in_transit", "delivered", "lost"},
+		"customs_hold": {"at_port", "returned", "cancelled"},
+		"delivered":    {},
+		"returned":     {},
+		"lost":         {},
+		"cancelled":    {},
+	}
+}
+
+func TestNewStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name          string
+		override      map[string][]string
+		remove        string
+		expectedError string
+	}{
+		{
+			name: "should build the default state machine",
+		},
+		{
+			name:          "should fail when a status is unknown",
+			override:      map[string][]string{"teleported": {}},
+			expectedError: "invalid cargo status transitions provided",
+		},
+		{
+			name:          "should fail when a target status is unknown",
+			override:      map[string][]string{"pending": {"teleported"}},
+			expectedError: "invalid cargo status transitions provided",
+		},
+		{
+			name:          "should fail when a status is not declared",
+			remove:        "lost",
+			expectedError: "status lost is not declared",
+		},
+		{
+			name:          "should fail when a status transitions to itself",
+			override:      map[string][]string{"loaded": {"loaded", "in_transit"}},
+			expectedError: "status loaded has a repeated or self transition",
+		},
+		{
+			name:          "should fail when a transition is repeated",
+			override:      map[string][]string{"loaded": {"in_transit", "in_transit"}},
+			expectedError: "status loaded has a repeated or self transition",
+		},
+		{
+			name:          "should fail when a status is not reachable from pending",
+			override:      map[string][]string{"pending": {"in_transit", "cancelled"}},
+			expectedError: "status loaded is not reachable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := defaultRawStatusTransitions()
+			maps.Copy(raw, tt.override)
+			delete(raw, tt.remove)
+
+			transitions, err := cargodomain.NewStatusTransitions(raw)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, cargodomain.DefaultStatusTransitions(), transitions)
+		})
+	}
+}
+
+func TestStatusTransitions_Allows(t *testing.T) {
+	transitions := cargodomain.DefaultStatusTransitions()
+
+	assert.True(t, transitions.Allows(cargodomain.StatusInTransit, cargodomain.StatusAtPort))
+	assert.True(t, transitions.Allows(cargodomain.StatusCustomsHold, cargodomain.StatusReturned))
+	assert.False(t, transitions.Allows(cargodomain.StatusDelivered, cargodomain.StatusInTransit))
+	assert.False(t, transitions.Allows(cargodomain.StatusLost, cargodomain.StatusPending))
+	assert.True(t, transitions.IsFinal(cargodomain.StatusCancelled))
+	assert.False(t, transitions.IsFinal(cargodomain.StatusPending))
+}
+
+func TestStatusTransitions_ActiveStatuses(t *testing.T) {
+	transitions := cargodomain.DefaultStatusTransitions()
+
+	assert.Equal(t, []cargodomain.Status{
+		cargodomain.StatusPending,
+		cargodomain.StatusLoaded,
+		cargodomain.StatusInTransit,
+		cargodomain.StatusAtPort,
+		cargodomain.StatusCustomsHold,
+	}, transitions.ActiveStatuses())
+	assert.Equal(t, []cargodomain.Status{
+		cargodomain.StatusDelivered,
+		cargodomain.StatusReturned,
+		cargodomain.StatusLost,
+		cargodomain.StatusCancelled,
+	}, transitions.FinalStatuses())
+
+	transitions[cargodomain.StatusDelivered] = []cargodomain.Status{cargodomain.StatusReturned}
+	assert.Contains(t, transitions.ActiveStatuses(), cargodomain.StatusDelivered)
+	assert.NotContains(t, transitions.FinalStatuses(), cargodomain.StatusDelivered)
+}
@@ -1,6 +1,8 @@
 package cargodomain
 
 import (
+	"maps"
+	"slices"
 	"time"
 
 	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
@@ -9,11 +11,30 @@ import (
 
 var (
 	ErrInvalidCargoOptionProvided = domain.NewError("invalid cargo update value provided")
+	ErrVesselUnchanged            = domain.NewError("vessel is unchanged")
+	ErrVesselChangeNotAllowed     = domain.NewError("vessel change is only allowed on pending cargoes")
+	ErrCancellationReasonRequired = domain.NewError("cargo cancellation requires a reason code")
+	ErrItemsChangeNotAllowed      = domain.NewError("items change is only allowed on pending cargoes")
+	ErrMetadataUnchanged          = domain.NewError("metadata is unchanged")
+	ErrCargoVersionMismatch       = domain.NewError("cargo version doesn't match the expected one")
 )
 
 type CargoUpdateOpt func(*Cargo) error
 
-func WithStatus(trackingID, status string, at time.Time) CargoUpdateOpt {
+// WithExpectedVersion rejects the update unless the cargo is on the expected version, meant to
+// be applied first. No version expected means no precondition at all.
+func WithExpectedVersion(expected *uint64) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		if expected != nil && *expected != c.version {
+			return ErrCargoVersionMismatch
+		}
+
+		return nil
+	}
+}
+
+// WithStatus moves the cargo to the given status as long as the transitions state machine allows it.
+func WithStatus(transitions StatusTransitions, trackingID, status string, at time.Time) CargoUpdateOpt {
 	return func(c *Cargo) error {
 		newStatus, err := NewStatus(status)
 		if err != nil {
@@ -24,7 +45,12 @@ func WithStatus(trackingID, status string, at time.Time) CargoUpdateOpt {
 			return ErrStatusUnchanged
 		}
 
-		if !c.status.IsTransitionAllowed(newStatus) {
+		// Cancellations must go through WithCancellation so a reason is always recorded.
+		if newStatus.Equals(StatusCancelled) {
+			return ErrCancellationReasonRequired
+		}
+
+		if !transitions.Allows(c.status, newStatus) {
 			return ErrStatusTransitionNotAllowed
 		}
 
@@ -58,3 +84,242 @@ func WithStatus(trackingID, status string, at time.Time) CargoUpdateOpt {
 		return nil
 	}
 }
+
+func WithVesselID(trackingID string, vesselID VesselID, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		if c.vesselID == vesselID {
+			return ErrVesselUnchanged
+		}
+
+		if !c.status.Equals(StatusPending) {
+			return ErrVesselChangeNotAllowed
+		}
+
+		newTrackingID, err := cargotrackingdomain.NewTrackingID(trackingID)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		tracking := cargotrackingdomain.NewTrackingOnCargoVesselChanged(
+			newTrackingID,
+			at,
+			c.status.String(),
+			c.vesselID.String(),
+			vesselID.String(),
+		)
+		c.appendTracking(tracking)
+
+		event, err := NewCargoVesselChangedV1DomainEvent(
+			c.id,
+			c.vesselID,
+			vesselID,
+			at,
+		)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		c.vesselID = vesselID
+		c.updatedAt = at
+		c.RecordEvent(event)
+
+		return nil
+	}
+}
+
+func WithItemsAdded(trackingID string, items []struct {
+	Name        string
+	Weight      uint64
+	UNNumber    string
+	HazardClass string
+	Length      uint64
+	Width       uint64
+	Height      uint64
+}, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		return c.changeItems(trackingID, ItemsOperationAdd, c.items.withAdded(itemsFromRaw(items)), at)
+	}
+}
+
+func WithItemsRemoved(trackingID string, names []string, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		remaining, err := c.items.withoutNames(names...)
+		if err != nil {
+			return err
+		}
+
+		return c.changeItems(trackingID, ItemsOperationRemove, remaining, at)
+	}
+}
+
+func WithItemsReplaced(trackingID string, items []struct {
+	Name        string
+	Weight      uint64
+	UNNumber    string
+	HazardClass string
+	Length      uint64
+	Width       uint64
+	Height      uint64
+}, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		return c.changeItems(trackingID, ItemsOperationReplace, itemsFromRaw(items), at)
+	}
+}
+
+// changeItems swaps the cargo items by the given ones once they pass the same
+// validation applied on creation, only pending cargoes can change their manifest.
+func (c *Cargo) changeItems(trackingID string, operation ItemsOperation, items Items, at time.Time) error {
+	if !c.status.Equals(StatusPending) {
+		return ErrItemsChangeNotAllowed
+	}
+
+	newItems, err := NewItems(items...)
+	if err != nil {
+		return err
+	}
+
+	newTrackingID, err := cargotrackingdomain.NewTrackingID(trackingID)
+	if err != nil {
+		return ErrInvalidCargoOptionProvided.Wrap(err)
+	}
+
+	tracking := cargotrackingdomain.NewTrackingOnCargoItemsChanged(
+		newTrackingID,
+		at,
+		c.status.String(),
+		operation.String(),
+		c.items.Weight(),
+		newItems.Weight(),
+	)
+	c.appendTracking(tracking)
+
+	event, err := NewCargoItemsChangedV1DomainEvent(c.id, operation, c.items, newItems, at)
+	if err != nil {
+		return ErrInvalidCargoOptionProvided.Wrap(err)
+	}
+
+	c.items = newItems
+	c.updatedAt = at
+	c.RecordEvent(event)
+
+	return nil
+}
+
+// WithMetadataChanges sets the given metadata keys and removes the ones set to nil, the rest are kept.
+func WithMetadataChanges(trackingID string, changes map[string]*string, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		metadata, err := c.metadata.withChanges(changes)
+		if err != nil {
+			return err
+		}
+
+		if c.metadata.Equals(metadata) {
+			return ErrMetadataUnchanged
+		}
+
+		newTrackingID, err := cargotrackingdomain.NewTrackingID(trackingID)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		keysSet, keysRemoved := make([]string, 0, len(changes)), make([]string, 0, len(changes))
+		for _, key := range slices.Sorted(maps.Keys(changes)) {
+			if changes[key] == nil {
+				keysRemoved = append(keysRemoved, key)
+				continue
+			}
+
+			keysSet = append(keysSet, key)
+		}
+
+		tracking := cargotrackingdomain.NewTrackingOnCargoMetadataChanged(
+			newTrackingID,
+			at,
+			c.status.String(),
+			keysSet,
+			keysRemoved,
+		)
+		c.appendTracking(tracking)
+
+		event, err := NewCargoMetadataChangedV1DomainEvent(c.id, metadata, at)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		c.metadata = metadata
+		c.updatedAt = at
+		c.RecordEvent(event)
+
+		return nil
+	}
+}
+
+// WithCancellation cancels the cargo recording why, as long as the transitions state machine allows it.
+func WithCancellation(transitions StatusTransitions, trackingID, reason string, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		cancellationReason, err := NewCancellationReason(reason)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		if c.status.Equals(StatusCancelled) {
+			return ErrStatusUnchanged
+		}
+
+		if !transitions.Allows(c.status, StatusCancelled) {
+			return ErrStatusTransitionNotAllowed
+		}
+
+		newTrackingID, err := cargotrackingdomain.NewTrackingID(trackingID)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		tracking := cargotrackingdomain.NewTrackingOnCargoCancelled(
+			newTrackingID,
+			at,
+			c.status.String(),
+			StatusCancelled.String(),
+			cancellationReason.String(),
+		)
+		c.appendTracking(tracking)
+
+		event, err := NewCargoCancelledV1DomainEvent(
+			c.id,
+			c.status,
+			cancellationReason,
+			at,
+		)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		c.status = StatusCancelled
+		c.updatedAt = at
+		c.RecordEvent(event)
+
+		return nil
+	}
+}
+
+// WithSoftDeletion deletes the cargo as long as it's pending or in a final status of the transitions
+// state machine, a cargo on its way still loads its vessel.
+func WithSoftDeletion(transitions StatusTransitions, trackingID string, at time.Time) CargoUpdateOpt {
+	return func(c *Cargo) error {
+		if !c.status.Equals(StatusPending) && !transitions.IsFinal(c.status) {
+			return NewCargoNotModifiableError(c.id, c.status, false)
+		}
+
+		newTrackingID, err := cargotrackingdomain.NewTrackingID(trackingID)
+		if err != nil {
+			return ErrInvalidCargoOptionProvided.Wrap(err)
+		}
+
+		c.appendTracking(cargotrackingdomain.NewTrackingOnCargoDeleted(newTrackingID, at, c.status.String()))
+
+		c.deletedAt = &at
+		c.updatedAt = at
+
+		return nil
+	}
+}
@@ -5,21 +5,34 @@ import (
 
 	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/logger"
 )
 
 var (
 	ErrCargoUpdateFailed = errutil.NewError("cargo update failed")
+	// ErrCargoVesselChanged means the cargo was moved to another vessel after the caller read it, so
+	// the vessel load it locked is not the one the cargo is loaded on anymore.
+	ErrCargoVesselChanged = domain.NewError("cargo was moved to another vessel")
 )
 
 type CargoUpdater struct {
-	repository CargoRepository
-	publisher  domain.EventPublisher
+	repository  CargoRepository
+	vesselCheck CargoVesselChecker
+	publisher   domain.EventPublisher
+	logger      logger.ZerologLogger
 }
 
-func NewCargoUpdater(repository CargoRepository, publisher domain.EventPublisher) *CargoUpdater {
+func NewCargoUpdater(
+	repository CargoRepository,
+	checker CargoVesselChecker,
+	publisher domain.EventPublisher,
+	logger logger.ZerologLogger,
+) *CargoUpdater {
 	return &CargoUpdater{
-		repository: repository,
-		publisher:  publisher,
+		repository:  repository,
+		vesselCheck: checker,
+		publisher:   publisher,
+		logger:      logger,
 	}
 }
 
@@ -34,10 +47,44 @@ func (cu *CargoUpdater) Update(ctx context.Context, id string, opts ...CargoUpda
 		return ErrCargoUpdateFailed.Wrap(err)
 	}
 
+	cu.locate(ctx, cargo)
 	if updateErr := cargo.Update(ctx, opts...); updateErr != nil {
 		return ErrCargoUpdateFailed.Wrap(updateErr)
 	}
 
+	return cu.persist(ctx, cargo)
+}
+
+// locate fetches the current position of the cargo vessel so the tracking entries
+// recorded by the update capture it. It's best effort, a vessel that can't be found
+// (e.g. already removed) must not prevent the cargo from being updated.
+func (cu *CargoUpdater) locate(ctx context.Context, cargo *Cargo) {
+	vessel, err := cu.vesselCheck.Check(ctx, cargo.vesselID)
+	if err != nil {
+		cu.logger.Warn().
+			Ctx(ctx).
+			Err(err).
+			Str("cargo_id", cargo.id.String()).
+			Str("vessel_id", cargo.vesselID.String()).
+			Msg("error locating cargo vessel, tracking entries are recorded without its position")
+		return
+	}
+
+	cargo.locate(vessel)
+}
+
+// ensureCargoOnVessel rejects a cargo moved away from the expected vessel since the caller read it,
+// e.g. before locking that vessel load. No vessel expected means any vessel is fine.
+func ensureCargoOnVessel(cargo *Cargo, expected string) error {
+	if expected == "" || cargo.vesselID.String() == expected {
+		return nil
+	}
+
+	return ErrCargoVesselChanged
+}
+
+// persist saves an already updated cargo and publishes its recorded events.
+func (cu *CargoUpdater) persist(ctx context.Context, cargo *Cargo) error {
 	// The following approach would be better if we had an outbox pattern implemented.
 	// However, for simplicity, we are directly publishing events after saving the cargo.
 	events := cargo.PullEvents()
@@ -51,3 +98,22 @@ func (cu *CargoUpdater) Update(ctx context.Context, id string, opts ...CargoUpda
 
 	return nil
 }
+
+// persistAll saves several already updated cargoes atomically, e.g. the ones involved on a split
+// or a merge, and publishes their recorded events once every one of them is saved.
+func (cu *CargoUpdater) persistAll(ctx context.Context, cargoes ...*Cargo) error {
+	events := make([]domain.Event, 0, len(cargoes))
+	for _, cargo := range cargoes {
+		events = append(events, cargo.PullEvents()...)
+	}
+
+	if saveErr := cu.repository.SaveAll(ctx, cargoes...); saveErr != nil {
+		return ErrCargoUpdateFailed.Wrap(saveErr)
+	}
+
+	if publishErr := cu.publisher.Publish(ctx, events...); publishErr != nil {
+		return ErrCargoUpdateFailed.Wrap(publishErr)
+	}
+
+	return nil
+}
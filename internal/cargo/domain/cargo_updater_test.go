@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -21,6 +22,13 @@ func TestCargoUpdater_Update(t *testing.T) {
 	ctx := context.Background()
 	idProvider := utils.NewFixedULIDProvider()
 	now := time.Now()
+	expectedVersion, staleVersion := uint64(3), uint64(2)
+
+	const (
+		vesselCapacity  = 10 // in kilograms
+		vesselLatitude  = 36.1408
+		vesselLongitude = -5.3536
+	)
 
 	tests := []struct {
 		name          string
@@ -41,13 +49,20 @@ func TestCargoUpdater_Update(t *testing.T) {
 				).Build(t)
 			},
 			opts: []cargodomain.CargoUpdateOpt{
-				cargodomain.WithStatus(idProvider.New().String(), "in_transit", now),
+				cargodomain.WithStatus(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "in_transit", now),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, publisher *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
 				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 					return cargo, nil
 				}
-				repo.SaveFunc = func(_ context.Context, _ *cargodomain.Cargo) error {
+				repo.SaveFunc = func(_ context.Context, c *cargodomain.Cargo) error {
+					tracking := c.Primitives().Tracking
+					if len(tracking) != 1 || tracking[0].VesselLatitude == nil || tracking[0].VesselLongitude == nil {
+						return errors.New("vessel position was not tracked")
+					}
+					if *tracking[0].VesselLatitude != vesselLatitude || *tracking[0].VesselLongitude != vesselLongitude {
+						return errors.New("unexpected vessel position tracked")
+					}
 					return nil
 				}
 
@@ -85,7 +100,7 @@ func TestCargoUpdater_Update(t *testing.T) {
 				).Build(t)
 			},
 			opts: []cargodomain.CargoUpdateOpt{
-				cargodomain.WithStatus(idProvider.New().String(), "pending", now),
+				cargodomain.WithStatus(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "pending", now),
 			},
 			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, publisher *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
 				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
@@ -134,6 +149,51 @@ func TestCargoUpdater_Update(t *testing.T) {
 			},
 			expectedError: "cargo update failed: bad update",
 		},
+		{
+			name: "should update cargo when it's on the expected version",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(
+					cargotest.WithID(idProvider.New().String()),
+					cargotest.WithVersion(3),
+				).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithExpectedVersion(&expectedVersion),
+				cargodomain.WithStatus(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "in_transit", now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, publisher *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				repo.SaveFunc = func(_ context.Context, _ *cargodomain.Cargo) error {
+					return nil
+				}
+				publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "should fail when cargo is not on the expected version",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(
+					cargotest.WithID(idProvider.New().String()),
+					cargotest.WithVersion(3),
+				).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithExpectedVersion(&staleVersion),
+				cargodomain.WithStatus(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "in_transit", now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, _ *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+			},
+			expectedError: "cargo update failed: cargo version doesn't match the expected one",
+		},
 		{
 			name: "should fail when save fails",
 			id:   idProvider.New().String(),
@@ -154,18 +214,141 @@ func TestCargoUpdater_Update(t *testing.T) {
 			},
 			expectedError: "cargo update failed: db error",
 		},
+		{
+			name: "should cancel cargo successfully",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(
+					cargotest.WithID(idProvider.New().String()),
+					cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+				).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithCancellation(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "customer_request", now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, publisher *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				repo.SaveFunc = func(_ context.Context, _ *cargodomain.Cargo) error {
+					return nil
+				}
+				publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "should fail cancellation when reason is invalid",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(cargotest.WithID(idProvider.New().String())).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithCancellation(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "bored", now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, _ *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+			},
+			expectedError: "invalid cargo cancellation reason provided",
+		},
+		{
+			name: "should fail cancellation when cargo is already delivered",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(
+					cargotest.WithID(idProvider.New().String()),
+					cargotest.WithStatus(cargodomain.StatusDelivered.String()),
+				).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithCancellation(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "damaged", now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, _ *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+			},
+			expectedError: "cargo update failed: status transition not allowed",
+		},
+		{
+			name: "should fail when cancelling through a plain status update",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(cargotest.WithID(idProvider.New().String())).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithStatus(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), "cancelled", now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, _ *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+			},
+			expectedError: "cargo update failed: cargo cancellation requires a reason code",
+		},
+		{
+			name: "should soft delete cargo successfully",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(cargotest.WithID(idProvider.New().String())).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithSoftDeletion(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, publisher *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				repo.SaveFunc = func(_ context.Context, c *cargodomain.Cargo) error {
+					if c.Primitives().DeletedAt == nil {
+						return errors.New("cargo was not soft deleted")
+					}
+					return nil
+				}
+				publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "should fail when soft deleting a cargo on its way",
+			id:   idProvider.New().String(),
+			setupCargo: func() *cargodomain.Cargo {
+				return cargotest.NewCargoMother(
+					cargotest.WithID(idProvider.New().String()),
+					cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+				).Build(t)
+			},
+			opts: []cargodomain.CargoUpdateOpt{
+				cargodomain.WithSoftDeletion(cargodomain.DefaultStatusTransitions(), idProvider.New().String(), now),
+			},
+			setupMocks: func(repo *cargodomainmock.CargoRepositoryMock, _ *messagingmock.PublisherMock, cargo *cargodomain.Cargo) {
+				repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+			},
+			expectedError: "cargo update failed: cargo is not modifiable",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &cargodomainmock.CargoRepositoryMock{}
 			publisher := &messagingmock.PublisherMock{}
+			checker := &cargodomainmock.CargoVesselCheckerMock{
+				CheckFunc: func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity).WithPosition(vesselLatitude, vesselLongitude), nil
+				},
+			}
 			cargo := tt.setupCargo()
 			if tt.setupMocks != nil {
 				tt.setupMocks(repo, publisher, cargo)
 			}
 
-			updater := cargodomain.NewCargoUpdater(repo, publisher)
+			updater := cargodomain.NewCargoUpdater(repo, checker, publisher, zerolog.Nop())
 			err := updater.Update(ctx, tt.id, tt.opts...)
 
 			if tt.expectedError != "" {
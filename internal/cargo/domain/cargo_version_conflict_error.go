@@ -0,0 +1,31 @@
+package cargodomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const cargoVersionConflictErrorMsg = "cargo was modified concurrently."
+
+// CargoVersionConflictError is returned when a cargo is saved but the stored one is no
+// longer on the version it was loaded with, i.e. someone else saved it in between.
+type CargoVersionConflictError struct {
+	domain.BaseError
+}
+
+func NewCargoVersionConflictError(id CargoID, version uint64) *CargoVersionConflictError {
+	return &CargoVersionConflictError{
+		BaseError: domain.NewError(
+			cargoVersionConflictErrorMsg,
+			errutil.WithMetadataKeyValue("domain.cargo.id", id.String()),
+			errutil.WithMetadataKeyValue("domain.cargo.version", version),
+		),
+	}
+}
+
+func IsCargoVersionConflictError(err error) bool {
+	var self *CargoVersionConflictError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,34 @@
+package cargodomain
+
+import (
+	"context"
+	"fmt"
+)
+
+// CargoVesselCapacityGuard ensures a vessel is not loaded over its capacity
+// taking into account the weight of the active cargoes already assigned to it.
+type CargoVesselCapacityGuard struct {
+	repository CargoRepository
+}
+
+func NewCargoVesselCapacityGuard(repository CargoRepository) *CargoVesselCapacityGuard {
+	return &CargoVesselCapacityGuard{
+		repository: repository,
+	}
+}
+
+// Guard checks whether the vessel can carry the incoming weight, both the
+// incoming weight and the returned loaded weight are expressed in grams.
+func (g *CargoVesselCapacityGuard) Guard(ctx context.Context, vessel CargoVessel, incomingWeight uint64) error {
+	loadedWeight, err := g.repository.ActiveWeightByVessel(ctx, vessel.ID())
+	if err != nil {
+		return fmt.Errorf("error calculating vessel loaded weight: %w", err)
+	}
+
+	capacity := vessel.CapacityInGrams()
+	if loadedWeight > capacity || incomingWeight > capacity-loadedWeight {
+		return NewCargoExceedsVesselCapacityError(vessel.ID(), capacity, loadedWeight, incomingWeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package cargodomain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+)
+
+const (
+	CargoVesselChangedV1DomainEventName = "cargo-vessel-changed-v1"
+)
+
+type CargoVesselChangedV1DomainEvent struct {
+	*messaging.BaseMessage
+
+	oldVesselID string
+	newVesselID string
+	occurredOn  time.Time
+}
+
+func (e *CargoVesselChangedV1DomainEvent) OldVesselID() string {
+	return e.oldVesselID
+}
+
+func (e *CargoVesselChangedV1DomainEvent) NewVesselID() string {
+	return e.newVesselID
+}
+
+func (e *CargoVesselChangedV1DomainEvent) OccurredOn() time.Time {
+	return e.occurredOn
+}
+
+func NewCargoVesselChangedV1DomainEvent(
+	id CargoID,
+	oldVesselID VesselID,
+	newVesselID VesselID,
+	occurredOn time.Time,
+) (*CargoVesselChangedV1DomainEvent, error) {
+	attributes := map[string]any{
+		"old_vessel_id": oldVesselID.String(),
+		"new_vessel_id": newVesselID.String(),
+		"occurred_on":   occurredOn.Format(time.RFC3339),
+	}
+
+	eventData, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cargo vessel changed v1 domain event: %w", err)
+	}
+
+	return &CargoVesselChangedV1DomainEvent{
+		BaseMessage: messaging.NewBaseMessage(
+			CargoVesselChangedV1DomainEventName,
+			messaging.DefaultMessageSpecVersion,
+			"deus.cargo_tracker",
+			id.String(),
+			"cargo",
+			occurredOn,
+			eventData,
+		),
+		oldVesselID: oldVesselID.String(),
+		newVesselID: newVesselID.String(),
+		occurredOn:  occurredOn,
+	}, nil
+}
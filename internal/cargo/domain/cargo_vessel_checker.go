@@ -2,9 +2,47 @@ package cargodomain
 
 import (
 	"context"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
 )
 
+// CargoVessel is the cargo context view of a vessel.
+type CargoVessel struct {
+	id                VesselID
+	capacityKilograms uint64
+	position          *cargotrackingdomain.VesselPosition
+}
+
+func NewCargoVessel(id VesselID, capacityKilograms uint64) CargoVessel {
+	return CargoVessel{
+		id:                id,
+		capacityKilograms: capacityKilograms,
+	}
+}
+
+func (v CargoVessel) ID() VesselID {
+	return v.id
+}
+
+func (v CargoVessel) CapacityInGrams() uint64 {
+	return domainweight.FromKilograms(v.capacityKilograms).Grams()
+}
+
+// WithPosition returns a copy of the vessel located at the given coordinates.
+func (v CargoVessel) WithPosition(latitude, longitude float64) CargoVessel {
+	position := cargotrackingdomain.NewVesselPosition(latitude, longitude)
+	v.position = &position
+
+	return v
+}
+
+// Position returns the last known vessel position, nil when it's unknown.
+func (v CargoVessel) Position() *cargotrackingdomain.VesselPosition {
+	return v.position
+}
+
 //go:generate moq -pkg cargodomainmock -out mock/cargo_vessel_checker_moq.go . CargoVesselChecker
 type CargoVesselChecker interface {
-	Check(ctx context.Context, vesselID VesselID) error
+	Check(ctx context.Context, vesselID VesselID) (CargoVessel, error)
 }
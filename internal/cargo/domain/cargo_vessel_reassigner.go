@@ -0,0 +1,76 @@
+package cargodomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type CargoVesselReassignInput struct {
+	ID         string
+	VesselID   string
+	TrackingID string
+	At         time.Time
+	// ExpectedVersion rejects the reassignment unless the cargo is on it, nil means any version.
+	ExpectedVersion *uint64
+}
+
+// CargoVesselReassigner moves a pending cargo to another vessel ensuring the
+// destination vessel exists and has enough room to carry it.
+type CargoVesselReassigner struct {
+	repository    CargoRepository
+	vesselCheck   CargoVesselChecker
+	capacityGuard *CargoVesselCapacityGuard
+	updater       *CargoUpdater
+}
+
+func NewCargoVesselReassigner(
+	repository CargoRepository,
+	checker CargoVesselChecker,
+	updater *CargoUpdater,
+) *CargoVesselReassigner {
+	return &CargoVesselReassigner{
+		repository:    repository,
+		vesselCheck:   checker,
+		capacityGuard: NewCargoVesselCapacityGuard(repository),
+		updater:       updater,
+	}
+}
+
+func (r *CargoVesselReassigner) Reassign(ctx context.Context, input CargoVesselReassignInput) error {
+	cargoID, err := NewCargoID(input.ID)
+	if err != nil {
+		return ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	vesselID, err := NewVesselID(input.VesselID)
+	if err != nil {
+		return ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	cargo, err := r.repository.Find(ctx, cargoID)
+	if err != nil {
+		return ErrCargoUpdateFailed.Wrap(err)
+	}
+
+	// The destination vessel is checked up front, so the vessel change is tracked at its position.
+	vessel, err := r.vesselCheck.Check(ctx, vesselID)
+	if err != nil {
+		return fmt.Errorf("error checking vessel: %w", err)
+	}
+
+	cargo.locate(vessel)
+	if updateErr := cargo.Update(
+		ctx,
+		WithExpectedVersion(input.ExpectedVersion),
+		WithVesselID(input.TrackingID, vesselID, input.At),
+	); updateErr != nil {
+		return ErrCargoUpdateFailed.Wrap(updateErr)
+	}
+
+	if guardErr := r.capacityGuard.Guard(ctx, vessel, cargo.items.Weight()); guardErr != nil {
+		return guardErr
+	}
+
+	return r.updater.persist(ctx, cargo)
+}
@@ -0,0 +1,203 @@
+package cargodomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/messaging"
+	messagingmock "github.com/soulcodex/deus-cargo-tracker/pkg/messaging/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+)
+
+func TestCargoVesselReassigner_Reassign(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	const (
+		cargoID         = "01K43FJ8ZCYAVQ14ZV7EKCPMR8"
+		currentVesselID = "01K4B43REGN4HBFQETVZZ484A3"
+		newVesselID     = "01K4BBCBY7MQCC5CVGKMRHBBTM"
+		vesselCapacity  = 10 // in kilograms
+	)
+
+	type mocks struct {
+		repo      *cargodomainmock.CargoRepositoryMock
+		checker   *cargodomainmock.CargoVesselCheckerMock
+		publisher *messagingmock.PublisherMock
+	}
+
+	newCargo := func(opts ...cargotest.CargoMotherOpt) *cargodomain.Cargo {
+		opts = append([]cargotest.CargoMotherOpt{
+			cargotest.WithID(cargoID),
+			cargotest.WithVesselID(currentVesselID),
+			cargotest.WithTimestamps(now, now),
+		}, opts...)
+
+		return cargotest.NewCargoMother(opts...).Build(t)
+	}
+
+	tests := []struct {
+		name          string
+		input         cargodomain.CargoVesselReassignInput
+		cargo         *cargodomain.Cargo
+		setupMocks    func(m mocks, cargo *cargodomain.Cargo)
+		assertion     func(t *testing.T, m mocks)
+		expectedError string
+	}{
+		{
+			name:  "should reassign cargo to the new vessel successfully",
+			input: cargodomain.CargoVesselReassignInput{ID: cargoID, VesselID: newVesselID},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				m.checker.CheckFunc = func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity).WithPosition(36.1408, -5.3536), nil
+				}
+				m.repo.ActiveWeightByVesselFunc = func(_ context.Context, _ cargodomain.VesselID) (uint64, error) {
+					return 0, nil
+				}
+				m.repo.SaveFunc = func(_ context.Context, _ *cargodomain.Cargo) error {
+					return nil
+				}
+				m.publisher.PublishFunc = func(_ context.Context, _ ...messaging.Message) error {
+					return nil
+				}
+			},
+			assertion: func(t *testing.T, m mocks) {
+				require.Len(t, m.repo.SaveCalls(), 1)
+				saved := m.repo.SaveCalls()[0].C
+				assert.Equal(t, cargodomain.VesselID(newVesselID), saved.VesselID())
+				require.Len(t, saved.Tracking(), 1)
+				entry := saved.Primitives().Tracking[0]
+				assert.Equal(t, "cargo.vessel_changed", entry.EntryType)
+
+				// The change is tracked at the destination vessel position, which is checked only once.
+				require.Len(t, m.checker.CheckCalls(), 1)
+				assert.Equal(t, cargodomain.VesselID(newVesselID), m.checker.CheckCalls()[0].VesselID)
+				require.NotNil(t, entry.VesselLatitude)
+				require.NotNil(t, entry.VesselLongitude)
+				assert.InDelta(t, 36.1408, *entry.VesselLatitude, 0)
+				assert.InDelta(t, -5.3536, *entry.VesselLongitude, 0)
+
+				require.Len(t, m.publisher.PublishCalls(), 1)
+				events := m.publisher.PublishCalls()[0].Messages
+				require.Len(t, events, 1)
+				assert.Equal(t, cargodomain.CargoVesselChangedV1DomainEventName, events[0].Type())
+			},
+		},
+		{
+			name:          "should fail when vessel id is invalid",
+			input:         cargodomain.CargoVesselReassignInput{ID: cargoID, VesselID: "invalid"},
+			cargo:         newCargo(),
+			setupMocks:    func(_ mocks, _ *cargodomain.Cargo) {},
+			expectedError: "invalid cargo vessel id provided",
+		},
+		{
+			name:  "should fail when cargo is not pending",
+			input: cargodomain.CargoVesselReassignInput{ID: cargoID, VesselID: newVesselID},
+			cargo: newCargo(cargotest.WithStatus(cargodomain.StatusInTransit.String())),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				m.checker.CheckFunc = func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+			},
+			expectedError: "vessel change is only allowed on pending cargoes",
+		},
+		{
+			name:  "should fail when vessel is unchanged",
+			input: cargodomain.CargoVesselReassignInput{ID: cargoID, VesselID: currentVesselID},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				m.checker.CheckFunc = func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+			},
+			expectedError: "vessel is unchanged",
+		},
+		{
+			name:  "should fail when new vessel does not exist",
+			input: cargodomain.CargoVesselReassignInput{ID: cargoID, VesselID: newVesselID},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				m.checker.CheckFunc = func(_ context.Context, _ cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.CargoVessel{}, errors.New("vessel not found")
+				}
+			},
+			expectedError: "error checking vessel: vessel not found",
+		},
+		{
+			name:  "should fail when new vessel has no room for the cargo",
+			input: cargodomain.CargoVesselReassignInput{ID: cargoID, VesselID: newVesselID},
+			cargo: newCargo(),
+			setupMocks: func(m mocks, cargo *cargodomain.Cargo) {
+				m.repo.FindFunc = func(_ context.Context, _ cargodomain.CargoID, _ ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
+					return cargo, nil
+				}
+				m.checker.CheckFunc = func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, vesselCapacity), nil
+				}
+				m.repo.ActiveWeightByVesselFunc = func(_ context.Context, _ cargodomain.VesselID) (uint64, error) {
+					return 9000, nil
+				}
+			},
+			assertion: func(t *testing.T, m mocks) {
+				assert.Empty(t, m.repo.SaveCalls())
+			},
+			expectedError: "cargo exceeds vessel capacity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				repo:      &cargodomainmock.CargoRepositoryMock{},
+				checker:   &cargodomainmock.CargoVesselCheckerMock{},
+				publisher: &messagingmock.PublisherMock{},
+			}
+			tt.setupMocks(m, tt.cargo)
+			if m.checker.CheckFunc == nil {
+				m.checker.CheckFunc = func(_ context.Context, _ cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.CargoVessel{}, errors.New("vessel not found")
+				}
+			}
+
+			input := tt.input
+			input.TrackingID, input.At = utils.NewFixedULIDProvider().New().String(), now
+
+			updater := cargodomain.NewCargoUpdater(m.repo, m.checker, m.publisher, zerolog.Nop())
+			reassigner := cargodomain.NewCargoVesselReassigner(m.repo, m.checker, updater)
+			err := reassigner.Reassign(ctx, input)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.assertion != nil {
+				tt.assertion(t, m)
+			}
+		})
+	}
+}
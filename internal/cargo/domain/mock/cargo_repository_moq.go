@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 	"sync"
+	"time"
 )
 
 // Ensure, that CargoRepositoryMock does implement cargodomain.CargoRepository.
@@ -19,12 +20,24 @@ var _ cargodomain.CargoRepository = &CargoRepositoryMock{}
 //
 //		// make and configure a mocked cargodomain.CargoRepository
 //		mockedCargoRepository := &CargoRepositoryMock{
+//			ActiveWeightByVesselFunc: func(ctx context.Context, vesselID cargodomain.VesselID) (uint64, error) {
+//				panic("mock out the ActiveWeightByVessel method")
+//			},
 //			FindFunc: func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error) {
 //				panic("mock out the Find method")
 //			},
 //			SaveFunc: func(ctx context.Context, c *cargodomain.Cargo) error {
 //				panic("mock out the Save method")
 //			},
+//			SaveAllFunc: func(ctx context.Context, cargoes ...*cargodomain.Cargo) error {
+//				panic("mock out the SaveAll method")
+//			},
+//			SearchFunc: func(ctx context.Context, criteria *cargodomain.CargoSearchCriteria) (cargodomain.CargoSearchResult, error) {
+//				panic("mock out the Search method")
+//			},
+//			SearchStalledFunc: func(ctx context.Context, changedBefore time.Time, limit uint64) ([]cargodomain.StalledCargo, error) {
+//				panic("mock out the SearchStalled method")
+//			},
 //		}
 //
 //		// use mockedCargoRepository in code that requires cargodomain.CargoRepository
@@ -32,14 +45,33 @@ var _ cargodomain.CargoRepository = &CargoRepositoryMock{}
 //
 //	}
 type CargoRepositoryMock struct {
+	// ActiveWeightByVesselFunc mocks the ActiveWeightByVessel method.
+	ActiveWeightByVesselFunc func(ctx context.Context, vesselID cargodomain.VesselID) (uint64, error)
+
 	// FindFunc mocks the Find method.
 	FindFunc func(ctx context.Context, id cargodomain.CargoID, opts ...cargodomain.CargoFindingOpt) (*cargodomain.Cargo, error)
 
 	// SaveFunc mocks the Save method.
 	SaveFunc func(ctx context.Context, c *cargodomain.Cargo) error
 
+	// SaveAllFunc mocks the SaveAll method.
+	SaveAllFunc func(ctx context.Context, cargoes ...*cargodomain.Cargo) error
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(ctx context.Context, criteria *cargodomain.CargoSearchCriteria) (cargodomain.CargoSearchResult, error)
+
+	// SearchStalledFunc mocks the SearchStalled method.
+	SearchStalledFunc func(ctx context.Context, changedBefore time.Time, limit uint64) ([]cargodomain.StalledCargo, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// ActiveWeightByVessel holds details about calls to the ActiveWeightByVessel method.
+		ActiveWeightByVessel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VesselID is the vesselID argument value.
+			VesselID cargodomain.VesselID
+		}
 		// Find holds details about calls to the Find method.
 		Find []struct {
 			// Ctx is the ctx argument value.
@@ -56,9 +88,72 @@ type CargoRepositoryMock struct {
 			// C is the c argument value.
 			C *cargodomain.Cargo
 		}
+		// SaveAll holds details about calls to the SaveAll method.
+		SaveAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Cargoes is the cargoes argument value.
+			Cargoes []*cargodomain.Cargo
+		}
+		// Search holds details about calls to the Search method.
+		Search []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Criteria is the criteria argument value.
+			Criteria *cargodomain.CargoSearchCriteria
+		}
+		// SearchStalled holds details about calls to the SearchStalled method.
+		SearchStalled []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChangedBefore is the changedBefore argument value.
+			ChangedBefore time.Time
+			// Limit is the limit argument value.
+			Limit uint64
+		}
+	}
+	lockActiveWeightByVessel sync.RWMutex
+	lockFind                 sync.RWMutex
+	lockSave                 sync.RWMutex
+	lockSaveAll              sync.RWMutex
+	lockSearch               sync.RWMutex
+	lockSearchStalled        sync.RWMutex
+}
+
+// ActiveWeightByVessel calls ActiveWeightByVesselFunc.
+func (mock *CargoRepositoryMock) ActiveWeightByVessel(ctx context.Context, vesselID cargodomain.VesselID) (uint64, error) {
+	if mock.ActiveWeightByVesselFunc == nil {
+		panic("CargoRepositoryMock.ActiveWeightByVesselFunc: method is nil but CargoRepository.ActiveWeightByVessel was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		VesselID cargodomain.VesselID
+	}{
+		Ctx:      ctx,
+		VesselID: vesselID,
 	}
-	lockFind sync.RWMutex
-	lockSave sync.RWMutex
+	mock.lockActiveWeightByVessel.Lock()
+	mock.calls.ActiveWeightByVessel = append(mock.calls.ActiveWeightByVessel, callInfo)
+	mock.lockActiveWeightByVessel.Unlock()
+	return mock.ActiveWeightByVesselFunc(ctx, vesselID)
+}
+
+// ActiveWeightByVesselCalls gets all the calls that were made to ActiveWeightByVessel.
+// Check the length with:
+//
+//	len(mockedCargoRepository.ActiveWeightByVesselCalls())
+func (mock *CargoRepositoryMock) ActiveWeightByVesselCalls() []struct {
+	Ctx      context.Context
+	VesselID cargodomain.VesselID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		VesselID cargodomain.VesselID
+	}
+	mock.lockActiveWeightByVessel.RLock()
+	calls = mock.calls.ActiveWeightByVessel
+	mock.lockActiveWeightByVessel.RUnlock()
+	return calls
 }
 
 // Find calls FindFunc.
@@ -136,3 +231,115 @@ func (mock *CargoRepositoryMock) SaveCalls() []struct {
 	mock.lockSave.RUnlock()
 	return calls
 }
+
+// SaveAll calls SaveAllFunc.
+func (mock *CargoRepositoryMock) SaveAll(ctx context.Context, cargoes ...*cargodomain.Cargo) error {
+	if mock.SaveAllFunc == nil {
+		panic("CargoRepositoryMock.SaveAllFunc: method is nil but CargoRepository.SaveAll was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Cargoes []*cargodomain.Cargo
+	}{
+		Ctx:     ctx,
+		Cargoes: cargoes,
+	}
+	mock.lockSaveAll.Lock()
+	mock.calls.SaveAll = append(mock.calls.SaveAll, callInfo)
+	mock.lockSaveAll.Unlock()
+	return mock.SaveAllFunc(ctx, cargoes...)
+}
+
+// SaveAllCalls gets all the calls that were made to SaveAll.
+// Check the length with:
+//
+//	len(mockedCargoRepository.SaveAllCalls())
+func (mock *CargoRepositoryMock) SaveAllCalls() []struct {
+	Ctx     context.Context
+	Cargoes []*cargodomain.Cargo
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Cargoes []*cargodomain.Cargo
+	}
+	mock.lockSaveAll.RLock()
+	calls = mock.calls.SaveAll
+	mock.lockSaveAll.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *CargoRepositoryMock) Search(ctx context.Context, criteria *cargodomain.CargoSearchCriteria) (cargodomain.CargoSearchResult, error) {
+	if mock.SearchFunc == nil {
+		panic("CargoRepositoryMock.SearchFunc: method is nil but CargoRepository.Search was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Criteria *cargodomain.CargoSearchCriteria
+	}{
+		Ctx:      ctx,
+		Criteria: criteria,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(ctx, criteria)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+// Check the length with:
+//
+//	len(mockedCargoRepository.SearchCalls())
+func (mock *CargoRepositoryMock) SearchCalls() []struct {
+	Ctx      context.Context
+	Criteria *cargodomain.CargoSearchCriteria
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Criteria *cargodomain.CargoSearchCriteria
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
+
+// SearchStalled calls SearchStalledFunc.
+func (mock *CargoRepositoryMock) SearchStalled(ctx context.Context, changedBefore time.Time, limit uint64) ([]cargodomain.StalledCargo, error) {
+	if mock.SearchStalledFunc == nil {
+		panic("CargoRepositoryMock.SearchStalledFunc: method is nil but CargoRepository.SearchStalled was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ChangedBefore time.Time
+		Limit         uint64
+	}{
+		Ctx:           ctx,
+		ChangedBefore: changedBefore,
+		Limit:         limit,
+	}
+	mock.lockSearchStalled.Lock()
+	mock.calls.SearchStalled = append(mock.calls.SearchStalled, callInfo)
+	mock.lockSearchStalled.Unlock()
+	return mock.SearchStalledFunc(ctx, changedBefore, limit)
+}
+
+// SearchStalledCalls gets all the calls that were made to SearchStalled.
+// Check the length with:
+//
+//	len(mockedCargoRepository.SearchStalledCalls())
+func (mock *CargoRepositoryMock) SearchStalledCalls() []struct {
+	Ctx           context.Context
+	ChangedBefore time.Time
+	Limit         uint64
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ChangedBefore time.Time
+		Limit         uint64
+	}
+	mock.lockSearchStalled.RLock()
+	calls = mock.calls.SearchStalled
+	mock.lockSearchStalled.RUnlock()
+	return calls
+}
@@ -19,7 +19,7 @@ var _ cargodomain.CargoVesselChecker = &CargoVesselCheckerMock{}
 //
 //		// make and configure a mocked cargodomain.CargoVesselChecker
 //		mockedCargoVesselChecker := &CargoVesselCheckerMock{
-//			CheckFunc: func(ctx context.Context, vesselID cargodomain.VesselID) error {
+//			CheckFunc: func(ctx context.Context, vesselID cargodomain.VesselID) (cargodomain.CargoVessel, error) {
 //				panic("mock out the Check method")
 //			},
 //		}
@@ -30,7 +30,7 @@ var _ cargodomain.CargoVesselChecker = &CargoVesselCheckerMock{}
 //	}
 type CargoVesselCheckerMock struct {
 	// CheckFunc mocks the Check method.
-	CheckFunc func(ctx context.Context, vesselID cargodomain.VesselID) error
+	CheckFunc func(ctx context.Context, vesselID cargodomain.VesselID) (cargodomain.CargoVessel, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -46,7 +46,7 @@ type CargoVesselCheckerMock struct {
 }
 
 // Check calls CheckFunc.
-func (mock *CargoVesselCheckerMock) Check(ctx context.Context, vesselID cargodomain.VesselID) error {
+func (mock *CargoVesselCheckerMock) Check(ctx context.Context, vesselID cargodomain.VesselID) (cargodomain.CargoVessel, error) {
 	if mock.CheckFunc == nil {
 		panic("CargoVesselCheckerMock.CheckFunc: method is nil but CargoVesselChecker.Check was just called")
 	}
@@ -1,52 +1,94 @@
 package cargodomain
 
 import (
+	"maps"
 	"time"
 
 	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
 )
 
 type ItemsPrimitives struct {
-	Name   string `json:"name"`
-	Weight uint64 `json:"weight"`
+	Name        string `json:"name"`
+	Weight      uint64 `json:"weight"`
+	UNNumber    string `json:"un_number,omitempty"`
+	HazardClass string `json:"hazard_class,omitempty"`
+	Length      uint64 `json:"length,omitempty"`
+	Width       uint64 `json:"width,omitempty"`
+	Height      uint64 `json:"height,omitempty"`
 }
 
 func itemsToPrimitives(items Items) []ItemsPrimitives {
 	primitives := make([]ItemsPrimitives, len(items))
 	for i, item := range items {
 		primitives[i] = ItemsPrimitives{
-			Name:   item.name,
-			Weight: item.weight,
+			Name:        item.name,
+			Weight:      item.weight,
+			UNNumber:    item.unNumber,
+			HazardClass: item.hazardClass.String(),
+			Length:      item.dimensions.length,
+			Width:       item.dimensions.width,
+			Height:      item.dimensions.height,
 		}
 	}
 
 	return primitives
 }
 
+type PartyPrimitives struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Address string `json:"address"`
+}
+
+func partyToPrimitives(party *Party) *PartyPrimitives {
+	if party == nil {
+		return nil
+	}
+
+	return &PartyPrimitives{
+		Name:    party.name,
+		Email:   party.email,
+		Address: party.address,
+	}
+}
+
 type CargoPrimitives struct {
 	ID        string
 	VesselID  string
 	Items     []ItemsPrimitives
 	Tracking  cargotrackingdomain.TrackingPrimitives
+	Shipper   *PartyPrimitives
+	Consignee *PartyPrimitives
+	Metadata  map[string]string
 	Status    string
 	Weight    uint64
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	// Volume and ChargeableWeight are derived from the items, they're not meant to be persisted.
+	Volume           uint64
+	ChargeableWeight uint64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
+	Version          uint64
 }
 
 func newCargoPrimitives(c *Cargo) CargoPrimitives {
 	items := itemsToPrimitives(c.items)
 
 	return CargoPrimitives{
-		ID:        c.id.String(),
-		VesselID:  c.vesselID.String(),
-		Items:     items,
-		Tracking:  cargotrackingdomain.NewTrackingPrimitives(c.id.String(), c.tracking),
-		Status:    c.status.String(),
-		Weight:    c.items.Weight(),
-		CreatedAt: c.createdAt,
-		UpdatedAt: c.updatedAt,
-		DeletedAt: c.deletedAt,
+		ID:               c.id.String(),
+		VesselID:         c.vesselID.String(),
+		Items:            items,
+		Tracking:         cargotrackingdomain.NewTrackingPrimitives(c.id.String(), c.tracking),
+		Shipper:          partyToPrimitives(c.shipper),
+		Consignee:        partyToPrimitives(c.consignee),
+		Metadata:         maps.Clone(c.metadata),
+		Status:           c.status.String(),
+		Weight:           c.items.Weight(),
+		Volume:           c.items.Volume(),
+		ChargeableWeight: c.items.ChargeableWeight(),
+		CreatedAt:        c.createdAt,
+		UpdatedAt:        c.updatedAt,
+		DeletedAt:        c.deletedAt,
+		Version:          c.version,
 	}
 }
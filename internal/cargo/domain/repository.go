@@ -2,6 +2,9 @@ package cargodomain
 
 import (
 	"context"
+	"time"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
 )
 
 type CargoFindingOpt func(*CargoFindingOptions)
@@ -31,10 +34,19 @@ func WithTracking() CargoFindingOpt {
 
 type CargoRepositoryReader interface {
 	Find(ctx context.Context, id CargoID, opts ...CargoFindingOpt) (*Cargo, error)
+	Search(ctx context.Context, criteria *CargoSearchCriteria) (CargoSearchResult, error)
+	// ActiveWeightByVessel sums, in grams, the weight of the non deleted cargoes which are
+	// not in a final status assigned to the given vessel.
+	ActiveWeightByVessel(ctx context.Context, vesselID VesselID) (uint64, error)
+	// SearchStalled returns, oldest first, the in transit cargoes whose last status change happened
+	// before the given instant and whose delay was not detected since then.
+	SearchStalled(ctx context.Context, changedBefore time.Time, limit uint64) ([]StalledCargo, error)
 }
 
 type CargoRepositoryWriter interface {
 	Save(ctx context.Context, c *Cargo) error
+	// SaveAll saves every given cargo atomically, none of them is saved when any fails.
+	SaveAll(ctx context.Context, cargoes ...*Cargo) error
 }
 
 //go:generate moq -pkg cargodomainmock -out mock/cargo_repository_moq.go . CargoRepository
@@ -42,3 +54,12 @@ type CargoRepository interface {
 	CargoRepositoryReader
 	CargoRepositoryWriter
 }
+
+// CargoTrackingReader reads the tracking timeline of a cargo in chronological order.
+type CargoTrackingReader interface {
+	SearchTracking(
+		ctx context.Context,
+		cargoID CargoID,
+		criteria *cargotrackingdomain.TrackingSearchCriteria,
+	) (cargotrackingdomain.TrackingSearchResult, error)
+}
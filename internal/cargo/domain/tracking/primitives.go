@@ -6,12 +6,15 @@ import (
 
 type TrackingPrimitives []TrackingItemPrimitives
 type TrackingItemPrimitives struct {
-	ID           string
-	CargoID      string
-	EntryType    string
-	StatusBefore *string
-	StatusAfter  *string
-	CreatedAt    time.Time
+	ID              string
+	CargoID         string
+	EntryType       string
+	StatusBefore    *string
+	StatusAfter     *string
+	Details         map[string]any
+	VesselLatitude  *float64
+	VesselLongitude *float64
+	CreatedAt       time.Time
 }
 
 func NewTrackingPrimitives(cargoID string, items Tracking) TrackingPrimitives {
@@ -40,12 +43,29 @@ func NewTrackingItemPrimitives(cargoID string, t TrackingItem) TrackingItemPrimi
 		statusAfter = &sa
 	}
 
+	var details map[string]any
+	if len(t.details) > 0 {
+		details = make(map[string]any, len(t.details))
+		for key, value := range t.details {
+			details[key] = value
+		}
+	}
+
+	var vesselLatitude, vesselLongitude *float64
+	if t.vesselPosition != nil {
+		latitude, longitude := t.vesselPosition.latitude, t.vesselPosition.longitude
+		vesselLatitude, vesselLongitude = &latitude, &longitude
+	}
+
 	return TrackingItemPrimitives{
-		ID:           t.id.String(),
-		CargoID:      cargoID,
-		EntryType:    t.entryType.String(),
-		StatusBefore: statusBefore,
-		StatusAfter:  statusAfter,
-		CreatedAt:    t.createdAt,
+		ID:              t.id.String(),
+		CargoID:         cargoID,
+		EntryType:       t.entryType.String(),
+		StatusBefore:    statusBefore,
+		StatusAfter:     statusAfter,
+		Details:         details,
+		VesselLatitude:  vesselLatitude,
+		VesselLongitude: vesselLongitude,
+		CreatedAt:       t.createdAt,
 	}
 }
@@ -1,6 +1,8 @@
 package cargotrackingdomain
 
 import (
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -10,24 +12,68 @@ func NewEmptyTracking() Tracking {
 	return make(Tracking, 0)
 }
 
+// Until returns, in chronological order, the tracking items recorded up to the given instant.
+func (t Tracking) Until(at time.Time) Tracking {
+	until := make(Tracking, 0, len(t))
+	for _, item := range t {
+		if !item.createdAt.After(at) {
+			until = append(until, item)
+		}
+	}
+
+	slices.SortStableFunc(until, func(a, b TrackingItem) int {
+		if byTime := a.createdAt.Compare(b.createdAt); byTime != 0 {
+			return byTime
+		}
+
+		return strings.Compare(a.id.String(), b.id.String())
+	})
+
+	return until
+}
+
+// TrackingDetails holds the entry type specific data of a tracking item.
+type TrackingDetails map[string]any
+
 type TrackingItem struct {
-	id           TrackingID
-	entryType    TrackingEntryType
-	createdAt    time.Time
-	statusBefore *string
-	statusAfter  *string
+	id             TrackingID
+	entryType      TrackingEntryType
+	createdAt      time.Time
+	statusBefore   *string
+	statusAfter    *string
+	details        TrackingDetails
+	vesselPosition *VesselPosition
 }
 
 func NewTrackingItemFromPrimitives(p TrackingItemPrimitives) TrackingItem {
+	var vesselPosition *VesselPosition
+	if p.VesselLatitude != nil && p.VesselLongitude != nil {
+		position := NewVesselPosition(*p.VesselLatitude, *p.VesselLongitude)
+		vesselPosition = &position
+	}
+
 	return TrackingItem{
-		id:           TrackingID(p.ID),
-		entryType:    TrackingEntryType(p.EntryType),
-		createdAt:    p.CreatedAt,
-		statusBefore: p.StatusBefore,
-		statusAfter:  p.StatusAfter,
+		id:             TrackingID(p.ID),
+		entryType:      TrackingEntryType(p.EntryType),
+		createdAt:      p.CreatedAt,
+		statusBefore:   p.StatusBefore,
+		statusAfter:    p.StatusAfter,
+		details:        p.Details,
+		vesselPosition: vesselPosition,
 	}
 }
 
+// StatusAfter returns the cargo status once the entry was recorded, nil when it's unknown.
+func (t TrackingItem) StatusAfter() *string {
+	return t.statusAfter
+}
+
+// WithVesselPosition returns a copy of the tracking item stamped with the given vessel position.
+func (t TrackingItem) WithVesselPosition(position *VesselPosition) TrackingItem {
+	t.vesselPosition = position
+	return t
+}
+
 func NewTrackingOnCargoCreated(trackingID TrackingID, cargoStatus string, createdAt time.Time) TrackingItem {
 	return TrackingItem{
 		id:           trackingID,
@@ -51,3 +97,158 @@ func NewTrackingOnCargoStatusChanged(
 		statusAfter:  &statusAfter,
 	}
 }
+
+func NewTrackingOnCargoVesselChanged(
+	id TrackingID,
+	createdAt time.Time,
+	cargoStatus string,
+	vesselBefore, vesselAfter string,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeVesselChanged,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+		details: TrackingDetails{
+			"vessel_before": vesselBefore,
+			"vessel_after":  vesselAfter,
+		},
+	}
+}
+
+func NewTrackingOnCargoItemsChanged(
+	id TrackingID,
+	createdAt time.Time,
+	cargoStatus string,
+	operation string,
+	weightBefore, weightAfter uint64,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeItemsChanged,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+		details: TrackingDetails{
+			"operation":     operation,
+			"weight_before": weightBefore,
+			"weight_after":  weightAfter,
+		},
+	}
+}
+
+func NewTrackingOnCargoMetadataChanged(
+	id TrackingID,
+	createdAt time.Time,
+	cargoStatus string,
+	keysSet, keysRemoved []string,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeMetadataChanged,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+		details: TrackingDetails{
+			"keys_set":     keysSet,
+			"keys_removed": keysRemoved,
+		},
+	}
+}
+
+// NewTrackingOnCargoSplitFrom is recorded on both the split cargo and the one created from it
+// so each of them references the other, the items are the names of the ones moved.
+func NewTrackingOnCargoSplitFrom(
+	id TrackingID,
+	createdAt time.Time,
+	cargoStatus string,
+	sourceCargoID, splitCargoID string,
+	items []string,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeSplitFrom,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+		details: TrackingDetails{
+			"source_cargo_id": sourceCargoID,
+			"split_cargo_id":  splitCargoID,
+			"items":           items,
+		},
+	}
+}
+
+// NewTrackingOnCargoMergedInto is recorded on both the merged cargo and the one absorbing it
+// so each of them references the other, the weight is the one moved in grams.
+func NewTrackingOnCargoMergedInto(
+	id TrackingID,
+	createdAt time.Time,
+	cargoStatus string,
+	sourceCargoID, targetCargoID string,
+	weight uint64,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeMergedInto,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+		details: TrackingDetails{
+			"source_cargo_id": sourceCargoID,
+			"target_cargo_id": targetCargoID,
+			"weight":          weight,
+		},
+	}
+}
+
+func NewTrackingOnCargoCancelled(
+	id TrackingID,
+	createdAt time.Time,
+	statusBefore, statusAfter string,
+	reason string,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeCancelled,
+		createdAt:    createdAt,
+		statusBefore: &statusBefore,
+		statusAfter:  &statusAfter,
+		details: TrackingDetails{
+			"reason": reason,
+		},
+	}
+}
+
+func NewTrackingOnCargoDeleted(id TrackingID, createdAt time.Time, cargoStatus string) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    trackingEntryTypeDeleted,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+	}
+}
+
+// NewTrackingOnCargoDelayDetected records a cargo kept in the same status for longer than
+// the given threshold, statusSince being the instant of its last status change.
+func NewTrackingOnCargoDelayDetected(
+	id TrackingID,
+	createdAt time.Time,
+	cargoStatus string,
+	statusSince time.Time,
+	threshold time.Duration,
+) TrackingItem {
+	return TrackingItem{
+		id:           id,
+		entryType:    TrackingEntryTypeDelayDetected,
+		createdAt:    createdAt,
+		statusBefore: &cargoStatus,
+		statusAfter:  &cargoStatus,
+		details: TrackingDetails{
+			"status_since":      statusSince.Format(time.RFC3339),
+			"threshold_seconds": int64(threshold.Seconds()),
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package cargotrackingdomain
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	trackingCursorSeparator  = "|"
+	trackingCursorPartsCount = 2
+)
+
+var (
+	ErrInvalidTrackingCursorProvided = domainvalidation.NewError("invalid tracking cursor provided")
+)
+
+// TrackingCursor points to the last tracking item of a page, entries are always
+// sorted chronologically so it holds its creation time and id for the keyset.
+type TrackingCursor struct {
+	createdAt time.Time
+	id        TrackingID
+}
+
+func NewTrackingCursor(item TrackingItem) TrackingCursor {
+	return TrackingCursor{createdAt: item.createdAt, id: item.id}
+}
+
+func ParseTrackingCursor(raw string) (TrackingCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return TrackingCursor{}, ErrInvalidTrackingCursorProvided.Wrap(err)
+	}
+
+	parts := strings.Split(string(decoded), trackingCursorSeparator)
+	if len(parts) != trackingCursorPartsCount {
+		return TrackingCursor{}, ErrInvalidTrackingCursorProvided
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return TrackingCursor{}, ErrInvalidTrackingCursorProvided.Wrap(err)
+	}
+
+	id, err := NewTrackingID(parts[1])
+	if err != nil {
+		return TrackingCursor{}, ErrInvalidTrackingCursorProvided.Wrap(err)
+	}
+
+	return TrackingCursor{createdAt: createdAt, id: id}, nil
+}
+
+func (c TrackingCursor) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c TrackingCursor) ID() TrackingID {
+	return c.id
+}
+
+func (c TrackingCursor) String() string {
+	raw := strings.Join([]string{c.createdAt.Format(time.RFC3339Nano), c.id.String()}, trackingCursorSeparator)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
@@ -1,12 +1,57 @@
 package cargotrackingdomain
 
+import (
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
 const (
-	trackingEntryTypeCreated       TrackingEntryType = "cargo.created"
-	trackingEntryTypeStatusChanged TrackingEntryType = "cargo.status_changed"
+	trackingEntryTypeCreated         TrackingEntryType = "cargo.created"
+	trackingEntryTypeStatusChanged   TrackingEntryType = "cargo.status_changed"
+	trackingEntryTypeVesselChanged   TrackingEntryType = "cargo.vessel_changed"
+	trackingEntryTypeItemsChanged    TrackingEntryType = "cargo.items_changed"
+	trackingEntryTypeCancelled       TrackingEntryType = "cargo.cancelled"
+	trackingEntryTypeDeleted         TrackingEntryType = "cargo.deleted"
+	trackingEntryTypeMetadataChanged TrackingEntryType = "cargo.metadata_changed"
+	trackingEntryTypeSplitFrom       TrackingEntryType = "cargo.split_from"
+	trackingEntryTypeMergedInto      TrackingEntryType = "cargo.merged_into"
+
+	// TrackingEntryTypeDelayDetected is exported so stalled cargoes already flagged can be told apart.
+	TrackingEntryTypeDelayDetected TrackingEntryType = "cargo.delay_detected"
+)
+
+var (
+	validTrackingEntryTypes = map[TrackingEntryType]struct{}{
+		trackingEntryTypeCreated:         {},
+		trackingEntryTypeStatusChanged:   {},
+		trackingEntryTypeVesselChanged:   {},
+		trackingEntryTypeItemsChanged:    {},
+		trackingEntryTypeCancelled:       {},
+		trackingEntryTypeDeleted:         {},
+		trackingEntryTypeMetadataChanged: {},
+		trackingEntryTypeSplitFrom:       {},
+		trackingEntryTypeMergedInto:      {},
+		TrackingEntryTypeDelayDetected:   {},
+	}
+
+	ErrInvalidTrackingEntryTypeProvided = domainvalidation.NewError("invalid tracking entry type provided")
 )
 
 type TrackingEntryType string
 
+func NewTrackingEntryType(raw string) (TrackingEntryType, error) {
+	entryType := TrackingEntryType(raw)
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.InMap(validTrackingEntryTypes),
+	)
+
+	if err := validator.Validate(entryType); err != nil {
+		return "", ErrInvalidTrackingEntryTypeProvided.Wrap(err)
+	}
+
+	return entryType, nil
+}
+
 func (s TrackingEntryType) String() string {
 	return string(s)
 }
@@ -0,0 +1,116 @@
+package cargotrackingdomain
+
+import (
+	"time"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	DefaultTrackingSearchPageSize uint64 = 50
+	MaxTrackingSearchPageSize     uint64 = 200
+)
+
+var (
+	ErrInvalidTrackingSearchCriteria = domainvalidation.NewError("invalid tracking search criteria provided")
+)
+
+type TrackingSearchOpt func(*TrackingSearchCriteria) error
+
+// TrackingSearchCriteria filters the tracking timeline of a cargo, which is always
+// returned in chronological order.
+type TrackingSearchCriteria struct {
+	EntryTypes    []TrackingEntryType
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+	Cursor        *TrackingCursor
+	PageSize      uint64
+}
+
+func newDefaultTrackingSearchCriteria() *TrackingSearchCriteria {
+	return &TrackingSearchCriteria{
+		EntryTypes:    make([]TrackingEntryType, 0),
+		CreatedAtFrom: nil,
+		CreatedAtTo:   nil,
+		Cursor:        nil,
+		PageSize:      DefaultTrackingSearchPageSize,
+	}
+}
+
+func NewTrackingSearchCriteria(opts ...TrackingSearchOpt) (*TrackingSearchCriteria, error) {
+	criteria := newDefaultTrackingSearchCriteria()
+	for _, opt := range opts {
+		if err := opt(criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	if criteria.CreatedAtFrom != nil && criteria.CreatedAtTo != nil && criteria.CreatedAtFrom.After(*criteria.CreatedAtTo) {
+		return nil, ErrInvalidTrackingSearchCriteria
+	}
+
+	return criteria, nil
+}
+
+func WithEntryTypeFilter(entryTypes ...string) TrackingSearchOpt {
+	return func(c *TrackingSearchCriteria) error {
+		for _, raw := range entryTypes {
+			entryType, err := NewTrackingEntryType(raw)
+			if err != nil {
+				return ErrInvalidTrackingSearchCriteria.Wrap(err)
+			}
+
+			c.EntryTypes = append(c.EntryTypes, entryType)
+		}
+
+		return nil
+	}
+}
+
+func WithCreatedAtRange(from, to *time.Time) TrackingSearchOpt {
+	return func(c *TrackingSearchCriteria) error {
+		c.CreatedAtFrom = from
+		c.CreatedAtTo = to
+		return nil
+	}
+}
+
+func WithCursor(raw string) TrackingSearchOpt {
+	return func(c *TrackingSearchCriteria) error {
+		if raw == "" {
+			return nil
+		}
+
+		cursor, err := ParseTrackingCursor(raw)
+		if err != nil {
+			return ErrInvalidTrackingSearchCriteria.Wrap(err)
+		}
+
+		c.Cursor = &cursor
+		return nil
+	}
+}
+
+func WithPageSize(size uint64) TrackingSearchOpt {
+	return func(c *TrackingSearchCriteria) error {
+		if size == 0 {
+			return nil
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.WithinBounds(1, MaxTrackingSearchPageSize),
+		)
+
+		if err := validator.Validate(size); err != nil {
+			return ErrInvalidTrackingSearchCriteria.Wrap(err)
+		}
+
+		c.PageSize = size
+		return nil
+	}
+}
+
+type TrackingSearchResult struct {
+	Tracking   Tracking
+	NextCursor *TrackingCursor
+}
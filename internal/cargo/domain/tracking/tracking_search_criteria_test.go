@@ -0,0 +1,91 @@
+package cargotrackingdomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+func TestNewTrackingSearchCriteria(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	trackingID := cargotrackingdomain.TrackingID(utils.NewULID().String())
+	cursor := cargotrackingdomain.NewTrackingCursor(cargotrackingdomain.NewTrackingOnCargoCreated(trackingID, "pending", now))
+
+	tests := []struct {
+		name          string
+		opts          []cargotrackingdomain.TrackingSearchOpt
+		assertion     func(t *testing.T, criteria *cargotrackingdomain.TrackingSearchCriteria)
+		expectedError bool
+	}{
+		{
+			name: "should build default criteria when no options are provided",
+			assertion: func(t *testing.T, criteria *cargotrackingdomain.TrackingSearchCriteria) {
+				assert.Empty(t, criteria.EntryTypes)
+				assert.Nil(t, criteria.CreatedAtFrom)
+				assert.Nil(t, criteria.CreatedAtTo)
+				assert.Nil(t, criteria.Cursor)
+				assert.Equal(t, cargotrackingdomain.DefaultTrackingSearchPageSize, criteria.PageSize)
+			},
+		},
+		{
+			name: "should build criteria with every filter provided",
+			opts: []cargotrackingdomain.TrackingSearchOpt{
+				cargotrackingdomain.WithEntryTypeFilter("cargo.created", "cargo.status_changed"),
+				cargotrackingdomain.WithCreatedAtRange(&before, &now),
+				cargotrackingdomain.WithCursor(cursor.String()),
+				cargotrackingdomain.WithPageSize(10),
+			},
+			assertion: func(t *testing.T, criteria *cargotrackingdomain.TrackingSearchCriteria) {
+				assert.Len(t, criteria.EntryTypes, 2)
+				assert.Equal(t, &before, criteria.CreatedAtFrom)
+				assert.Equal(t, &now, criteria.CreatedAtTo)
+				require.NotNil(t, criteria.Cursor)
+				assert.Equal(t, trackingID, criteria.Cursor.ID())
+				assert.True(t, now.Equal(criteria.Cursor.CreatedAt()))
+				assert.Equal(t, uint64(10), criteria.PageSize)
+			},
+		},
+		{
+			name:          "should fail on unknown entry type",
+			opts:          []cargotrackingdomain.TrackingSearchOpt{cargotrackingdomain.WithEntryTypeFilter("cargo.teleported")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail on inverted created at range",
+			opts:          []cargotrackingdomain.TrackingSearchOpt{cargotrackingdomain.WithCreatedAtRange(&now, &before)},
+			expectedError: true,
+		},
+		{
+			name:          "should fail on malformed cursor",
+			opts:          []cargotrackingdomain.TrackingSearchOpt{cargotrackingdomain.WithCursor("not-a-cursor")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail on page size over the max allowed",
+			opts:          []cargotrackingdomain.TrackingSearchOpt{cargotrackingdomain.WithPageSize(cargotrackingdomain.MaxTrackingSearchPageSize + 1)},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := cargotrackingdomain.NewTrackingSearchCriteria(tt.opts...)
+
+			if tt.expectedError {
+				require.ErrorIs(t, err, cargotrackingdomain.ErrInvalidTrackingSearchCriteria)
+				assert.Nil(t, criteria)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.assertion(t, criteria)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package cargotrackingdomain
+
+// VesselPosition is the location of the vessel carrying the cargo when a tracking entry was recorded.
+type VesselPosition struct {
+	latitude  float64
+	longitude float64
+}
+
+func NewVesselPosition(latitude, longitude float64) VesselPosition {
+	return VesselPosition{
+		latitude:  latitude,
+		longitude: longitude,
+	}
+}
+
+func (p VesselPosition) Latitude() float64 {
+	return p.latitude
+}
+
+func (p VesselPosition) Longitude() float64 {
+	return p.longitude
+}
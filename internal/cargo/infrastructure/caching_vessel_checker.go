@@ -0,0 +1,62 @@
+package cargoinfra
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+)
+
+var _ cargodomain.CargoVesselChecker = (*CachingVesselChecker)(nil)
+
+type vesselCheckCacheKey struct{}
+
+type vesselCheck struct {
+	vessel cargodomain.CargoVessel
+	err    error
+}
+
+type vesselCheckCache struct {
+	mu     sync.Mutex
+	checks map[cargodomain.VesselID]vesselCheck
+}
+
+// WithVesselCheckCache returns a context where every vessel is checked once at most,
+// meant for batches creating several cargoes for the same vessels.
+func WithVesselCheckCache(ctx context.Context) context.Context {
+	cache := &vesselCheckCache{checks: make(map[cargodomain.VesselID]vesselCheck)}
+	return context.WithValue(ctx, vesselCheckCacheKey{}, cache)
+}
+
+// CachingVesselChecker reuses the checks already made within the context cache, as long as they
+// either succeeded or found no vessel, any other failure is checked again. It behaves as the
+// decorated checker when the context has no cache.
+type CachingVesselChecker struct {
+	checker cargodomain.CargoVesselChecker
+}
+
+func NewCachingVesselChecker(checker cargodomain.CargoVesselChecker) *CachingVesselChecker {
+	return &CachingVesselChecker{checker: checker}
+}
+
+func (c *CachingVesselChecker) Check(ctx context.Context, vesselID cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+	cache, ok := ctx.Value(vesselCheckCacheKey{}).(*vesselCheckCache)
+	if !ok {
+		return c.checker.Check(ctx, vesselID)
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if check, found := cache.checks[vesselID]; found {
+		return check.vessel, check.err
+	}
+
+	vessel, err := c.checker.Check(ctx, vesselID)
+	if err == nil || errors.Is(err, ErrVesselNotFound) {
+		cache.checks[vesselID] = vesselCheck{vessel: vessel, err: err}
+	}
+
+	return vessel, err
+}
@@ -0,0 +1,73 @@
+package cargoinfra_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargodomainmock "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/mock"
+	cargoinfra "github.com/soulcodex/deus-cargo-tracker/internal/cargo/infrastructure"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+func TestCachingVesselChecker_Check(t *testing.T) {
+	vesselID, otherVesselID := cargodomain.VesselID(utils.NewULID().String()), cargodomain.VesselID(utils.NewULID().String())
+
+	tests := []struct {
+		name          string
+		ctx           func() context.Context
+		checkErr      error
+		expectedCalls int
+	}{
+		{
+			name:          "should check every vessel once within a cached context",
+			ctx:           func() context.Context { return cargoinfra.WithVesselCheckCache(context.Background()) },
+			expectedCalls: 2,
+		},
+		{
+			name:          "should reuse not found vessels within a cached context",
+			ctx:           func() context.Context { return cargoinfra.WithVesselCheckCache(context.Background()) },
+			checkErr:      cargoinfra.ErrVesselNotFound,
+			expectedCalls: 2,
+		},
+		{
+			name:          "should check again failed checks within a cached context",
+			ctx:           func() context.Context { return cargoinfra.WithVesselCheckCache(context.Background()) },
+			checkErr:      errors.New("query bus unavailable"),
+			expectedCalls: 4,
+		},
+		{
+			name:          "should check every time without a cached context",
+			ctx:           context.Background,
+			expectedCalls: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := &cargodomainmock.CargoVesselCheckerMock{
+				CheckFunc: func(_ context.Context, id cargodomain.VesselID) (cargodomain.CargoVessel, error) {
+					return cargodomain.NewCargoVessel(id, 1), tt.checkErr
+				},
+			}
+
+			caching, ctx := cargoinfra.NewCachingVesselChecker(checker), tt.ctx()
+			for _, id := range []cargodomain.VesselID{vesselID, otherVesselID, vesselID, otherVesselID} {
+				vessel, err := caching.Check(ctx, id)
+				if tt.checkErr != nil {
+					require.ErrorIs(t, err, tt.checkErr)
+					continue
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, id, vessel.ID())
+			}
+
+			assert.Len(t, checker.CheckCalls(), tt.expectedCalls)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package cargoentrypoint
+
+import (
+	"context"
+	"time"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargoqueries "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/queries"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/logger"
+)
+
+const cargoDelayDetectionMutexKey = "cargo_delay_detection"
+
+// CargoDelayDetectorWorker periodically flags the in transit cargoes whose status didn't change
+// for longer than the configured threshold. A run only happens on the instance acquiring the
+// distributed lock, the rest skip it instead of waiting to scan again, and a cargo is only flagged
+// while it's still on the version it was found stalled on.
+type CargoDelayDetectorWorker struct {
+	queryBus   querybus.Bus
+	commandBus commandbus.Bus
+	mutex      distributedsync.MutexService
+	logger     logger.ZerologLogger
+	threshold  time.Duration
+	interval   time.Duration
+}
+
+func NewCargoDelayDetectorWorker(
+	queryBus querybus.Bus,
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	logger logger.ZerologLogger,
+	threshold time.Duration,
+	interval time.Duration,
+) *CargoDelayDetectorWorker {
+	return &CargoDelayDetectorWorker{
+		queryBus:   queryBus,
+		commandBus: commandBus,
+		mutex:      mutex,
+		logger:     logger,
+		threshold:  threshold,
+		interval:   interval,
+	}
+}
+
+// Run detects delays on every interval until the given context is done.
+func (w *CargoDelayDetectorWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Detect(ctx); err != nil {
+				w.logger.Error().Ctx(ctx).Err(err).Msg("error detecting cargo delays")
+			}
+		}
+	}
+}
+
+// Detect flags the delay of the stalled cargoes found, a cargo failing doesn't prevent the rest
+// from being flagged and is picked again by the next run. Nothing is done while another instance
+// is running it.
+func (w *CargoDelayDetectorWorker) Detect(ctx context.Context) error {
+	_, err := w.mutex.TryMutex(ctx, cargoDelayDetectionMutexKey, func() (interface{}, error) {
+		query := &cargoqueries.SearchStalledCargoes{Threshold: w.threshold}
+		stalled, err := bus.DispatchWithResponse[*cargoqueries.SearchStalledCargoes, cargoqueries.StalledCargoesResponse](
+			w.queryBus,
+		)(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cargo := range stalled.Items {
+			cmd := &cargocommands.DetectCargoDelayCommand{
+				ID:              cargo.ID,
+				StatusSince:     cargo.StatusSince,
+				Threshold:       w.threshold,
+				ExpectedVersion: &cargo.Version,
+			}
+
+			if detectErr := bus.DispatchBlocking(w.commandBus, w.mutex)(ctx, cmd); detectErr != nil {
+				w.logger.Error().
+					Ctx(ctx).
+					Err(detectErr).
+					Str("cargo_id", cargo.ID).
+					Msg("error detecting cargo delay")
+			}
+		}
+
+		return struct{}{}, nil
+	})
+
+	if distributedsync.IsMutexHeldError(err) {
+		w.logger.Debug().Ctx(ctx).Msg("skipping cargo delay detection, another instance is running it")
+		return nil
+	}
+
+	return err
+}
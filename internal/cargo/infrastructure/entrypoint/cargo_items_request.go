@@ -0,0 +1,66 @@
+package cargoentrypoint
+
+import (
+	"encoding/json"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
+)
+
+// CargoItemsRequest keeps the items attribute as sent, google/jsonapi silently drops the items it
+// can't decode and has no way to decode a weight which is either a number or an object.
+type CargoItemsRequest []any
+
+type CargoItemRequest struct {
+	Name        string                 `json:"name"`
+	Weight      CargoItemWeightRequest `json:"weight"`
+	UNNumber    string                 `json:"un_number"`
+	HazardClass string                 `json:"hazard_class"`
+	Length      uint64                 `json:"length"`
+	Width       uint64                 `json:"width"`
+	Height      uint64                 `json:"height"`
+}
+
+// CargoItemWeightRequest is sent either as a plain number of grams or as a value along with its unit.
+type CargoItemWeightRequest struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+func (w *CargoItemWeightRequest) UnmarshalJSON(data []byte) error {
+	var grams float64
+	if err := json.Unmarshal(data, &grams); err == nil {
+		*w = CargoItemWeightRequest{Value: grams, Unit: domainweight.UnitGram.String()}
+		return nil
+	}
+
+	// weight drops this method so the object is decoded as usual.
+	type weight CargoItemWeightRequest
+
+	return json.Unmarshal(data, (*weight)(w))
+}
+
+// grams normalises the weight sent, whatever the unit it was expressed in.
+func (w CargoItemWeightRequest) grams() (uint64, error) {
+	weight, err := domainweight.NewWeight(w.Value, w.Unit)
+	if err != nil {
+		return 0, err
+	}
+
+	return weight.Grams(), nil
+}
+
+// decode returns the items sent, failing when any of them is malformed instead of dropping it.
+func (items CargoItemsRequest) decode() ([]CargoItemRequest, error) {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return nil, cargodomain.ErrInvalidItemsProvided.Wrap(err)
+	}
+
+	var decoded []CargoItemRequest
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, cargodomain.ErrInvalidItemsProvided.Wrap(err)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,23 @@
+package cargoentrypoint
+
+import (
+	"context"
+
+	cargoqueries "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/queries"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+)
+
+// fetchCargoVesselID resolves the vessel a cargo is loaded on, so the commands changing what the
+// vessel carries can lock its load before being dispatched.
+func fetchCargoVesselID(ctx context.Context, queryBus querybus.Bus, cargoID string) (string, error) {
+	cargo, err := bus.DispatchWithResponse[*cargoqueries.FetchCargoByID, cargoqueries.CargoResponse](queryBus)(
+		ctx,
+		&cargoqueries.FetchCargoByID{ID: cargoID},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return cargo.VesselID, nil
+}
@@ -11,21 +11,93 @@ import (
 	cargoinfra "github.com/soulcodex/deus-cargo-tracker/internal/cargo/infrastructure"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
 	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
 	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
 	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
 )
 
 type CreateCargoRequest struct {
-	ID       string `jsonapi:"primary,cargo"`
-	VesselID string `jsonapi:"attr,vessel_id"`
-	Items    []struct {
-		Name   string `jsonapi:"attr,name"`
-		Weight uint64 `jsonapi:"attr,weight"`
-	} `jsonapi:"attr,items"`
+	ID        string            `jsonapi:"primary,cargo"`
+	VesselID  string            `jsonapi:"attr,vessel_id"`
+	Items     CargoItemsRequest `jsonapi:"attr,items"`
+	Shipper   CargoPartyRequest `jsonapi:"attr,shipper"`
+	Consignee CargoPartyRequest `jsonapi:"attr,consignee"`
+	// Metadata values are checked to be strings on command.
+	Metadata map[string]any `jsonapi:"attr,metadata"`
+}
+
+type CargoPartyRequest struct {
+	Name    string `jsonapi:"attr,name"`
+	Email   string `jsonapi:"attr,email"`
+	Address string `jsonapi:"attr,address"`
+}
+
+// command returns nil when the party was not sent, as it's optional on cargo creation.
+func (p CargoPartyRequest) command() *cargocommands.CargoParty {
+	if p == (CargoPartyRequest{}) {
+		return nil
+	}
+
+	return &cargocommands.CargoParty{Name: p.Name, Email: p.Email, Address: p.Address}
+}
+
+// command checks what can't be enforced while decoding, the items shape and the metadata values type.
+func (req *CreateCargoRequest) command() (*cargocommands.CreateCargoCommand, error) {
+	for _, value := range req.Metadata {
+		if _, isString := value.(string); !isString {
+			return nil, cargodomain.ErrInvalidMetadataProvided
+		}
+	}
+
+	items, err := req.Items.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &cargocommands.CreateCargoCommand{
+		ID:       req.ID,
+		VesselID: req.VesselID,
+		Items: make([]struct {
+			Name   string `json:"name"`
+			Weight struct {
+				Value float64 `json:"value"`
+				Unit  string  `json:"unit"`
+			} `json:"weight"`
+			UNNumber    string `json:"un_number"`
+			HazardClass string `json:"hazard_class"`
+			Length      uint64 `json:"length"`
+			Width       uint64 `json:"width"`
+			Height      uint64 `json:"height"`
+		}, len(items)),
+		Shipper:   req.Shipper.command(),
+		Consignee: req.Consignee.command(),
+		Metadata:  newMetadataCommand(req.Metadata),
+	}
+	for i, item := range items {
+		cmd.Items[i].Name, cmd.Items[i].Weight.Value, cmd.Items[i].Weight.Unit = item.Name, item.Weight.Value, item.Weight.Unit
+		cmd.Items[i].UNNumber, cmd.Items[i].HazardClass = item.UNNumber, item.HazardClass
+		cmd.Items[i].Length, cmd.Items[i].Width, cmd.Items[i].Height = item.Length, item.Width, item.Height
+	}
+
+	return cmd, nil
+}
+
+// newMetadataCommand keeps the string values only, the request must be validated beforehand.
+func newMetadataCommand(raw map[string]any) map[string]string {
+	metadata := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if str, isString := value.(string); isString {
+			metadata[key] = str
+		}
+	}
+
+	return metadata
 }
 
 func HandlePOSTCreateCargoV1HTTP(
 	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
 	middleware *httpserver.JSONAPIResponseMiddleware,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -36,38 +108,45 @@ func HandlePOSTCreateCargoV1HTTP(
 			return
 		}
 
-		cmd := &cargocommands.CreateCargoCommand{
-			ID:       req.ID,
-			VesselID: req.VesselID,
-			Items: []struct {
-				Name   string `json:"name"`
-				Weight uint64 `json:"weight"`
-			}(req.Items),
+		cmd, err := req.command()
+		if err == nil {
+			err = bus.DispatchBlocking(commandBus, mutex)(r.Context(), cmd)
 		}
 
-		err := bus.Dispatch(commandBus)(r.Context(), cmd)
-
-		switch {
-		case err == nil:
-			middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
-		case errors.Is(err, cargoinfra.ErrVesselNotFound):
-			res, statusCode := jsonapiresponse.NewNotFound("cargo vessel not found"), http.StatusNotFound
-			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
-		case cargodomain.IsCargoAlreadyExistsError(err):
-			res, statusCode := jsonapiresponse.NewBadRequest("cargo already exists"), http.StatusConflict
-			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
-		case errors.Is(err, cargodomain.ErrInvalidVesselIDProvided):
-			res, statusCode := jsonapiresponse.NewBadRequest("invalid vessel ID provided"), http.StatusBadRequest
-			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
-		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
-			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
-			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
-		case errors.Is(err, cargodomain.ErrInvalidItemsProvided):
-			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo items provided"), http.StatusBadRequest
-			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
-		default:
-			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+		if err != nil {
+			res, statusCode := newCreateCargoErrorResponse(err)
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
 		}
+
+		middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+	}
+}
+
+// newCreateCargoErrorResponse maps a cargo creation failure to its error response.
+func newCreateCargoErrorResponse(err error) ([]*jsonapi.ErrorObject, int) {
+	switch {
+	case errors.Is(err, cargoinfra.ErrVesselNotFound):
+		return jsonapiresponse.NewNotFound("cargo vessel not found"), http.StatusNotFound
+	case cargodomain.IsCargoAlreadyExistsError(err):
+		return jsonapiresponse.NewBadRequest("cargo already exists"), http.StatusConflict
+	case cargodomain.IsCargoExceedsVesselCapacityError(err):
+		return jsonapiresponse.NewConflict("cargo exceeds vessel capacity"), http.StatusConflict
+	case errors.Is(err, cargodomain.ErrInvalidVesselIDProvided):
+		return jsonapiresponse.NewBadRequest("invalid vessel ID provided"), http.StatusBadRequest
+	case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+		return jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+	case errors.Is(err, cargodomain.ErrInvalidItemsProvided):
+		return jsonapiresponse.NewBadRequest("invalid cargo items provided"), http.StatusBadRequest
+	case errors.Is(err, domainweight.ErrInvalidWeightProvided):
+		return jsonapiresponse.NewBadRequest("invalid cargo item weight provided"), http.StatusBadRequest
+	case errors.Is(err, cargodomain.ErrInvalidShipperProvided):
+		return jsonapiresponse.NewBadRequest("invalid cargo shipper provided"), http.StatusBadRequest
+	case errors.Is(err, cargodomain.ErrInvalidConsigneeProvided):
+		return jsonapiresponse.NewBadRequest("invalid cargo consignee provided"), http.StatusBadRequest
+	case errors.Is(err, cargodomain.ErrInvalidMetadataProvided):
+		return jsonapiresponse.NewBadRequest("invalid cargo metadata provided"), http.StatusBadRequest
+	default:
+		return jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
 	}
 }
@@ -0,0 +1,151 @@
+package cargoentrypoint
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/google/jsonapi"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargoinfra "github.com/soulcodex/deus-cargo-tracker/internal/cargo/infrastructure"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const (
+	atomicQueryParam = "atomic"
+	// maxCargoesPerBatch bounds the cargoes received at once, enough for a whole vessel manifest.
+	maxCargoesPerBatch = 500
+)
+
+const (
+	batchResultStatusCreated = "created"
+	batchResultStatusFailed  = "failed"
+	// batchResultStatusSkipped flags the valid cargoes left out because their atomic batch was rejected.
+	batchResultStatusSkipped = "skipped"
+)
+
+type CreateCargoesBatchResult struct {
+	ID       string               `jsonapi:"primary,cargo_batch_result"`
+	Position int                  `jsonapi:"attr,position"`
+	Status   string               `jsonapi:"attr,status"`
+	Error    *jsonapi.ErrorObject `jsonapi:"attr,error,omitempty"`
+}
+
+// newCreateCargoesBatchResults reports every cargo as created unless it failed, the ones
+// which didn't fail are skipped when any other does on an atomic batch.
+func newCreateCargoesBatchResults(
+	requests []*CreateCargoRequest,
+	failures map[int]error,
+	atomic bool,
+) []*CreateCargoesBatchResult {
+	results := make([]*CreateCargoesBatchResult, len(requests))
+	for i, req := range requests {
+		results[i] = &CreateCargoesBatchResult{ID: req.ID, Position: i, Status: batchResultStatusCreated}
+
+		err, failed := failures[i]
+		switch {
+		case failed:
+			res, _ := newCreateCargoErrorResponse(err)
+			results[i].Status, results[i].Error = batchResultStatusFailed, res[0]
+		case atomic && len(failures) > 0:
+			results[i].Status = batchResultStatusSkipped
+		}
+	}
+
+	return results
+}
+
+// decodeCreateCargoesBatch returns the received cargoes along with their commands and the
+// cargoes which can't be created, indexed by their position on the batch.
+func decodeCreateCargoesBatch(
+	body io.Reader,
+) ([]*CreateCargoRequest, []*cargocommands.CreateCargoCommand, map[int]error, error) {
+	decoded, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(CreateCargoRequest)))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	requests, failures := make([]*CreateCargoRequest, len(decoded)), make(map[int]error)
+	commands := make([]*cargocommands.CreateCargoCommand, len(decoded))
+	for i, item := range decoded {
+		req, ok := item.(*CreateCargoRequest)
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("unexpected cargo request type %T", item)
+		}
+
+		requests[i] = req
+		if commands[i], err = req.command(); err != nil {
+			failures[i] = err
+		}
+	}
+
+	return requests, commands, failures, nil
+}
+
+func HandlePOSTCreateCargoesBatchV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests, commands, failures, err := decodeCreateCargoesBatch(r.Body)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		if len(requests) == 0 || len(requests) > maxCargoesPerBatch {
+			detail := fmt.Sprintf("cargoes batch must contain between 1 and %d cargoes", maxCargoesPerBatch)
+			res, statusCode := jsonapiresponse.NewBadRequest(detail), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, nil)
+			return
+		}
+
+		// Vessels are checked once per batch no matter how many cargoes they load.
+		ctx := cargoinfra.WithVesselCheckCache(r.Context())
+
+		if !httpserver.FetchBoolQueryParamValue(r.URL.Query(), atomicQueryParam, false) {
+			for i, cmd := range commands {
+				if _, found := failures[i]; found {
+					continue
+				}
+
+				if dispatchErr := bus.DispatchBlocking(commandBus, mutex)(ctx, cmd); dispatchErr != nil {
+					failures[i] = dispatchErr
+				}
+			}
+
+			results := newCreateCargoesBatchResults(requests, failures, false)
+			middleware.WriteCollectionResponse(r.Context(), w, results, nil, http.StatusOK)
+			return
+		}
+
+		if len(failures) > 0 {
+			results := newCreateCargoesBatchResults(requests, failures, true)
+			middleware.WriteCollectionResponse(r.Context(), w, results, nil, http.StatusUnprocessableEntity)
+			return
+		}
+
+		err = bus.DispatchMultiBlocking(commandBus, mutex)(ctx, &cargocommands.CreateCargoesBatchCommand{Cargoes: commands})
+
+		rejected, isRejected := cargodomain.AsCargoBatchRejectedError(err)
+		switch {
+		case err == nil:
+			middleware.WriteCollectionResponse(r.Context(), w, newCreateCargoesBatchResults(requests, nil, true), nil, http.StatusOK)
+		case isRejected:
+			results := newCreateCargoesBatchResults(requests, rejected.Failures(), true)
+			middleware.WriteCollectionResponse(r.Context(), w, results, nil, http.StatusUnprocessableEntity)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+func HandleDELETEDeleteCargoV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cargoID := mux.Vars(r)["cargo_id"]
+		if cargoID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("cargo ID is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, cargodomain.ErrInvalidCargoIDProvided)
+			return
+		}
+
+		expectedVersion, ifMatchErr := httpserver.FetchIfMatchVersion(r)
+		if ifMatchErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid If-Match header provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, ifMatchErr)
+			return
+		}
+
+		cmd := &cargocommands.DeleteCargoCommand{ID: cargoID, ExpectedVersion: expectedVersion}
+
+		err := bus.DispatchBlocking(commandBus, mutex)(r.Context(), cmd)
+
+		switch {
+		case err == nil:
+			middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case cargodomain.IsCargoNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoVersionMismatch):
+			res, statusCode := jsonapiresponse.NewPreconditionFailed(
+				"cargo version doesn't match the If-Match header",
+			), http.StatusPreconditionFailed
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoNotModifiableError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo can't be deleted on its current status"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoVersionConflictError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo was modified concurrently"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
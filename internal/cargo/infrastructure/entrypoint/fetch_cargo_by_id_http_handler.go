@@ -11,76 +11,162 @@ import (
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
 	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
 	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
 	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
 )
 
+// CargoTracking json tags are used when it's embedded as an attribute value.
 type CargoTracking struct {
-	ID           string    `jsonapi:"primary,tracking"`
-	EntryType    string    `jsonapi:"attr,entry_type"`
-	StatusBefore *string   `jsonapi:"attr,status_before"`
-	StatusAfter  *string   `jsonapi:"attr,status_after"`
-	CreatedAt    time.Time `jsonapi:"attr,created_at,rfc3339"`
+	ID              string         `jsonapi:"primary,tracking" json:"id"`
+	EntryType       string         `jsonapi:"attr,entry_type" json:"entry_type"`
+	StatusBefore    *string        `jsonapi:"attr,status_before" json:"status_before"`
+	StatusAfter     *string        `jsonapi:"attr,status_after" json:"status_after"`
+	Details         map[string]any `jsonapi:"attr,details,omitempty" json:"details,omitempty"`
+	VesselLatitude  *float64       `jsonapi:"attr,vessel_latitude,omitempty" json:"vessel_latitude,omitempty"`
+	VesselLongitude *float64       `jsonapi:"attr,vessel_longitude,omitempty" json:"vessel_longitude,omitempty"`
+	CreatedAt       time.Time      `jsonapi:"attr,created_at,rfc3339" json:"created_at"`
 }
 
-func newCargoTracking(resp cargoqueries.CargoResponse) []*CargoTracking {
-	trackingItems := make([]*CargoTracking, 0, len(resp.Tracking))
-	for _, t := range resp.Tracking {
-		trackingItems = append(trackingItems, &CargoTracking{
-			ID:           t.ID,
-			EntryType:    t.EntryType,
-			StatusBefore: t.StatusBefore,
-			StatusAfter:  t.StatusAfter,
-			CreatedAt:    t.CreatedAt,
-		})
+func newCargoTrackingItem(t cargoqueries.CargoTrackingResponseItem) *CargoTracking {
+	return &CargoTracking{
+		ID:              t.ID,
+		EntryType:       t.EntryType,
+		StatusBefore:    t.StatusBefore,
+		StatusAfter:     t.StatusAfter,
+		Details:         t.Details,
+		VesselLatitude:  t.VesselLatitude,
+		VesselLongitude: t.VesselLongitude,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+func newCargoTracking(tracking []cargoqueries.CargoTrackingResponseItem) []*CargoTracking {
+	trackingItems := make([]*CargoTracking, 0, len(tracking))
+	for _, t := range tracking {
+		trackingItems = append(trackingItems, newCargoTrackingItem(t))
 	}
 
 	return trackingItems
 }
 
+type CargoStateAsOf struct {
+	At       time.Time      `json:"at"`
+	Status   string         `json:"status"`
+	Tracking *CargoTracking `json:"tracking"`
+}
+
+func newCargoStateAsOf(resp *cargoqueries.CargoStateAsOfResponse) *CargoStateAsOf {
+	if resp == nil {
+		return nil
+	}
+
+	return &CargoStateAsOf{At: resp.At, Status: resp.Status, Tracking: newCargoTrackingItem(resp.Tracking)}
+}
+
 type FetchCargoByIDResponse struct {
 	ID       string `jsonapi:"primary,cargo"`
 	VesselID string `jsonapi:"attr,vessel_id"`
 	Items    []struct {
-		Name   string `json:"name"`
-		Weight uint64 `json:"weight"`
+		Name        string  `json:"name"`
+		Weight      float64 `json:"weight"`
+		UNNumber    string  `json:"un_number,omitempty"`
+		HazardClass string  `json:"hazard_class,omitempty"`
+		Length      uint64  `json:"length,omitempty"`
+		Width       uint64  `json:"width,omitempty"`
+		Height      uint64  `json:"height,omitempty"`
 	} `jsonapi:"attr,items"`
-	Tracking  []*CargoTracking `jsonapi:"relation,tracking,omitempty"`
-	Status    string           `jsonapi:"attr,status"`
-	Weight    uint64           `jsonapi:"attr,weight"`
-	CreatedAt time.Time        `jsonapi:"attr,created_at,rfc3339"`
-	UpdatedAt time.Time        `jsonapi:"attr,updated_at,rfc3339"`
+	Shipper          *CargoParty       `jsonapi:"attr,shipper,omitempty"`
+	Consignee        *CargoParty       `jsonapi:"attr,consignee,omitempty"`
+	Metadata         map[string]string `jsonapi:"attr,metadata,omitempty"`
+	Tracking         []*CargoTracking  `jsonapi:"relation,tracking,omitempty"`
+	Status           string            `jsonapi:"attr,status"`
+	AsOf             *CargoStateAsOf   `jsonapi:"attr,as_of,omitempty"`
+	Weight           float64           `jsonapi:"attr,weight"`
+	WeightUnit       string            `jsonapi:"attr,weight_unit"`
+	Volume           uint64            `jsonapi:"attr,volume"`
+	ChargeableWeight float64           `jsonapi:"attr,chargeable_weight"`
+	CreatedAt        time.Time         `jsonapi:"attr,created_at,rfc3339"`
+	UpdatedAt        time.Time         `jsonapi:"attr,updated_at,rfc3339"`
+}
+
+type CargoParty struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Address string `json:"address"`
+}
+
+func newCargoParty(resp *cargoqueries.CargoPartyResponse) *CargoParty {
+	if resp == nil {
+		return nil
+	}
+
+	return &CargoParty{Name: resp.Name, Email: resp.Email, Address: resp.Address}
 }
 
-func newFetchCargoByIDResponse(resp cargoqueries.CargoResponse) *FetchCargoByIDResponse {
+// newCargoMetadata leaves the metadata out of the response when the cargo has none.
+func newCargoMetadata(metadata map[string]string) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	return metadata
+}
+
+// newFetchCargoByIDResponse expresses every weight in the given unit, they're handled in grams internally.
+func newFetchCargoByIDResponse(resp cargoqueries.CargoResponse, unit domainweight.Unit) *FetchCargoByIDResponse {
 	items := make([]struct {
-		Name   string `json:"name"`
-		Weight uint64 `json:"weight"`
+		Name        string  `json:"name"`
+		Weight      float64 `json:"weight"`
+		UNNumber    string  `json:"un_number,omitempty"`
+		HazardClass string  `json:"hazard_class,omitempty"`
+		Length      uint64  `json:"length,omitempty"`
+		Width       uint64  `json:"width,omitempty"`
+		Height      uint64  `json:"height,omitempty"`
 	}, len(resp.Items))
 	for i, item := range resp.Items {
 		items[i] = struct {
-			Name   string `json:"name"`
-			Weight uint64 `json:"weight"`
+			Name        string  `json:"name"`
+			Weight      float64 `json:"weight"`
+			UNNumber    string  `json:"un_number,omitempty"`
+			HazardClass string  `json:"hazard_class,omitempty"`
+			Length      uint64  `json:"length,omitempty"`
+			Width       uint64  `json:"width,omitempty"`
+			Height      uint64  `json:"height,omitempty"`
 		}{
-			Name:   item.Name,
-			Weight: item.Weight,
+			Name:        item.Name,
+			Weight:      domainweight.FromGrams(item.Weight).In(unit),
+			UNNumber:    item.UNNumber,
+			HazardClass: item.HazardClass,
+			Length:      item.Length,
+			Width:       item.Width,
+			Height:      item.Height,
 		}
 	}
 
 	return &FetchCargoByIDResponse{
-		ID:        resp.ID,
-		VesselID:  resp.VesselID,
-		Items:     items,
-		Tracking:  newCargoTracking(resp),
-		Status:    resp.Status,
-		Weight:    resp.Weight,
-		CreatedAt: resp.CreatedAt,
-		UpdatedAt: resp.UpdatedAt,
+		ID:               resp.ID,
+		VesselID:         resp.VesselID,
+		Items:            items,
+		Shipper:          newCargoParty(resp.Shipper),
+		Consignee:        newCargoParty(resp.Consignee),
+		Metadata:         newCargoMetadata(resp.Metadata),
+		Tracking:         newCargoTracking(resp.Tracking),
+		Status:           resp.Status,
+		AsOf:             newCargoStateAsOf(resp.AsOf),
+		Weight:           domainweight.FromGrams(resp.Weight).In(unit),
+		WeightUnit:       unit.String(),
+		Volume:           resp.Volume,
+		ChargeableWeight: domainweight.FromGrams(resp.ChargeableWeight).In(unit),
+		CreatedAt:        resp.CreatedAt,
+		UpdatedAt:        resp.UpdatedAt,
 	}
 }
 
 const (
 	trackingQueryParam = "tracking"
+	unitsQueryParam    = "units"
+	asOfQueryParam     = "as_of"
 )
 
 func HandleGETFetchCargoByIDV1HTTP(
@@ -99,7 +185,22 @@ func HandleGETFetchCargoByIDV1HTTP(
 		// but for simplicity I'm going to use a custom query param.
 		tracking := httpserver.FetchBoolQueryParamValue(r.URL.Query(), trackingQueryParam, false)
 
-		query := &cargoqueries.FetchCargoByID{ID: cargoID, Tracking: tracking}
+		rawUnit := httpserver.FetchStringQueryParamValue(r.URL.Query(), unitsQueryParam, "")
+		unit, unitErr := domainweight.NewUnitOrDefault(rawUnit, domainweight.UnitGram)
+		if unitErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid units provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, unitErr)
+			return
+		}
+
+		asOf, asOfErr := httpserver.FetchTimeQueryParamValue(r.URL.Query(), asOfQueryParam)
+		if asOfErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid as of provided, RFC3339 expected"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, asOfErr)
+			return
+		}
+
+		query := &cargoqueries.FetchCargoByID{ID: cargoID, Tracking: tracking, AsOf: asOf}
 
 		result, err := bus.DispatchWithResponse[*cargoqueries.FetchCargoByID, cargoqueries.CargoResponse](queryBus)(
 			r.Context(),
@@ -108,13 +209,17 @@ func HandleGETFetchCargoByIDV1HTTP(
 
 		switch {
 		case err == nil:
-			middleware.WriteResponse(r.Context(), w, newFetchCargoByIDResponse(result), http.StatusOK)
+			w.Header().Set(httpserver.HeaderETag, httpserver.VersionETag(result.Version))
+			middleware.WriteResponse(r.Context(), w, newFetchCargoByIDResponse(result, unit), http.StatusOK)
 		case cargodomain.IsCargoNotExistsError(err):
 			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
 		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
 			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoStateUnknownAsOf):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo state unknown at the given instant"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
 		default:
 			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
@@ -0,0 +1,51 @@
+package cargoentrypoint
+
+import (
+	"net/http"
+
+	cargoqueries "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/queries"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type FetchCargoStatusResponse struct {
+	ID          string   `jsonapi:"primary,cargo-status"`
+	Transitions []string `jsonapi:"attr,transitions"`
+	Initial     bool     `jsonapi:"attr,initial"`
+	Final       bool     `jsonapi:"attr,final"`
+}
+
+func newFetchCargoStatusesResponse(resp cargoqueries.CargoStatusesResponse) []*FetchCargoStatusResponse {
+	statuses := make([]*FetchCargoStatusResponse, len(resp.Items))
+	for i, item := range resp.Items {
+		statuses[i] = &FetchCargoStatusResponse{
+			ID:          item.Status,
+			Transitions: item.Transitions,
+			Initial:     item.Initial,
+			Final:       item.Final,
+		}
+	}
+
+	return statuses
+}
+
+func HandleGETFetchCargoStatusesV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result, err := bus.DispatchWithResponse[*cargoqueries.FetchCargoStatuses, cargoqueries.CargoStatusesResponse](queryBus)(
+			r.Context(),
+			&cargoqueries.FetchCargoStatuses{},
+		)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		middleware.WriteResponse(r.Context(), w, newFetchCargoStatusesResponse(result), http.StatusOK)
+	}
+}
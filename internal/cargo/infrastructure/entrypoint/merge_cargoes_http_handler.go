@@ -0,0 +1,77 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/jsonapi"
+	"github.com/gorilla/mux"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type MergeCargoesRequest struct {
+	SourceCargoIDs []string `jsonapi:"attr,source_cargo_ids"`
+}
+
+func HandlePOSTMergeCargoesV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cargoID := mux.Vars(r)["cargo_id"]
+		if cargoID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("cargo ID is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, cargodomain.ErrInvalidCargoIDProvided)
+			return
+		}
+
+		var req MergeCargoesRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		cmd := &cargocommands.MergeCargoesCommand{ID: cargoID, SourceIDs: req.SourceCargoIDs}
+
+		err := bus.DispatchMultiBlocking(commandBus, mutex)(r.Context(), cmd)
+
+		switch {
+		case err == nil:
+			middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case cargodomain.IsCargoNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidItemsProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid merged cargo items"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoMergeNotAllowed):
+			res, statusCode := jsonapiresponse.NewConflict(
+				"merge is only allowed between distinct pending cargoes on the same vessel",
+			), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoMergePartiesMismatch):
+			res, statusCode := jsonapiresponse.NewConflict(
+				"merge is only allowed between cargoes with the same shipper and consignee",
+			), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoVersionConflictError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo was modified concurrently"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	cargoqueries "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/queries"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const entryTypeFilterQueryParam = "filter[entry_type]"
+
+func HandleGETSearchCargoTrackingV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := newSearchCargoTrackingQuery(r)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest(err.Error()), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		result, err := bus.DispatchWithResponse[*cargoqueries.SearchCargoTracking, cargoqueries.CargoTrackingResponse](queryBus)(
+			r.Context(),
+			query,
+		)
+
+		switch {
+		case err == nil:
+			links := httpserver.NewCursorPaginationLinks(r, pageCursorQueryParam, result.NextCursor)
+			middleware.WriteCollectionResponse(r.Context(), w, newCargoTracking(result.Items), links, http.StatusOK)
+		case cargodomain.IsCargoNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargotrackingdomain.ErrInvalidTrackingSearchCriteria):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid tracking search criteria provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
+
+func newSearchCargoTrackingQuery(r *http.Request) (*cargoqueries.SearchCargoTracking, error) {
+	values := r.URL.Query()
+
+	createdAtFrom, err := httpserver.FetchTimeQueryParamValue(values, createdAtFromFilterQueryParam)
+	if err != nil {
+		return nil, errors.New("invalid created at from filter provided, RFC3339 expected")
+	}
+
+	createdAtTo, err := httpserver.FetchTimeQueryParamValue(values, createdAtToFilterQueryParam)
+	if err != nil {
+		return nil, errors.New("invalid created at to filter provided, RFC3339 expected")
+	}
+
+	pageSize, err := httpserver.FetchUintQueryParamValue(values, pageSizeQueryParam, cargotrackingdomain.DefaultTrackingSearchPageSize)
+	if err != nil {
+		return nil, errors.New("invalid page size provided")
+	}
+
+	return &cargoqueries.SearchCargoTracking{
+		CargoID:       mux.Vars(r)["cargo_id"],
+		EntryTypes:    httpserver.FetchCSVQueryParamValue(values, entryTypeFilterQueryParam),
+		CreatedAtFrom: createdAtFrom,
+		CreatedAtTo:   createdAtTo,
+		Cursor:        httpserver.FetchStringQueryParamValue(values, pageCursorQueryParam, ""),
+		PageSize:      pageSize,
+	}, nil
+}
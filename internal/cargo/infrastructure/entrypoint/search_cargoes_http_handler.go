@@ -0,0 +1,128 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	cargoqueries "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/queries"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const (
+	statusFilterQueryParam        = "filter[status]"
+	vesselIDFilterQueryParam      = "filter[vessel_id]"
+	shipperFilterQueryParam       = "filter[shipper]"
+	metadataFilterQueryParamStart = "filter[metadata."
+	metadataFilterQueryParamEnd   = "]"
+	createdAtFromFilterQueryParam = "filter[created_at][gte]"
+	createdAtToFilterQueryParam   = "filter[created_at][lte]"
+	sortQueryParam                = "sort"
+	pageCursorQueryParam          = "page[cursor]"
+	pageSizeQueryParam            = "page[size]"
+)
+
+func newSearchCargoesResponse(resp cargoqueries.CargoesResponse, unit domainweight.Unit) []*FetchCargoByIDResponse {
+	cargoes := make([]*FetchCargoByIDResponse, len(resp.Items))
+	for i, item := range resp.Items {
+		cargoes[i] = newFetchCargoByIDResponse(item, unit)
+	}
+
+	return cargoes
+}
+
+func HandleGETSearchCargoesV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := newSearchCargoesQuery(r)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest(err.Error()), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		rawUnit := httpserver.FetchStringQueryParamValue(r.URL.Query(), unitsQueryParam, "")
+		unit, unitErr := domainweight.NewUnitOrDefault(rawUnit, domainweight.UnitGram)
+		if unitErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid units provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, unitErr)
+			return
+		}
+
+		result, err := bus.DispatchWithResponse[*cargoqueries.SearchCargoes, cargoqueries.CargoesResponse](queryBus)(
+			r.Context(),
+			query,
+		)
+
+		switch {
+		case err == nil:
+			links := httpserver.NewCursorPaginationLinks(r, pageCursorQueryParam, result.NextCursor)
+			middleware.WriteCollectionResponse(r.Context(), w, newSearchCargoesResponse(result, unit), links, http.StatusOK)
+		case errors.Is(err, cargodomain.ErrInvalidCargoSearchCriteria):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo search criteria provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
+
+func newSearchCargoesQuery(r *http.Request) (*cargoqueries.SearchCargoes, error) {
+	values := r.URL.Query()
+
+	createdAtFrom, err := httpserver.FetchTimeQueryParamValue(values, createdAtFromFilterQueryParam)
+	if err != nil {
+		return nil, errors.New("invalid created at from filter provided, RFC3339 expected")
+	}
+
+	createdAtTo, err := httpserver.FetchTimeQueryParamValue(values, createdAtToFilterQueryParam)
+	if err != nil {
+		return nil, errors.New("invalid created at to filter provided, RFC3339 expected")
+	}
+
+	pageSize, err := httpserver.FetchUintQueryParamValue(values, pageSizeQueryParam, cargodomain.DefaultCargoSearchPageSize)
+	if err != nil {
+		return nil, errors.New("invalid page size provided")
+	}
+
+	return &cargoqueries.SearchCargoes{
+		Statuses:      httpserver.FetchCSVQueryParamValue(values, statusFilterQueryParam),
+		VesselID:      httpserver.FetchStringQueryParamValue(values, vesselIDFilterQueryParam, ""),
+		Shipper:       httpserver.FetchStringQueryParamValue(values, shipperFilterQueryParam, ""),
+		Metadata:      fetchMetadataFilters(values),
+		CreatedAtFrom: createdAtFrom,
+		CreatedAtTo:   createdAtTo,
+		Sort:          httpserver.FetchStringQueryParamValue(values, sortQueryParam, ""),
+		Cursor:        httpserver.FetchStringQueryParamValue(values, pageCursorQueryParam, ""),
+		PageSize:      pageSize,
+	}, nil
+}
+
+// fetchMetadataFilters collects every filter[metadata.<key>] query param by its metadata key.
+func fetchMetadataFilters(values url.Values) map[string]string {
+	filters := make(map[string]string)
+	for param := range values {
+		key, isMetadataFilter := strings.CutPrefix(param, metadataFilterQueryParamStart)
+		if !isMetadataFilter {
+			continue
+		}
+
+		key, isMetadataFilter = strings.CutSuffix(key, metadataFilterQueryParamEnd)
+		if !isMetadataFilter {
+			continue
+		}
+
+		filters[key] = values.Get(param)
+	}
+
+	return filters
+}
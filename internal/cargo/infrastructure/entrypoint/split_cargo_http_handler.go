@@ -0,0 +1,97 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/jsonapi"
+	"github.com/gorilla/mux"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargoinfra "github.com/soulcodex/deus-cargo-tracker/internal/cargo/infrastructure"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type SplitCargoRequest struct {
+	SplitCargoID string   `jsonapi:"attr,split_cargo_id"`
+	Items        []string `jsonapi:"attr,items"`
+}
+
+func HandlePOSTSplitCargoV1HTTP(
+	queryBus querybus.Bus,
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cargoID := mux.Vars(r)["cargo_id"]
+		if cargoID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("cargo ID is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, cargodomain.ErrInvalidCargoIDProvided)
+			return
+		}
+
+		var req SplitCargoRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		vesselID, err := fetchCargoVesselID(r.Context(), queryBus, cargoID)
+
+		cmd := &cargocommands.SplitCargoCommand{
+			ID:        cargoID,
+			SplitID:   req.SplitCargoID,
+			VesselID:  vesselID,
+			ItemNames: req.Items,
+		}
+
+		if err == nil {
+			err = bus.DispatchMultiBlocking(commandBus, mutex)(r.Context(), cmd)
+		}
+
+		switch {
+		case err == nil:
+			middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case cargodomain.IsCargoNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoItemNotFound):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo item not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargoinfra.ErrVesselNotFound):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo vessel not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidItemsProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo items provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoAlreadyExistsError(err):
+			res, statusCode := jsonapiresponse.NewConflict("split cargo already exists"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoSplitNotAllowed):
+			res, statusCode := jsonapiresponse.NewConflict("split is only allowed on pending cargoes"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoVesselChanged):
+			res, statusCode := jsonapiresponse.NewConflict(
+				"cargo was moved to another vessel meanwhile, retry the request",
+			), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoVersionConflictError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo was modified concurrently"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/jsonapi"
+	"github.com/gorilla/mux"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargoinfra "github.com/soulcodex/deus-cargo-tracker/internal/cargo/infrastructure"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type UpdateCargoItemsRequest struct {
+	Operation string            `jsonapi:"attr,operation"`
+	Items     CargoItemsRequest `jsonapi:"attr,items"`
+}
+
+// command normalises the items weights to grams, as the same weight shapes as on creation are accepted.
+func (req *UpdateCargoItemsRequest) command(
+	cargoID string,
+	vesselID string,
+	expectedVersion *uint64,
+) (*cargocommands.UpdateCargoItemsCommand, error) {
+	items, err := req.Items.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &cargocommands.UpdateCargoItemsCommand{
+		ID:        cargoID,
+		VesselID:  vesselID,
+		Operation: req.Operation,
+		Items: make([]struct {
+			Name        string `json:"name"`
+			Weight      uint64 `json:"weight"`
+			UNNumber    string `json:"un_number"`
+			HazardClass string `json:"hazard_class"`
+			Length      uint64 `json:"length"`
+			Width       uint64 `json:"width"`
+			Height      uint64 `json:"height"`
+		}, len(items)),
+		ExpectedVersion: expectedVersion,
+	}
+	for i, item := range items {
+		grams, weightErr := item.Weight.grams()
+		if weightErr != nil {
+			return nil, weightErr
+		}
+
+		cmd.Items[i].Name, cmd.Items[i].Weight = item.Name, grams
+		cmd.Items[i].UNNumber, cmd.Items[i].HazardClass = item.UNNumber, item.HazardClass
+		cmd.Items[i].Length, cmd.Items[i].Width, cmd.Items[i].Height = item.Length, item.Width, item.Height
+	}
+
+	return cmd, nil
+}
+
+func HandlePATCHUpdateCargoItemsV1HTTP(
+	queryBus querybus.Bus,
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cargoID := mux.Vars(r)["cargo_id"]
+		if cargoID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("cargo ID is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, cargodomain.ErrInvalidCargoIDProvided)
+			return
+		}
+
+		expectedVersion, ifMatchErr := httpserver.FetchIfMatchVersion(r)
+		if ifMatchErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid If-Match header provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, ifMatchErr)
+			return
+		}
+
+		var req UpdateCargoItemsRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		vesselID, err := fetchCargoVesselID(r.Context(), queryBus, cargoID)
+
+		var cmd *cargocommands.UpdateCargoItemsCommand
+		if err == nil {
+			cmd, err = req.command(cargoID, vesselID, expectedVersion)
+		}
+
+		if err == nil {
+			err = bus.DispatchMultiBlocking(commandBus, mutex)(r.Context(), cmd)
+		}
+
+		switch {
+		case err == nil:
+			middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case cargodomain.IsCargoNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoItemNotFound):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo item not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargoinfra.ErrVesselNotFound):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo vessel not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidItemsOperationProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo items operation provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidItemsProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo items provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, domainweight.ErrInvalidWeightProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo item weight provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrItemsChangeNotAllowed):
+			res, statusCode := jsonapiresponse.NewConflict("items change is only allowed on pending cargoes"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoExceedsVesselCapacityError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo exceeds vessel capacity"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoVersionMismatch):
+			res, statusCode := jsonapiresponse.NewPreconditionFailed(
+				"cargo version doesn't match the If-Match header",
+			), http.StatusPreconditionFailed
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoVesselChanged):
+			res, statusCode := jsonapiresponse.NewConflict(
+				"cargo was moved to another vessel meanwhile, retry the request",
+			), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoVersionConflictError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo was modified concurrently"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
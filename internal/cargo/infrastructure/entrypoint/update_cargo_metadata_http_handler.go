@@ -0,0 +1,110 @@
+package cargoentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/jsonapi"
+	"github.com/gorilla/mux"
+
+	cargocommands "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/commands"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+// UpdateCargoMetadataRequest follows the JSON merge patch semantics, keys set to null are removed
+// and the ones not sent are kept.
+type UpdateCargoMetadataRequest struct {
+	Metadata map[string]any `jsonapi:"attr,metadata"`
+}
+
+func (req *UpdateCargoMetadataRequest) command(cargoID string) (*cargocommands.UpdateCargoMetadataCommand, error) {
+	if len(req.Metadata) == 0 {
+		return nil, cargodomain.ErrInvalidMetadataProvided
+	}
+
+	metadata := make(map[string]*string, len(req.Metadata))
+	for key, value := range req.Metadata {
+		if value == nil {
+			metadata[key] = nil
+			continue
+		}
+
+		str, isString := value.(string)
+		if !isString {
+			return nil, cargodomain.ErrInvalidMetadataProvided
+		}
+
+		metadata[key] = &str
+	}
+
+	return &cargocommands.UpdateCargoMetadataCommand{ID: cargoID, Metadata: metadata}, nil
+}
+
+func HandlePATCHUpdateCargoMetadataV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cargoID := mux.Vars(r)["cargo_id"]
+		if cargoID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("cargo ID is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, cargodomain.ErrInvalidCargoIDProvided)
+			return
+		}
+
+		expectedVersion, ifMatchErr := httpserver.FetchIfMatchVersion(r)
+		if ifMatchErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid If-Match header provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, ifMatchErr)
+			return
+		}
+
+		var req UpdateCargoMetadataRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		cmd, err := req.command(cargoID)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo metadata provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+		cmd.ExpectedVersion = expectedVersion
+
+		err = bus.DispatchBlocking(commandBus, mutex)(r.Context(), cmd)
+
+		switch {
+		case err == nil:
+			middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case cargodomain.IsCargoNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("cargo not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidCargoIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo ID provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrInvalidMetadataProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo metadata provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoVersionMismatch):
+			res, statusCode := jsonapiresponse.NewPreconditionFailed(
+				"cargo version doesn't match the If-Match header",
+			), http.StatusPreconditionFailed
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoVersionConflictError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo was modified concurrently"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
@@ -33,6 +33,13 @@ func HandlePATCHUpdateCargoStatusV1HTTP(
 			return
 		}
 
+		expectedVersion, ifMatchErr := httpserver.FetchIfMatchVersion(r)
+		if ifMatchErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid If-Match header provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, ifMatchErr)
+			return
+		}
+
 		var req UpdateCargoStatusRequest
 		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
 			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
@@ -41,8 +48,9 @@ func HandlePATCHUpdateCargoStatusV1HTTP(
 		}
 
 		cmd := &cargocommands.UpdateCargoStatusCommand{
-			ID:        cargoID,
-			NewStatus: req.NewStatus,
+			ID:              cargoID,
+			NewStatus:       req.NewStatus,
+			ExpectedVersion: expectedVersion,
 		}
 
 		err := bus.DispatchBlocking(commandBus, mutex)(r.Context(), cmd)
@@ -56,9 +64,20 @@ func HandlePATCHUpdateCargoStatusV1HTTP(
 		case errors.Is(err, cargodomain.ErrInvalidStatusProvided):
 			res, statusCode := jsonapiresponse.NewBadRequest("invalid cargo status provided"), http.StatusBadRequest
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCancellationReasonRequired):
+			res, statusCode := jsonapiresponse.NewBadRequest("cargo cancellation requires a reason code"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
 		case errors.Is(err, cargodomain.ErrStatusTransitionNotAllowed):
 			res, statusCode := jsonapiresponse.NewBadRequest("status transition not allowed"), http.StatusConflict
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, cargodomain.ErrCargoVersionMismatch):
+			res, statusCode := jsonapiresponse.NewPreconditionFailed(
+				"cargo version doesn't match the If-Match header",
+			), http.StatusPreconditionFailed
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case cargodomain.IsCargoVersionConflictError(err):
+			res, statusCode := jsonapiresponse.NewConflict("cargo was modified concurrently"), http.StatusConflict
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
 		default:
 			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
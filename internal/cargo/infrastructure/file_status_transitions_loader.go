@@ -0,0 +1,44 @@
+package cargoinfra
+
+import (
+	"encoding/json"
+	"os"
+
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrLoadingStatusTransitions = errutil.NewError("error loading cargo status transitions")
+)
+
+// LoadStatusTransitionsFromFile reads the cargo status state machine from a JSON file
+// shaped as {"pending": ["loaded", "in_transit"], ...}. When no path is provided the
+// built-in transitions are returned, both are validated before being returned.
+func LoadStatusTransitionsFromFile(path string) (cargodomain.StatusTransitions, error) {
+	if path == "" {
+		transitions := cargodomain.DefaultStatusTransitions()
+		if err := transitions.Validate(); err != nil {
+			return nil, ErrLoadingStatusTransitions.Wrap(err)
+		}
+
+		return transitions, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ErrLoadingStatusTransitions.Wrap(err)
+	}
+
+	var raw map[string][]string
+	if unmarshalErr := json.Unmarshal(content, &raw); unmarshalErr != nil {
+		return nil, ErrLoadingStatusTransitions.Wrap(unmarshalErr)
+	}
+
+	transitions, err := cargodomain.NewStatusTransitions(raw)
+	if err != nil {
+		return nil, ErrLoadingStatusTransitions.Wrap(err)
+	}
+
+	return transitions, nil
+}
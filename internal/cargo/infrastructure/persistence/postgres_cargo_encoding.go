@@ -22,15 +22,19 @@ func newPostgresCargoDecoder(tracking cargotrackingdomain.Tracking) postgres.Dec
 			id           string
 			vesselID     string
 			items        sql.RawBytes
+			rawShipper   []byte
+			rawConsignee []byte
+			rawMetadata  []byte
 			status       string
 			createdAt    time.Time
 			updatedAt    time.Time
 			rawDeletedAt sql.NullTime
+			version      uint64
 		)
 
 		err := rows.Scan(
-			&id, &vesselID, &items, &status,
-			&createdAt, &updatedAt, &rawDeletedAt,
+			&id, &vesselID, &items, &rawShipper, &rawConsignee, &rawMetadata, &status,
+			&createdAt, &updatedAt, &rawDeletedAt, &version,
 		)
 		if err != nil {
 			return nil, ErrScanningCargoRow.Wrap(err)
@@ -47,15 +51,34 @@ func newPostgresCargoDecoder(tracking cargotrackingdomain.Tracking) postgres.Dec
 			return nil, ErrScanningCargoRow.Wrap(err)
 		}
 
+		shipper, err := decodePartyPrimitives(rawShipper)
+		if err != nil {
+			return nil, ErrScanningCargoRow.Wrap(err)
+		}
+
+		consignee, err := decodePartyPrimitives(rawConsignee)
+		if err != nil {
+			return nil, ErrScanningCargoRow.Wrap(err)
+		}
+
+		var metadata map[string]string
+		if metadataErr := json.Unmarshal(rawMetadata, &metadata); metadataErr != nil {
+			return nil, ErrScanningCargoRow.Wrap(metadataErr)
+		}
+
 		primitives := cargodomain.CargoPrimitives{
 			ID:        id,
 			VesselID:  vesselID,
 			Items:     cargoItems,
 			Tracking:  cargotrackingdomain.NewTrackingPrimitives(id, tracking),
+			Shipper:   shipper,
+			Consignee: consignee,
+			Metadata:  metadata,
 			Status:    status,
 			CreatedAt: createdAt,
 			UpdatedAt: updatedAt,
 			DeletedAt: deletedAt,
+			Version:   version,
 		}
 
 		return cargodomain.NewCargoFromPrimitives(primitives), nil
@@ -75,16 +98,76 @@ func newPostgresCargoEncoder() postgres.EncodeFunc[*cargodomain.Cargo] {
 			return nil, ErrSavingCargo.Wrap(marshalErr)
 		}
 
+		shipper, marshalErr := encodePartyPrimitives(primitives.Shipper)
+		if marshalErr != nil {
+			return nil, ErrSavingCargo.Wrap(marshalErr)
+		}
+
+		consignee, marshalErr := encodePartyPrimitives(primitives.Consignee)
+		if marshalErr != nil {
+			return nil, ErrSavingCargo.Wrap(marshalErr)
+		}
+
+		metadata, marshalErr := encodeMetadata(primitives.Metadata)
+		if marshalErr != nil {
+			return nil, ErrSavingCargo.Wrap(marshalErr)
+		}
+
 		encoded := []any{
 			primitives.ID,
 			primitives.VesselID,
 			sql.RawBytes(items),
+			shipper,
+			consignee,
+			metadata,
 			primitives.Status,
 			primitives.CreatedAt,
 			primitives.UpdatedAt,
 			primitives.DeletedAt,
+			// Every save stores the next version, the repository checks the stored one is still the loaded one.
+			primitives.Version + 1,
 		}
 
 		return encoded, nil
 	}
 }
+
+func decodePartyPrimitives(raw []byte) (*cargodomain.PartyPrimitives, error) {
+	if len(raw) == 0 {
+		return nil, nil //nolint:nilnil // a missing party is stored as NULL
+	}
+
+	var party cargodomain.PartyPrimitives
+	if err := json.Unmarshal(raw, &party); err != nil {
+		return nil, err
+	}
+
+	return &party, nil
+}
+
+func encodePartyPrimitives(party *cargodomain.PartyPrimitives) (any, error) {
+	if party == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(party)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RawBytes(encoded), nil
+}
+
+// encodeMetadata stores a cargo without metadata as an empty object, the column can't be NULL.
+func encodeMetadata(metadata map[string]string) (sql.RawBytes, error) {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RawBytes(encoded), nil
+}
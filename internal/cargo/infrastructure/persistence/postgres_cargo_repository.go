@@ -2,6 +2,9 @@ package cargopersistence
 
 import (
 	"context"
+	"database/sql"
+	"slices"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
@@ -27,11 +30,17 @@ type PostgresCargoRepository struct {
 	encoder      postgres.EncodeFunc[*cargodomain.Cargo]
 	errorHandler *postgres.ErrorHandler
 	fields       []string
+	transitions  cargodomain.StatusTransitions
 
 	trackingRepo *postgresCargoTrackingRepository
 }
 
-func NewPostgresCargoRepository(schema string, pool sqldb.ConnectionPool) *PostgresCargoRepository {
+// NewPostgresCargoRepository tells the active cargoes apart by the final statuses of the given transitions.
+func NewPostgresCargoRepository(
+	schema string,
+	pool sqldb.ConnectionPool,
+	transitions cargodomain.StatusTransitions,
+) *PostgresCargoRepository {
 	errorHandlers := postgres.ErrorHandlers{
 		postgres.UniqueViolationErrorCode: uniqueViolationPostgresCargoRepoErrorHandler(),
 	}
@@ -44,12 +53,17 @@ func NewPostgresCargoRepository(schema string, pool sqldb.ConnectionPool) *Postg
 			"id",
 			"vessel_id",
 			"items",
+			"shipper",
+			"consignee",
+			"metadata",
 			"status",
 			"created_at",
 			"updated_at",
 			"deleted_at",
+			"version",
 		},
 		errorHandler: postgres.NewErrorHandler(errorHandlers),
+		transitions:  transitions,
 		trackingRepo: newPostgresCargoTrackingRepository(schema, pool),
 	}
 }
@@ -92,55 +106,244 @@ func (r *PostgresCargoRepository) Find(
 }
 
 func (r *PostgresCargoRepository) Save(ctx context.Context, c *cargodomain.Cargo) error {
+	return r.SaveAll(ctx, c)
+}
+
+func (r *PostgresCargoRepository) SaveAll(ctx context.Context, cargoes ...*cargodomain.Cargo) error {
 	tx, txErr := r.pool.Writer().BeginTx(ctx, nil)
 	if txErr != nil {
 		return ErrSavingCargo.Wrap(txErr)
 	}
 
+	for _, c := range cargoes {
+		if saveErr := r.save(ctx, tx, c); saveErr != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				return ErrSavingCargo.Wrap(rbErr)
+			}
+
+			return ErrSavingCargo.Wrap(saveErr)
+		}
+	}
+
+	if commitErr := tx.Commit(); commitErr != nil {
+		return ErrSavingCargo.Wrap(commitErr)
+	}
+
+	return nil
+}
+
+func (r *PostgresCargoRepository) save(ctx context.Context, tx *sql.Tx, c *cargodomain.Cargo) error {
 	bindings, bindingsErr := r.encoder(c)
 	if bindingsErr != nil {
-		return ErrSavingCargo.Wrap(bindingsErr)
+		return bindingsErr
 	}
 
-	query := sq.Insert(r.tableName).
+	// The stored cargo is only overwritten when it's still on the version the cargo was loaded with,
+	// otherwise nothing is affected and someone else saved it in between.
+	query := sq.Insert(r.tableName + " AS stored").
 		Columns(r.fields...).
 		Values(bindings...).
 		Suffix("ON CONFLICT (id) DO UPDATE SET " +
+			"vessel_id = EXCLUDED.vessel_id, " +
 			"items = EXCLUDED.items, " +
+			"shipper = EXCLUDED.shipper, " +
+			"consignee = EXCLUDED.consignee, " +
+			"metadata = EXCLUDED.metadata, " +
 			"status = EXCLUDED.status, " +
 			"updated_at = EXCLUDED.updated_at, " +
-			"deleted_at = EXCLUDED.deleted_at",
+			"deleted_at = EXCLUDED.deleted_at, " +
+			"version = EXCLUDED.version " +
+			"WHERE stored.version = EXCLUDED.version - 1",
 		).PlaceholderFormat(sq.Dollar)
 
-	_, err := query.RunWith(tx).ExecContext(ctx)
+	result, err := query.RunWith(tx).ExecContext(ctx)
 	if err != nil {
 		if pgError, match := postgres.IsPostgresError(err); match {
-			return ErrSavingCargo.Wrap(r.errorHandler.Handle(c, pgError))
+			return r.errorHandler.Handle(c, pgError)
 		}
 
-		return ErrSavingCargo.Wrap(err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
 	}
 
-	if saveTrackingErr := r.trackingRepo.save(ctx, tx, c.ID(), c.Tracking()); saveTrackingErr != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			return ErrSavingCargo.Wrap(rbErr)
+	if affected == 0 {
+		// A cargo never saved before can only clash with an existing one.
+		if c.Version() == 0 {
+			return cargodomain.NewCargoAlreadyExistsError(c.ID(), c.VesselID())
 		}
 
-		return ErrSavingCargo.Wrap(saveTrackingErr)
+		return cargodomain.NewCargoVersionConflictError(c.ID(), c.Version())
 	}
 
-	if commitErr := tx.Commit(); commitErr != nil {
-		return ErrSavingCargo.Wrap(commitErr)
+	return r.trackingRepo.save(ctx, tx, c.ID(), c.Tracking())
+}
+
+func (r *PostgresCargoRepository) Search(
+	ctx context.Context,
+	criteria *cargodomain.CargoSearchCriteria,
+) (cargodomain.CargoSearchResult, error) {
+	sortColumn, sortDirection := criteria.Sort.Field.String(), "ASC"
+	keysetOperator := ">"
+	if criteria.Sort.Descending {
+		sortDirection, keysetOperator = "DESC", "<"
 	}
 
-	return nil
+	wheres := make([]sq.Sqlizer, 0)
+	if len(criteria.Statuses) > 0 {
+		// Backed by the cargoes_idx_status index.
+		wheres = append(wheres, sq.Eq{"status": statusesToStrings(criteria.Statuses)})
+	}
+
+	if criteria.VesselID != nil {
+		// Backed by the cargoes_idx_vessel_id index.
+		wheres = append(wheres, sq.Eq{"vessel_id": criteria.VesselID.String()})
+	}
+
+	if criteria.Shipper != nil {
+		// Backed by the cargoes_idx_shipper_name and cargoes_idx_shipper_email indexes.
+		wheres = append(wheres, sq.Or{
+			sq.Expr("LOWER(shipper ->> 'name') = LOWER(?)", *criteria.Shipper),
+			sq.Expr("LOWER(shipper ->> 'email') = LOWER(?)", *criteria.Shipper),
+		})
+	}
+
+	if len(criteria.Metadata) > 0 {
+		metadata, err := encodeMetadata(criteria.Metadata)
+		if err != nil {
+			return cargodomain.CargoSearchResult{}, ErrRunningQuery.Wrap(err)
+		}
+
+		// Backed by the cargoes_idx_metadata index, which supports the containment operator.
+		wheres = append(wheres, sq.Expr("metadata @> ?::jsonb", string(metadata)))
+	}
+
+	if criteria.CreatedAtFrom != nil {
+		wheres = append(wheres, sq.GtOrEq{"created_at": *criteria.CreatedAtFrom})
+	}
+
+	if criteria.CreatedAtTo != nil {
+		wheres = append(wheres, sq.LtOrEq{"created_at": *criteria.CreatedAtTo})
+	}
+
+	if criteria.Cursor != nil {
+		keyset := "(" + sortColumn + ", id) " + keysetOperator + " (?, ?)"
+		wheres = append(wheres, sq.Expr(keyset, criteria.Cursor.Value(), criteria.Cursor.ID().String()))
+	}
+
+	// One extra row is requested to know whether there is a next page or not.
+	query := r.cargoSelectBuilder(criteria.PageSize+1, wheres...).
+		OrderBy(sortColumn+" "+sortDirection, "id "+sortDirection)
+
+	rows, err := query.RunWith(r.pool.Reader()).QueryContext(ctx)
+	if err != nil {
+		return cargodomain.CargoSearchResult{}, ErrRunningQuery.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	decoder := newPostgresCargoDecoder(cargotrackingdomain.NewEmptyTracking())
+	cargoes := make([]*cargodomain.Cargo, 0, criteria.PageSize)
+	for rows.Next() {
+		c, decodeErr := decoder(rows)
+		if decodeErr != nil {
+			return cargodomain.CargoSearchResult{}, ErrFetchingCargoRows.Wrap(decodeErr)
+		}
+		cargoes = append(cargoes, c)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return cargodomain.CargoSearchResult{}, ErrFetchingCargoRows.Wrap(rowsErr)
+	}
+
+	result := cargodomain.CargoSearchResult{Cargoes: cargoes, NextCursor: nil}
+	if uint64(len(cargoes)) > criteria.PageSize {
+		result.Cargoes = cargoes[:criteria.PageSize]
+		next := cargodomain.NewCargoCursor(result.Cargoes[len(result.Cargoes)-1], criteria.Sort.Field)
+		result.NextCursor = &next
+	}
+
+	return result, nil
+}
+
+func (r *PostgresCargoRepository) ActiveWeightByVessel(ctx context.Context, vesselID cargodomain.VesselID) (uint64, error) {
+	query := sq.Select("COALESCE(SUM((item->>'weight')::BIGINT), 0)").
+		From(r.tableName + ", jsonb_array_elements(items) AS item").
+		Where(sq.Eq{"vessel_id": vesselID.String()}).
+		Where(sq.NotEq{"status": statusesToStrings(r.transitions.FinalStatuses())}).
+		Where(sq.Eq{"deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	var weight uint64
+	if err := query.RunWith(r.pool.Reader()).QueryRowContext(ctx).Scan(&weight); err != nil {
+		return 0, ErrRunningQuery.Wrap(err)
+	}
+
+	return weight, nil
+}
+
+func (r *PostgresCargoRepository) SearchStalled(
+	ctx context.Context,
+	changedBefore time.Time,
+	limit uint64,
+) ([]cargodomain.StalledCargo, error) {
+	// Only the in transit cargoes still expecting a status change can be stalled.
+	stalledStatuses := slices.DeleteFunc([]cargodomain.Status{cargodomain.StatusInTransit}, r.transitions.IsFinal)
+
+	// Every entry whose status before and after differ records a status change, the last one
+	// is the instant the cargo entered its current status. Backed by the cargoes_idx_status
+	// and cargoes_tracking_idx_cargo_id indexes.
+	lastStatusChanges := sq.Select("c.id", "c.version", "MAX(t.created_at) AS status_since").
+		From(r.tableName + " c").
+		Join(r.trackingRepo.tableName + " t ON t.cargo_id = c.id AND t.status_before IS DISTINCT FROM t.status_after").
+		Where(sq.Eq{"c.status": statusesToStrings(stalledStatuses), "c.deleted_at": nil}).
+		GroupBy("c.id")
+
+	notDetectedYet := "NOT EXISTS (SELECT 1 FROM " + r.trackingRepo.tableName + " d " +
+		"WHERE d.cargo_id = s.id AND d.entry_type = ? AND d.created_at >= s.status_since)"
+
+	query := sq.Select("s.id", "s.status_since", "s.version").
+		FromSelect(lastStatusChanges, "s").
+		Where(sq.Lt{"s.status_since": changedBefore}).
+		Where(sq.Expr(notDetectedYet, cargotrackingdomain.TrackingEntryTypeDelayDetected.String())).
+		OrderBy("s.status_since ASC", "s.id ASC").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	rows, err := query.RunWith(r.pool.Reader()).QueryContext(ctx)
+	if err != nil {
+		return nil, ErrRunningQuery.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	stalled := make([]cargodomain.StalledCargo, 0, limit)
+	for rows.Next() {
+		var (
+			id          string
+			statusSince time.Time
+			version     uint64
+		)
+		if scanErr := rows.Scan(&id, &statusSince, &version); scanErr != nil {
+			return nil, ErrFetchingCargoRows.Wrap(scanErr)
+		}
+
+		stalled = append(stalled, cargodomain.StalledCargo{ID: cargodomain.CargoID(id), StatusSince: statusSince, Version: version})
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, ErrFetchingCargoRows.Wrap(rowsErr)
+	}
+
+	return stalled, nil
 }
 
-func (r *PostgresCargoRepository) cargoSelectBuilder(limit uint64, wheres ...sq.Eq) sq.SelectBuilder {
+func (r *PostgresCargoRepository) cargoSelectBuilder(limit uint64, wheres ...sq.Sqlizer) sq.SelectBuilder {
 	qb := sq.Select(r.fields...).From(r.tableName).Limit(limit).PlaceholderFormat(sq.Dollar)
 
 	if wheres == nil {
-		wheres = make([]sq.Eq, 0)
+		wheres = make([]sq.Sqlizer, 0)
 	}
 
 	wheres = append(wheres, sq.Eq{"deleted_at": nil})
@@ -152,6 +355,15 @@ func (r *PostgresCargoRepository) cargoSelectBuilder(limit uint64, wheres ...sq.
 	return qb
 }
 
+func statusesToStrings(statuses []cargodomain.Status) []string {
+	raw := make([]string, len(statuses))
+	for i, status := range statuses {
+		raw[i] = status.String()
+	}
+
+	return raw
+}
+
 func uniqueViolationPostgresCargoRepoErrorHandler() postgres.ErrorHandlerFunc {
 	return func(resource interface{}, err *pq.Error) error {
 		switch res := resource.(type) {
@@ -2,6 +2,7 @@ package cargopersistence
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
@@ -23,10 +24,14 @@ func newPostgresCargoTrackingDecoder() postgres.DecodeFunc[cargotrackingdomain.T
 			rawStatusBefore *sql.NullString
 			rawStatusAfter  *sql.NullString
 			createdAt       time.Time
+			rawDetails      []byte
+			vesselLatitude  sql.NullFloat64
+			vesselLongitude sql.NullFloat64
 		)
 
 		err := rows.Scan(
-			&id, &cargoID, &entryType, &rawStatusBefore, &rawStatusAfter, &createdAt,
+			&id, &cargoID, &entryType, &rawStatusBefore, &rawStatusAfter, &createdAt, &rawDetails,
+			&vesselLatitude, &vesselLongitude,
 		)
 		if err != nil {
 			return cargotrackingdomain.TrackingItem{}, ErrScanningCargoTrackingRow.Wrap(err)
@@ -42,15 +47,28 @@ func newPostgresCargoTrackingDecoder() postgres.DecodeFunc[cargotrackingdomain.T
 			statusAfter = &rawStatusAfter.String
 		}
 
+		var details map[string]any
+		if len(rawDetails) > 0 {
+			if unmarshalErr := json.Unmarshal(rawDetails, &details); unmarshalErr != nil {
+				return cargotrackingdomain.TrackingItem{}, ErrScanningCargoTrackingRow.Wrap(unmarshalErr)
+			}
+		}
+
 		primitives := cargotrackingdomain.TrackingItemPrimitives{
 			ID:           id,
 			CargoID:      cargoID,
 			EntryType:    entryType,
 			StatusBefore: statusBefore,
 			StatusAfter:  statusAfter,
+			Details:      details,
 			CreatedAt:    createdAt,
 		}
 
+		if vesselLatitude.Valid && vesselLongitude.Valid {
+			primitives.VesselLatitude = &vesselLatitude.Float64
+			primitives.VesselLongitude = &vesselLongitude.Float64
+		}
+
 		return cargotrackingdomain.NewTrackingItemFromPrimitives(primitives), nil
 	}
 }
@@ -60,6 +78,15 @@ func newPostgresCargoTrackingEncoder() func(cargodomain.CargoID) postgres.Encode
 		return func(tracking cargotrackingdomain.TrackingItem) ([]any, error) {
 			primitives := cargotrackingdomain.NewTrackingItemPrimitives(cargoID.String(), tracking)
 
+			var details []byte
+			if len(primitives.Details) > 0 {
+				encoded, marshalErr := json.Marshal(primitives.Details)
+				if marshalErr != nil {
+					return nil, ErrSavingCargoTracking.Wrap(marshalErr)
+				}
+				details = encoded
+			}
+
 			return []any{
 				primitives.ID,
 				primitives.CargoID,
@@ -67,6 +94,9 @@ func newPostgresCargoTrackingEncoder() func(cargodomain.CargoID) postgres.Encode
 				primitives.StatusBefore,
 				primitives.StatusAfter,
 				primitives.CreatedAt,
+				details,
+				primitives.VesselLatitude,
+				primitives.VesselLongitude,
 			}, nil
 		}
 	}
@@ -14,6 +14,8 @@ import (
 )
 
 var (
+	_ cargodomain.CargoTrackingReader = (*postgresCargoTrackingRepository)(nil)
+
 	ErrFetchingCargoTrackingRows = errutil.NewError("error fetching cargo tracking rows")
 	ErrRunningCargoTrackingQuery = errutil.NewError("error running cargo tracking query")
 	ErrSavingCargoTracking       = errutil.NewError("error saving cargo to the database")
@@ -27,6 +29,11 @@ type postgresCargoTrackingRepository struct {
 	fields    []string
 }
 
+// NewPostgresCargoTrackingReader returns the reader of the tracking timeline stored along the cargoes.
+func NewPostgresCargoTrackingReader(schema string, pool sqldb.ConnectionPool) cargodomain.CargoTrackingReader {
+	return newPostgresCargoTrackingRepository(schema, pool)
+}
+
 func newPostgresCargoTrackingRepository(schema string, pool sqldb.ConnectionPool) *postgresCargoTrackingRepository {
 	return &postgresCargoTrackingRepository{
 		tableName: schema + "." + "cargoes_tracking",
@@ -40,6 +47,9 @@ func newPostgresCargoTrackingRepository(schema string, pool sqldb.ConnectionPool
 			"status_before",
 			"status_after",
 			"created_at",
+			"details",
+			"vessel_latitude",
+			"vessel_longitude",
 		},
 	}
 }
@@ -48,16 +58,69 @@ func (r *postgresCargoTrackingRepository) findByCargoID(
 	ctx context.Context,
 	cargoID cargodomain.CargoID,
 ) (cargotrackingdomain.Tracking, error) {
-	rows, err := r.trackingSelectBuilder(sq.Eq{"cargo_id": cargoID}).RunWith(r.pool.Reader()).QueryContext(ctx)
+	// Backed by the cargoes_tracking_idx_cargo_id index.
+	query := r.trackingSelectBuilder(sq.Eq{"cargo_id": cargoID}).OrderBy("created_at ASC", "id ASC")
+
+	return r.fetch(ctx, query)
+}
+
+func (r *postgresCargoTrackingRepository) SearchTracking(
+	ctx context.Context,
+	cargoID cargodomain.CargoID,
+	criteria *cargotrackingdomain.TrackingSearchCriteria,
+) (cargotrackingdomain.TrackingSearchResult, error) {
+	// Backed by the cargoes_tracking_idx_cargo_id index, which also covers the ordering.
+	wheres := []sq.Sqlizer{sq.Eq{"cargo_id": cargoID}}
+
+	if len(criteria.EntryTypes) > 0 {
+		entryTypes := make([]string, len(criteria.EntryTypes))
+		for i, entryType := range criteria.EntryTypes {
+			entryTypes[i] = entryType.String()
+		}
+
+		wheres = append(wheres, sq.Eq{"entry_type": entryTypes})
+	}
+
+	if criteria.CreatedAtFrom != nil {
+		wheres = append(wheres, sq.GtOrEq{"created_at": *criteria.CreatedAtFrom})
+	}
+
+	if criteria.CreatedAtTo != nil {
+		wheres = append(wheres, sq.LtOrEq{"created_at": *criteria.CreatedAtTo})
+	}
+
+	if criteria.Cursor != nil {
+		wheres = append(wheres, sq.Expr("(created_at, id) > (?, ?)", criteria.Cursor.CreatedAt(), criteria.Cursor.ID().String()))
+	}
+
+	// One extra row is requested to know whether there is a next page or not.
+	query := r.trackingSelectBuilder(wheres...).OrderBy("created_at ASC", "id ASC").Limit(criteria.PageSize + 1)
+
+	tracking, err := r.fetch(ctx, query)
+	if err != nil {
+		return cargotrackingdomain.TrackingSearchResult{}, err
+	}
+
+	result := cargotrackingdomain.TrackingSearchResult{Tracking: tracking, NextCursor: nil}
+	if uint64(len(tracking)) > criteria.PageSize {
+		result.Tracking = tracking[:criteria.PageSize]
+		next := cargotrackingdomain.NewTrackingCursor(result.Tracking[len(result.Tracking)-1])
+		result.NextCursor = &next
+	}
+
+	return result, nil
+}
+
+func (r *postgresCargoTrackingRepository) fetch(
+	ctx context.Context,
+	query sq.SelectBuilder,
+) (cargotrackingdomain.Tracking, error) {
+	rows, err := query.RunWith(r.pool.Reader()).QueryContext(ctx)
 	if err != nil {
 		return nil, ErrRunningCargoTrackingQuery.Wrap(err)
 	}
 	defer func() { _ = rows.Close() }()
 
-	if rowsErr := rows.Err(); rowsErr != nil {
-		return nil, ErrFetchingCargoTrackingRows.Wrap(rowsErr)
-	}
-
 	trackingItems := make(cargotrackingdomain.Tracking, 0)
 	for rows.Next() {
 		trackingItem, decodeErr := r.decoder(rows)
@@ -67,6 +130,10 @@ func (r *postgresCargoTrackingRepository) findByCargoID(
 		trackingItems = append(trackingItems, trackingItem)
 	}
 
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, ErrFetchingCargoTrackingRows.Wrap(rowsErr)
+	}
+
 	return trackingItems, nil
 }
 
@@ -99,13 +166,9 @@ func (r *postgresCargoTrackingRepository) save(
 	return nil
 }
 
-func (r *postgresCargoTrackingRepository) trackingSelectBuilder(wheres ...sq.Eq) sq.SelectBuilder {
+func (r *postgresCargoTrackingRepository) trackingSelectBuilder(wheres ...sq.Sqlizer) sq.SelectBuilder {
 	qb := sq.Select(r.fields...).From(r.tableName).PlaceholderFormat(sq.Dollar)
 
-	if wheres == nil {
-		wheres = make([]sq.Eq, 0)
-	}
-
 	for _, where := range wheres {
 		qb = qb.Where(where)
 	}
@@ -2,9 +2,11 @@ package cargoinfra
 
 import (
 	"context"
+	"errors"
 
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 	vesselqueries "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/queries"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
 	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
@@ -14,6 +16,7 @@ var (
 	_ cargodomain.CargoVesselChecker = (*QueryBusVesselChecker)(nil)
 
 	ErrVesselNotFound = errutil.NewError("vessel not found")
+	ErrCheckingVessel = errutil.NewError("error checking vessel")
 )
 
 type QueryBusVesselChecker struct {
@@ -24,12 +27,19 @@ func NewQueryBusVesselChecker(queryBus querybus.Bus) *QueryBusVesselChecker {
 	return &QueryBusVesselChecker{queryBus: queryBus}
 }
 
-func (q *QueryBusVesselChecker) Check(ctx context.Context, vesselID cargodomain.VesselID) error {
+func (q *QueryBusVesselChecker) Check(ctx context.Context, vesselID cargodomain.VesselID) (cargodomain.CargoVessel, error) {
 	query := &vesselqueries.FetchVesselByIDQuery{ID: vesselID.String()}
-	err := bus.Dispatch(q.queryBus)(ctx, query)
+	vessel, err := bus.DispatchWithResponse[*vesselqueries.FetchVesselByIDQuery, vesselqueries.VesselResponse](q.queryBus)(
+		ctx,
+		query,
+	)
+	if vesseldomain.IsVesselNotExistsError(err) || errors.Is(err, vesseldomain.ErrInvalidVesselIDProvided) {
+		return cargodomain.CargoVessel{}, ErrVesselNotFound.Wrap(err)
+	}
 	if err != nil {
-		return ErrVesselNotFound.Wrap(err)
+		return cargodomain.CargoVessel{}, ErrCheckingVessel.Wrap(err)
 	}
 
-	return nil
+	// Vessel capacity is expressed in kilograms.
+	return cargodomain.NewCargoVessel(vesselID, vessel.Capacity).WithPosition(vessel.Latitude, vessel.Longitude), nil
 }
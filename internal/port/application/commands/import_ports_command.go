@@ -0,0 +1,43 @@
+package portcommands
+
+import (
+	"context"
+	"fmt"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+)
+
+// ImportPortsCommand stores the ports of the UN/LOCODE registry in a single transaction, replacing the
+// ones already stored so the ports no longer in the registry are deleted.
+type ImportPortsCommand struct{}
+
+func (c *ImportPortsCommand) Type() string {
+	return "import_ports_command"
+}
+
+func (c *ImportPortsCommand) BlockingKey() string {
+	return "ports_import"
+}
+
+type ImportPortsCommandHandler struct {
+	repository portdomain.PortRepository
+	registry   []*portdomain.Port
+}
+
+func NewImportPortsCommandHandler(
+	repository portdomain.PortRepository,
+	registry []*portdomain.Port,
+) *ImportPortsCommandHandler {
+	return &ImportPortsCommandHandler{
+		repository: repository,
+		registry:   registry,
+	}
+}
+
+func (h *ImportPortsCommandHandler) Handle(ctx context.Context, _ *ImportPortsCommand) (interface{}, error) {
+	if err := h.repository.ReplaceAll(ctx, h.registry...); err != nil {
+		return nil, fmt.Errorf("error importing ports: %w", err)
+	}
+
+	return struct{}{}, nil
+}
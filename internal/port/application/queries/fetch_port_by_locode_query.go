@@ -0,0 +1,40 @@
+package portqueries
+
+import (
+	"context"
+	"fmt"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+)
+
+type FetchPortByLOCODEQuery struct {
+	LOCODE string
+}
+
+func (q *FetchPortByLOCODEQuery) Type() string {
+	return "fetch_port_by_locode_query"
+}
+
+type FetchPortByLOCODEQueryHandler struct {
+	repository portdomain.PortRepository
+}
+
+func NewFetchPortByLOCODEQueryHandler(repository portdomain.PortRepository) *FetchPortByLOCODEQueryHandler {
+	return &FetchPortByLOCODEQueryHandler{
+		repository: repository,
+	}
+}
+
+func (h *FetchPortByLOCODEQueryHandler) Handle(ctx context.Context, q *FetchPortByLOCODEQuery) (PortResponse, error) {
+	locode, err := portdomain.NewLOCODE(q.LOCODE)
+	if err != nil {
+		return PortResponse{}, fmt.Errorf("invalid locode: %w", err)
+	}
+
+	port, err := h.repository.Find(ctx, locode)
+	if err != nil {
+		return PortResponse{}, fmt.Errorf("error fetching port: %w", err)
+	}
+
+	return NewPortResponse(port.Primitives()), nil
+}
@@ -0,0 +1,44 @@
+package portqueries
+
+import (
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+)
+
+type PortResponse struct {
+	LOCODE    string
+	Name      string
+	Country   string
+	Latitude  float64
+	Longitude float64
+	TimeZone  string
+}
+
+func NewPortResponse(p portdomain.PortPrimitives) PortResponse {
+	return PortResponse{
+		LOCODE:    p.LOCODE,
+		Name:      p.Name,
+		Country:   p.Country,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		TimeZone:  p.TimeZone,
+	}
+}
+
+type PortsResponse struct {
+	Items      []PortResponse
+	NextCursor string
+}
+
+func NewPortsResponse(result portdomain.PortSearchResult) PortsResponse {
+	items := make([]PortResponse, len(result.Ports))
+	for i, port := range result.Ports {
+		items[i] = NewPortResponse(port.Primitives())
+	}
+
+	var nextCursor string
+	if result.NextCursor != nil {
+		nextCursor = result.NextCursor.String()
+	}
+
+	return PortsResponse{Items: items, NextCursor: nextCursor}
+}
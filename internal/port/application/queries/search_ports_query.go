@@ -0,0 +1,48 @@
+package portqueries
+
+import (
+	"context"
+	"fmt"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+)
+
+type SearchPortsQuery struct {
+	Term     string
+	Country  string
+	Cursor   string
+	PageSize uint64
+}
+
+func (q *SearchPortsQuery) Type() string {
+	return "search_ports_query"
+}
+
+type SearchPortsQueryHandler struct {
+	repository portdomain.PortRepository
+}
+
+func NewSearchPortsQueryHandler(repository portdomain.PortRepository) *SearchPortsQueryHandler {
+	return &SearchPortsQueryHandler{
+		repository: repository,
+	}
+}
+
+func (h *SearchPortsQueryHandler) Handle(ctx context.Context, q *SearchPortsQuery) (PortsResponse, error) {
+	criteria, err := portdomain.NewPortSearchCriteria(
+		portdomain.WithSearchTerm(q.Term),
+		portdomain.WithCountryFilter(q.Country),
+		portdomain.WithCursor(q.Cursor),
+		portdomain.WithPageSize(q.PageSize),
+	)
+	if err != nil {
+		return PortsResponse{}, fmt.Errorf("invalid search criteria: %w", err)
+	}
+
+	result, err := h.repository.Search(ctx, criteria)
+	if err != nil {
+		return PortsResponse{}, fmt.Errorf("error searching ports: %w", err)
+	}
+
+	return NewPortsResponse(result), nil
+}
@@ -0,0 +1,31 @@
+package portdomain
+
+import (
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrInvalidCountryCodeProvided = errutil.NewError("invalid country code provided")
+)
+
+// CountryCode is the ISO 3166-1 alpha-2 code of the country the port is located in.
+type CountryCode string
+
+func NewCountryCode(code string) (CountryCode, error) {
+	countryCode := CountryCode(code)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.Regex(`^[A-Z]{2}$`),
+	)
+
+	if err := validation.Validate(code); err != nil {
+		return "", ErrInvalidCountryCodeProvided.Wrap(err)
+	}
+
+	return countryCode, nil
+}
+
+func (c CountryCode) String() string {
+	return string(c)
+}
@@ -0,0 +1,38 @@
+package portdomain
+
+import (
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const countryCodeLength = 2
+
+var (
+	ErrInvalidLOCODEProvided = errutil.NewError("invalid locode provided")
+)
+
+// LOCODE is the UN/LOCODE of the port, the ISO 3166-1 country code followed by three characters
+// identifying the location within the country, e.g. NLRTM.
+type LOCODE string
+
+func NewLOCODE(locode string) (LOCODE, error) {
+	portLOCODE := LOCODE(locode)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.Regex(`^[A-Z]{2}[A-Z2-9]{3}$`),
+	)
+
+	if err := validation.Validate(locode); err != nil {
+		return "", ErrInvalidLOCODEProvided.Wrap(err)
+	}
+
+	return portLOCODE, nil
+}
+
+func (l LOCODE) Country() CountryCode {
+	return CountryCode(l[:countryCodeLength])
+}
+
+func (l LOCODE) String() string {
+	return string(l)
+}
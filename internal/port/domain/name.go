@@ -0,0 +1,35 @@
+package portdomain
+
+import (
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const (
+	portNameMaxLength = 100
+)
+
+var (
+	ErrInvalidPortNameProvided = errutil.NewError("invalid port name provided")
+)
+
+type Name string
+
+func NewName(name string) (Name, error) {
+	portName := Name(name)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+		domainvalidation.MaxLength(portNameMaxLength),
+	)
+
+	if err := validation.Validate(name); err != nil {
+		return "", ErrInvalidPortNameProvided.Wrap(err)
+	}
+
+	return portName, nil
+}
+
+func (n Name) String() string {
+	return string(n)
+}
@@ -0,0 +1,67 @@
+package portdomain
+
+// Port is a location listed in the UN/LOCODE registry with port facilities.
+type Port struct {
+	locode   LOCODE
+	name     Name
+	country  CountryCode
+	location Location
+	timeZone TimeZone
+}
+
+type PortInput struct {
+	LOCODE    string
+	Name      string
+	Latitude  float64
+	Longitude float64
+	TimeZone  string
+}
+
+// NewPort builds a port from its UN/LOCODE entry, the country is the one the LOCODE starts with.
+func NewPort(input PortInput) (*Port, error) {
+	locode, err := NewLOCODE(input.LOCODE)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := NewName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := NewLocation(input.Latitude, input.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	timeZone, err := NewTimeZone(input.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Port{
+		locode:   locode,
+		name:     name,
+		country:  locode.Country(),
+		location: location,
+		timeZone: timeZone,
+	}, nil
+}
+
+func NewPortFromPrimitives(p PortPrimitives) *Port {
+	return &Port{
+		locode:   LOCODE(p.LOCODE),
+		name:     Name(p.Name),
+		country:  CountryCode(p.Country),
+		location: Location{latitude: p.Latitude, longitude: p.Longitude},
+		timeZone: TimeZone(p.TimeZone),
+	}
+}
+
+func (p *Port) Primitives() PortPrimitives {
+	return newPortPrimitives(p)
+}
+
+func (p *Port) LOCODE() LOCODE {
+	return p.locode
+}
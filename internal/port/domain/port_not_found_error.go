@@ -0,0 +1,26 @@
+package portdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+type PortNotExistsError struct {
+	domain.BaseError
+}
+
+func NewPortNotExistsError(locode LOCODE) *PortNotExistsError {
+	return &PortNotExistsError{
+		BaseError: domain.NewError(
+			"port doesn't exist.",
+			errutil.WithMetadataKeyValue("domain.port.locode", locode.String()),
+		),
+	}
+}
+
+func IsPortNotExistsError(err error) bool {
+	var self *PortNotExistsError
+	return errors.As(err, &self)
+}
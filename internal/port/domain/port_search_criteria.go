@@ -0,0 +1,117 @@
+package portdomain
+
+import (
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	DefaultPortSearchPageSize uint64 = 20
+	MaxPortSearchPageSize     uint64 = 100
+
+	portSearchTermMaxLength = 100
+)
+
+var (
+	ErrInvalidPortSearchCriteria = domainvalidation.NewError("invalid port search criteria provided")
+)
+
+type PortSearchOpt func(*PortSearchCriteria) error
+
+// PortSearchCriteria matches the ports sorted by LOCODE, the cursor being the LOCODE of the last
+// port of the previous page.
+type PortSearchCriteria struct {
+	Term     *string
+	Country  *CountryCode
+	Cursor   *LOCODE
+	PageSize uint64
+}
+
+func NewPortSearchCriteria(opts ...PortSearchOpt) (*PortSearchCriteria, error) {
+	criteria := &PortSearchCriteria{Term: nil, Country: nil, Cursor: nil, PageSize: DefaultPortSearchPageSize}
+	for _, opt := range opts {
+		if err := opt(criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	return criteria, nil
+}
+
+// WithSearchTerm matches the ports whose LOCODE or name start with the given term, ignoring case.
+func WithSearchTerm(raw string) PortSearchOpt {
+	return func(c *PortSearchCriteria) error {
+		term := strings.TrimSpace(raw)
+		if term == "" {
+			return nil
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.MaxLength(portSearchTermMaxLength),
+		)
+
+		if err := validator.Validate(term); err != nil {
+			return ErrInvalidPortSearchCriteria.Wrap(err)
+		}
+
+		c.Term = &term
+		return nil
+	}
+}
+
+func WithCountryFilter(raw string) PortSearchOpt {
+	return func(c *PortSearchCriteria) error {
+		if raw == "" {
+			return nil
+		}
+
+		country, err := NewCountryCode(strings.ToUpper(raw))
+		if err != nil {
+			return ErrInvalidPortSearchCriteria.Wrap(err)
+		}
+
+		c.Country = &country
+		return nil
+	}
+}
+
+func WithCursor(raw string) PortSearchOpt {
+	return func(c *PortSearchCriteria) error {
+		if raw == "" {
+			return nil
+		}
+
+		cursor, err := NewLOCODE(raw)
+		if err != nil {
+			return ErrInvalidPortSearchCriteria.Wrap(err)
+		}
+
+		c.Cursor = &cursor
+		return nil
+	}
+}
+
+func WithPageSize(size uint64) PortSearchOpt {
+	return func(c *PortSearchCriteria) error {
+		if size == 0 {
+			return nil
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.WithinBounds(1, MaxPortSearchPageSize),
+		)
+
+		if err := validator.Validate(size); err != nil {
+			return ErrInvalidPortSearchCriteria.Wrap(err)
+		}
+
+		c.PageSize = size
+		return nil
+	}
+}
+
+type PortSearchResult struct {
+	Ports      []*Port
+	NextCursor *LOCODE
+}
@@ -0,0 +1,150 @@
+package portdomain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+)
+
+func TestNewPort(t *testing.T) {
+	rotterdam := func() portdomain.PortInput {
+		return portdomain.PortInput{
+			LOCODE:    "NLRTM",
+			Name:      "Rotterdam",
+			Latitude:  51.9167,
+			Longitude: 4.5,
+			TimeZone:  "Europe/Amsterdam",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		input         func() portdomain.PortInput
+		expectedError error
+	}{
+		{
+			name:  "should create a port located in the country its locode starts with",
+			input: rotterdam,
+		},
+		{
+			name: "should fail when the locode is not a valid one",
+			input: func() portdomain.PortInput {
+				input := rotterdam()
+				input.LOCODE = "nlrtm"
+				return input
+			},
+			expectedError: portdomain.ErrInvalidLOCODEProvided,
+		},
+		{
+			name: "should fail when the name is empty",
+			input: func() portdomain.PortInput {
+				input := rotterdam()
+				input.Name = ""
+				return input
+			},
+			expectedError: portdomain.ErrInvalidPortNameProvided,
+		},
+		{
+			name: "should fail when the location is out of bounds",
+			input: func() portdomain.PortInput {
+				input := rotterdam()
+				input.Longitude = 181
+				return input
+			},
+			expectedError: portdomain.ErrInvalidLocationProvided,
+		},
+		{
+			name: "should fail when the time zone is unknown",
+			input: func() portdomain.PortInput {
+				input := rotterdam()
+				input.TimeZone = "Europe/Rotterdam"
+				return input
+			},
+			expectedError: portdomain.ErrInvalidTimeZoneProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, err := portdomain.NewPort(tt.input())
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			primitives := port.Primitives()
+			assert.Equal(t, "NLRTM", primitives.LOCODE)
+			assert.Equal(t, "NL", primitives.Country)
+			assert.Equal(t, "Europe/Amsterdam", primitives.TimeZone)
+		})
+	}
+}
+
+func TestNewPortSearchCriteria(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []portdomain.PortSearchOpt
+		assertion     func(t *testing.T, criteria *portdomain.PortSearchCriteria)
+		expectedError bool
+	}{
+		{
+			name: "should build default criteria when no options are provided",
+			assertion: func(t *testing.T, criteria *portdomain.PortSearchCriteria) {
+				assert.Nil(t, criteria.Term)
+				assert.Nil(t, criteria.Country)
+				assert.Nil(t, criteria.Cursor)
+				assert.Equal(t, portdomain.DefaultPortSearchPageSize, criteria.PageSize)
+			},
+		},
+		{
+			name: "should build criteria with every filter provided",
+			opts: []portdomain.PortSearchOpt{
+				portdomain.WithSearchTerm(" rotter "),
+				portdomain.WithCountryFilter("nl"),
+				portdomain.WithCursor("NLAMS"),
+				portdomain.WithPageSize(50),
+			},
+			assertion: func(t *testing.T, criteria *portdomain.PortSearchCriteria) {
+				require.NotNil(t, criteria.Term)
+				assert.Equal(t, "rotter", *criteria.Term)
+				require.NotNil(t, criteria.Country)
+				assert.Equal(t, portdomain.CountryCode("NL"), *criteria.Country)
+				require.NotNil(t, criteria.Cursor)
+				assert.Equal(t, portdomain.LOCODE("NLAMS"), *criteria.Cursor)
+				assert.Equal(t, uint64(50), criteria.PageSize)
+			},
+		},
+		{
+			name:          "should fail when the country is not a valid one",
+			opts:          []portdomain.PortSearchOpt{portdomain.WithCountryFilter("NLD")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the cursor is not a valid locode",
+			opts:          []portdomain.PortSearchOpt{portdomain.WithCursor("NL")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the page size exceeds the maximum",
+			opts:          []portdomain.PortSearchOpt{portdomain.WithPageSize(101)},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := portdomain.NewPortSearchCriteria(tt.opts...)
+			if tt.expectedError {
+				require.ErrorIs(t, err, portdomain.ErrInvalidPortSearchCriteria)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.assertion(t, criteria)
+		})
+	}
+}
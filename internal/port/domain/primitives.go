@@ -0,0 +1,21 @@
+package portdomain
+
+type PortPrimitives struct {
+	LOCODE    string
+	Name      string
+	Country   string
+	Latitude  float64
+	Longitude float64
+	TimeZone  string
+}
+
+func newPortPrimitives(p *Port) PortPrimitives {
+	return PortPrimitives{
+		LOCODE:    p.locode.String(),
+		Name:      p.name.String(),
+		Country:   p.country.String(),
+		Latitude:  p.location.latitude,
+		Longitude: p.location.longitude,
+		TimeZone:  p.timeZone.String(),
+	}
+}
@@ -0,0 +1,22 @@
+package portdomain
+
+import (
+	"context"
+)
+
+type PortRepositoryReader interface {
+	Find(ctx context.Context, locode LOCODE) (*Port, error)
+	Search(ctx context.Context, criteria *PortSearchCriteria) (PortSearchResult, error)
+}
+
+type PortRepositoryWriter interface {
+	// ReplaceAll stores the given ports all at once, overwriting the ones already stored with the same
+	// LOCODE and deleting the ones not given anymore.
+	ReplaceAll(ctx context.Context, ports ...*Port) error
+}
+
+//go:generate moq -pkg portdomainmock -out mock/port_repository_moq.go . PortRepository
+type PortRepository interface {
+	PortRepositoryReader
+	PortRepositoryWriter
+}
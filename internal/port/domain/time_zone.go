@@ -0,0 +1,39 @@
+package portdomain
+
+import (
+	"time"
+	// The IANA time zone database is embedded so ports are validated the same way wherever the service runs.
+	_ "time/tzdata"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrInvalidTimeZoneProvided = errutil.NewError("invalid time zone provided")
+)
+
+// TimeZone is the IANA time zone name of the port local time, e.g. Europe/Amsterdam.
+type TimeZone string
+
+func NewTimeZone(name string) (TimeZone, error) {
+	timeZone := TimeZone(name)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+	)
+
+	if err := validation.Validate(name); err != nil {
+		return "", ErrInvalidTimeZoneProvided.Wrap(err)
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return "", ErrInvalidTimeZoneProvided.Wrap(err)
+	}
+
+	return timeZone, nil
+}
+
+func (t TimeZone) String() string {
+	return string(t)
+}
@@ -0,0 +1,71 @@
+package portentrypoint
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	portqueries "github.com/soulcodex/deus-cargo-tracker/internal/port/application/queries"
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type FetchPortByLOCODEResponse struct {
+	LOCODE    string  `jsonapi:"primary,port"`
+	Name      string  `jsonapi:"attr,name"`
+	Country   string  `jsonapi:"attr,country"`
+	Latitude  float64 `jsonapi:"attr,latitude"`
+	Longitude float64 `jsonapi:"attr,longitude"`
+	TimeZone  string  `jsonapi:"attr,time_zone"`
+}
+
+func newFetchPortByLOCODEResponse(resp portqueries.PortResponse) *FetchPortByLOCODEResponse {
+	return &FetchPortByLOCODEResponse{
+		LOCODE:    resp.LOCODE,
+		Name:      resp.Name,
+		Country:   resp.Country,
+		Latitude:  resp.Latitude,
+		Longitude: resp.Longitude,
+		TimeZone:  resp.TimeZone,
+	}
+}
+
+func HandleGETFetchPortByLOCODEV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		locode := mux.Vars(r)["locode"]
+		if locode == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("locode is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, portdomain.ErrInvalidLOCODEProvided)
+			return
+		}
+
+		// LOCODEs are upper case, a lower case one is accepted the same way.
+		query := &portqueries.FetchPortByLOCODEQuery{LOCODE: strings.ToUpper(locode)}
+
+		result, err := bus.DispatchWithResponse[*portqueries.FetchPortByLOCODEQuery, portqueries.PortResponse](
+			queryBus,
+		)(r.Context(), query)
+
+		switch {
+		case err == nil:
+			middleware.WriteResponse(r.Context(), w, newFetchPortByLOCODEResponse(result), http.StatusOK)
+		case portdomain.IsPortNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("port not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, portdomain.ErrInvalidLOCODEProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid locode provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package portentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	portqueries "github.com/soulcodex/deus-cargo-tracker/internal/port/application/queries"
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const (
+	searchFilterQueryParam  = "filter[search]"
+	countryFilterQueryParam = "filter[country]"
+	pageCursorQueryParam    = "page[cursor]"
+	pageSizeQueryParam      = "page[size]"
+)
+
+func newSearchPortsResponse(resp portqueries.PortsResponse) []*FetchPortByLOCODEResponse {
+	ports := make([]*FetchPortByLOCODEResponse, len(resp.Items))
+	for i, item := range resp.Items {
+		ports[i] = newFetchPortByLOCODEResponse(item)
+	}
+
+	return ports
+}
+
+func HandleGETSearchPortsV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values := r.URL.Query()
+
+		pageSize, err := httpserver.FetchUintQueryParamValue(values, pageSizeQueryParam, portdomain.DefaultPortSearchPageSize)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid page size provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		query := &portqueries.SearchPortsQuery{
+			Term:     httpserver.FetchStringQueryParamValue(values, searchFilterQueryParam, ""),
+			Country:  httpserver.FetchStringQueryParamValue(values, countryFilterQueryParam, ""),
+			Cursor:   httpserver.FetchStringQueryParamValue(values, pageCursorQueryParam, ""),
+			PageSize: pageSize,
+		}
+
+		result, err := bus.DispatchWithResponse[*portqueries.SearchPortsQuery, portqueries.PortsResponse](queryBus)(
+			r.Context(),
+			query,
+		)
+
+		switch {
+		case err == nil:
+			links := httpserver.NewCursorPaginationLinks(r, pageCursorQueryParam, result.NextCursor)
+			middleware.WriteCollectionResponse(r.Context(), w, newSearchPortsResponse(result), links, http.StatusOK)
+		case errors.Is(err, portdomain.ErrInvalidPortSearchCriteria):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid port search criteria provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
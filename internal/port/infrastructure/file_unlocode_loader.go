@@ -0,0 +1,315 @@
+package portinfra
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/logger"
+)
+
+const (
+	// unlocodePortFunction is the function classifier the entries with port facilities start with.
+	unlocodePortFunction = "1"
+	// unlocodeRemovedEntry is the change indicator of the entries removed from the code list.
+	unlocodeRemovedEntry = "X"
+
+	coordinatesParts      = 2
+	minutesPerDegree      = 60
+	latitudeDegreeDigits  = 2
+	longitudeDegreeDigits = 3
+	minutesDigits         = 2
+	hemisphereDigits      = 1
+)
+
+// The columns of the official UN/LOCODE CSV code list, which comes without header.
+const (
+	unlocodeChangeColumn = iota
+	unlocodeCountryColumn
+	unlocodeLocationColumn
+	unlocodeNameColumn
+	unlocodeNameWoDiacriticsColumn
+	_ // Subdivision
+	unlocodeFunctionColumn
+	_ // Status
+	_ // Date
+	_ // IATA
+	unlocodeCoordinatesColumn
+	_ // Remarks
+	unlocodeColumns
+)
+
+// The columns of the time zones file, which starts with a locode,time_zone header.
+const (
+	timeZoneLOCODEColumn = iota
+	timeZoneNameColumn
+	timeZoneColumns
+)
+
+var (
+	// bundledUNLOCODE holds the major seaports of the UN/LOCODE code list, and bundledTimeZones
+	// their time zone, which the code list doesn't provide.
+	//
+	//go:embed unlocode.csv
+	bundledUNLOCODE []byte
+	//go:embed unlocode_time_zones.csv
+	bundledTimeZones []byte
+
+	ErrLoadingUNLOCODE     = errutil.NewError("error loading un/locode ports")
+	errMissingColumn       = errors.New("missing un/locode column")
+	errInvalidCoordinates  = errors.New("invalid un/locode coordinates")
+	errInvalidHemisphere   = errors.New("invalid un/locode coordinates hemisphere")
+	errUnexpectedEmptyFile = errors.New("empty un/locode file")
+)
+
+// LoadPortsFromFiles reads the ports of a code list laid out as the official headerless UN/LOCODE CSV,
+// i.e. Change, Country, Location, Name, NameWoDiacritics, Subdivision, Function, Status, Date, IATA,
+// Coordinates (e.g. 5155N 00430E) and Remarks, along with their time zone read from a locode,time_zone
+// CSV file. Entries without port facilities, coordinates or time zone, or removed from the code list,
+// are skipped, as well as the malformed ones which are logged. A LOCODE listed twice keeps its last
+// entry, the duplicate is logged. When no paths are provided the bundled files are read.
+func LoadPortsFromFiles(path, timeZonesPath string, logger logger.ZerologLogger) ([]*portdomain.Port, error) {
+	content, err := readFileOrDefault(path, bundledUNLOCODE)
+	if err != nil {
+		return nil, ErrLoadingUNLOCODE.Wrap(err)
+	}
+
+	timeZonesContent, err := readFileOrDefault(timeZonesPath, bundledTimeZones)
+	if err != nil {
+		return nil, ErrLoadingUNLOCODE.Wrap(err)
+	}
+
+	loader := &unlocodeLoader{logger: logger}
+
+	timeZones, err := loader.readTimeZones(bytes.NewReader(timeZonesContent))
+	if err != nil {
+		return nil, ErrLoadingUNLOCODE.Wrap(err)
+	}
+
+	ports, err := loader.readPorts(bytes.NewReader(content), timeZones)
+	if err != nil {
+		return nil, ErrLoadingUNLOCODE.Wrap(err)
+	}
+
+	return ports, nil
+}
+
+func readFileOrDefault(path string, bundled []byte) ([]byte, error) {
+	if path == "" {
+		return bundled, nil
+	}
+
+	return os.ReadFile(path)
+}
+
+type unlocodeLoader struct {
+	logger logger.ZerologLogger
+}
+
+func (l *unlocodeLoader) readTimeZones(r io.Reader) (map[string]string, error) {
+	reader := newCSVReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errUnexpectedEmptyFile
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(header) < timeZoneColumns {
+		return nil, errMissingColumn
+	}
+
+	timeZones := make(map[string]string)
+	for {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			return timeZones, nil
+		}
+		if l.skipMalformedLine(readErr) {
+			continue
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		if len(record) < timeZoneColumns {
+			l.skipEntry(reader, errMissingColumn, "")
+			continue
+		}
+
+		locode := strings.ToUpper(strings.TrimSpace(record[timeZoneLOCODEColumn]))
+		timeZones[locode] = strings.TrimSpace(record[timeZoneNameColumn])
+	}
+}
+
+func (l *unlocodeLoader) readPorts(r io.Reader, timeZones map[string]string) ([]*portdomain.Port, error) {
+	reader := newCSVReader(r)
+
+	ports := make([]*portdomain.Port, 0)
+	// indexes keeps where each locode was loaded, a repeated one would break the ports upsert.
+	indexes := make(map[string]int)
+	for lines := 0; ; lines++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			if lines == 0 {
+				return nil, errUnexpectedEmptyFile
+			}
+
+			return ports, nil
+		}
+		if l.skipMalformedLine(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(record) < unlocodeColumns {
+			l.skipEntry(reader, errMissingColumn, "")
+			continue
+		}
+
+		value := func(column int) string {
+			return strings.TrimSpace(record[column])
+		}
+
+		locode := value(unlocodeCountryColumn) + value(unlocodeLocationColumn)
+		if !isPortEntry(value, timeZones[locode]) {
+			continue
+		}
+
+		port, portErr := newPortFromRecord(value, locode, timeZones[locode])
+		if portErr != nil {
+			l.skipEntry(reader, portErr, locode)
+			continue
+		}
+
+		if index, duplicated := indexes[locode]; duplicated {
+			l.replaceEntry(reader, locode)
+			ports[index] = port
+			continue
+		}
+
+		indexes[locode] = len(ports)
+		ports = append(ports, port)
+	}
+}
+
+// skipMalformedLine logs the lines the CSV reader couldn't parse, which are skipped.
+func (l *unlocodeLoader) skipMalformedLine(err error) bool {
+	var parseErr *csv.ParseError
+	if !errors.As(err, &parseErr) {
+		return false
+	}
+
+	l.logger.Warn().Err(parseErr).Int("unlocode.line", parseErr.Line).Msg("skipping malformed un/locode line")
+
+	return true
+}
+
+func (l *unlocodeLoader) skipEntry(reader *csv.Reader, err error, locode string) {
+	line, _ := reader.FieldPos(0)
+	l.logger.Warn().Err(err).Int("unlocode.line", line).Str("unlocode.locode", locode).Msg("skipping malformed un/locode entry")
+}
+
+func (l *unlocodeLoader) replaceEntry(reader *csv.Reader, locode string) {
+	line, _ := reader.FieldPos(0)
+	l.logger.Warn().Int("unlocode.line", line).Str("unlocode.locode", locode).Msg("replacing duplicated un/locode entry")
+}
+
+// isPortEntry tells apart the entries with port facilities, coordinates and time zone from the rest,
+// including the rows naming the countries which come without location and the removed entries.
+func isPortEntry(value func(column int) string, timeZone string) bool {
+	if value(unlocodeLocationColumn) == "" || value(unlocodeChangeColumn) == unlocodeRemovedEntry {
+		return false
+	}
+
+	return strings.HasPrefix(value(unlocodeFunctionColumn), unlocodePortFunction) &&
+		value(unlocodeCoordinatesColumn) != "" &&
+		timeZone != ""
+}
+
+func newPortFromRecord(value func(column int) string, locode, timeZone string) (*portdomain.Port, error) {
+	latitude, longitude, err := parseCoordinates(value(unlocodeCoordinatesColumn))
+	if err != nil {
+		return nil, err
+	}
+
+	// The official files aren't always UTF-8 encoded, the name without diacritics is ASCII in any case.
+	name := value(unlocodeNameColumn)
+	if !utf8.ValidString(name) {
+		name = value(unlocodeNameWoDiacriticsColumn)
+	}
+
+	return portdomain.NewPort(portdomain.PortInput{
+		LOCODE:    locode,
+		Name:      name,
+		Latitude:  latitude,
+		Longitude: longitude,
+		TimeZone:  timeZone,
+	})
+}
+
+func newCSVReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	return reader
+}
+
+// parseCoordinates parses the UN/LOCODE degrees and minutes notation, e.g. 5155N 00430E.
+func parseCoordinates(raw string) (float64, float64, error) {
+	parts := strings.Fields(raw)
+	if len(parts) != coordinatesParts {
+		return 0, 0, errInvalidCoordinates
+	}
+
+	latitude, err := parseDegreesMinutes(parts[0], latitudeDegreeDigits, 'N', 'S')
+	if err != nil {
+		return 0, 0, err
+	}
+
+	longitude, err := parseDegreesMinutes(parts[1], longitudeDegreeDigits, 'E', 'W')
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return latitude, longitude, nil
+}
+
+func parseDegreesMinutes(raw string, degreeDigits int, positive, negative byte) (float64, error) {
+	if len(raw) != degreeDigits+minutesDigits+hemisphereDigits {
+		return 0, errInvalidCoordinates
+	}
+
+	degrees, err := strconv.Atoi(raw[:degreeDigits])
+	if err != nil {
+		return 0, errInvalidCoordinates
+	}
+
+	minutes, err := strconv.Atoi(raw[degreeDigits : degreeDigits+minutesDigits])
+	if err != nil || minutes >= minutesPerDegree {
+		return 0, errInvalidCoordinates
+	}
+
+	value := float64(degrees) + float64(minutes)/minutesPerDegree
+
+	switch raw[len(raw)-1] {
+	case positive:
+		return value, nil
+	case negative:
+		return -value, nil
+	default:
+		return 0, errInvalidHemisphere
+	}
+}
@@ -0,0 +1,120 @@
+package portinfra_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	portinfra "github.com/soulcodex/deus-cargo-tracker/internal/port/infrastructure"
+)
+
+func TestLoadPortsFromFiles(t *testing.T) {
+	writeFile := func(t *testing.T, name, content string) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	timeZonesPath := writeFile(t, "time_zones.csv", "locode,time_zone\n"+
+		"BRSSZ,America/Sao_Paulo\n"+
+		"NLAMS,Europe/Amsterdam\n"+
+		"NLRTM,Europe/Amsterdam\n"+
+		"DEFRA,Europe/Berlin\n"+
+		"SEGOT,Europe/Stockholm\n"+
+		"ESVLC,Mars/Olympus_Mons\n")
+
+	t.Run("should load the bundled ports when no path is provided", func(t *testing.T) {
+		ports, err := portinfra.LoadPortsFromFiles("", "", zerolog.Nop())
+		require.NoError(t, err)
+		require.NotEmpty(t, ports)
+
+		for _, port := range ports {
+			if port.LOCODE() != "NLRTM" {
+				continue
+			}
+
+			primitives := port.Primitives()
+			assert.Equal(t, "Rotterdam", primitives.Name)
+			assert.Equal(t, "NL", primitives.Country)
+			assert.InDelta(t, 51.9167, primitives.Latitude, 0.0001)
+			assert.InDelta(t, 4.5, primitives.Longitude, 0.0001)
+			assert.Equal(t, "Europe/Amsterdam", primitives.TimeZone)
+			return
+		}
+
+		t.Fatal("expected NLRTM among the bundled ports")
+	})
+
+	t.Run("should skip entries without port facilities, coordinates or time zone", func(t *testing.T) {
+		path := writeFile(t, "unlocode.csv", `,"BR",,".BRAZIL",,,,,,,,`+"\n"+
+			`,"BR","SSZ","Santos","Santos","SP","1-3-----","AI","0701",,"2356S 04620W",`+"\n"+
+			`,"NL","AMS","Amsterdam","Amsterdam","NH","12345---","AI","0601",,,`+"\n"+
+			`,"DE","FRA","Frankfurt am Main","Frankfurt am Main","HE","-2345---","AI","0601",,"5007N 00841E",`+"\n"+
+			`,"FJ","SUV","Suva","Suva","C","1--4----","AI","0601",,"1808S 17825E",`+"\n"+
+			`X,"NL","RTM","Rotterdam","Rotterdam","ZH","12345---","AI","0601",,"5155N 00430E",`+"\n")
+
+		ports, err := portinfra.LoadPortsFromFiles(path, timeZonesPath, zerolog.Nop())
+		require.NoError(t, err)
+		require.Len(t, ports, 1)
+		assert.Equal(t, "Santos", ports[0].Primitives().Name)
+		assert.InDelta(t, -23.9333, ports[0].Primitives().Latitude, 0.0001)
+		assert.InDelta(t, -46.3333, ports[0].Primitives().Longitude, 0.0001)
+	})
+
+	t.Run("should skip malformed entries", func(t *testing.T) {
+		path := writeFile(t, "unlocode.csv", `,"NL","RTM","Rotterdam","Rotterdam","ZH","12345---","AI","0601",,"5155X 00430E",`+"\n"+
+			`,"ES","VLC","Valencia","Valencia","V","1234----","AI","0601",,"3928N 00022W",`+"\n"+
+			`,"NL","AMS","Amsterdam"`+"\n"+
+			`,"BR","SSZ","Santos","Santos","SP","1-3-----","AI","0701",,"2356S 04620W",`+"\n")
+
+		ports, err := portinfra.LoadPortsFromFiles(path, timeZonesPath, zerolog.Nop())
+		require.NoError(t, err)
+		require.Len(t, ports, 1)
+		assert.Equal(t, "BRSSZ", ports[0].LOCODE().String())
+	})
+
+	t.Run("should keep the last entry of a duplicated locode", func(t *testing.T) {
+		path := writeFile(t, "unlocode.csv", `,"NL","RTM","Rotterdam","Rotterdam","ZH","12345---","AI","0601",,"5155N 00430E",`+"\n"+
+			`,"BR","SSZ","Santos","Santos","SP","1-3-----","AI","0701",,"2356S 04620W",`+"\n"+
+			`,"NL","RTM","Rotterdam Europoort","Rotterdam Europoort","ZH","12345---","AI","0601",,"5157N 00407E",`+"\n")
+
+		ports, err := portinfra.LoadPortsFromFiles(path, timeZonesPath, zerolog.Nop())
+		require.NoError(t, err)
+		require.Len(t, ports, 2)
+		assert.Equal(t, "NLRTM", ports[0].LOCODE().String())
+		assert.Equal(t, "Rotterdam Europoort", ports[0].Primitives().Name)
+		assert.InDelta(t, 51.95, ports[0].Primitives().Latitude, 0.0001)
+		assert.Equal(t, "BRSSZ", ports[1].LOCODE().String())
+	})
+
+	t.Run("should fall back to the name without diacritics when it isn't utf-8 encoded", func(t *testing.T) {
+		// The name is ISO 8859-1 encoded as in some of the official files.
+		path := writeFile(t, "unlocode.csv", ",\"SE\",\"GOT\",\"G\xf6teborg\",\"Goteborg\","+
+			"\"O\",\"1234----\",\"AI\",\"0601\",,\"5742N 01158E\",\n")
+
+		ports, err := portinfra.LoadPortsFromFiles(path, timeZonesPath, zerolog.Nop())
+		require.NoError(t, err)
+		require.Len(t, ports, 1)
+		assert.Equal(t, "Goteborg", ports[0].Primitives().Name)
+	})
+
+	t.Run("should fail when the file is empty", func(t *testing.T) {
+		path := writeFile(t, "unlocode.csv", "")
+
+		_, err := portinfra.LoadPortsFromFiles(path, timeZonesPath, zerolog.Nop())
+		require.ErrorIs(t, err, portinfra.ErrLoadingUNLOCODE)
+	})
+
+	t.Run("should fail when the time zones file lacks a column", func(t *testing.T) {
+		path := writeFile(t, "time_zones.csv", "locode\n")
+
+		_, err := portinfra.LoadPortsFromFiles("", path, zerolog.Nop())
+		require.ErrorIs(t, err, portinfra.ErrLoadingUNLOCODE)
+	})
+}
@@ -0,0 +1,29 @@
+package portpersistence
+
+import (
+	"database/sql"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+)
+
+var (
+	ErrScanningPortRow = errutil.NewError("error scanning port row")
+)
+
+func newPostgresPortDecoder() postgres.DecodeFunc[*portdomain.Port] {
+	return func(rows *sql.Rows) (*portdomain.Port, error) {
+		var primitives portdomain.PortPrimitives
+
+		err := rows.Scan(
+			&primitives.LOCODE, &primitives.Name, &primitives.Country,
+			&primitives.Latitude, &primitives.Longitude, &primitives.TimeZone,
+		)
+		if err != nil {
+			return nil, ErrScanningPortRow.Wrap(err)
+		}
+
+		return portdomain.NewPortFromPrimitives(primitives), nil
+	}
+}
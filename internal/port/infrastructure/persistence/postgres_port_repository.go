@@ -0,0 +1,207 @@
+package portpersistence
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+)
+
+// savePortsBatchSize keeps every insert statement far below the Postgres bind parameters limit.
+const savePortsBatchSize = 1000
+
+var (
+	_ portdomain.PortRepository = (*PostgresPortRepository)(nil)
+
+	ErrFetchingPortRows = errutil.NewError("error fetching port rows")
+	ErrRunningQuery     = errutil.NewError("error running port query")
+	ErrSavingPorts      = errutil.NewError("error saving ports to the database")
+)
+
+type PostgresPortRepository struct {
+	tableName string
+	pool      sqldb.ConnectionPool
+	decoder   postgres.DecodeFunc[*portdomain.Port]
+	fields    []string
+}
+
+func NewPostgresPortRepository(schema string, pool sqldb.ConnectionPool) *PostgresPortRepository {
+	return &PostgresPortRepository{
+		tableName: schema + "." + "ports",
+		pool:      pool,
+		decoder:   newPostgresPortDecoder(),
+		fields: []string{
+			"locode",
+			"name",
+			"country",
+			"latitude",
+			"longitude",
+			"time_zone",
+		},
+	}
+}
+
+func (r *PostgresPortRepository) Find(ctx context.Context, locode portdomain.LOCODE) (*portdomain.Port, error) {
+	ports, err := r.find(ctx, r.portSelectBuilder(1, sq.Eq{"locode": locode.String()}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ports) == 0 {
+		return nil, portdomain.NewPortNotExistsError(locode)
+	}
+
+	return ports[0], nil
+}
+
+func (r *PostgresPortRepository) Search(
+	ctx context.Context,
+	criteria *portdomain.PortSearchCriteria,
+) (portdomain.PortSearchResult, error) {
+	wheres := make([]sq.Sqlizer, 0)
+	if criteria.Term != nil {
+		// Backed by the primary key and the ports_idx_lower_name index.
+		prefix := escapeLikePattern(*criteria.Term) + "%"
+		wheres = append(wheres, sq.Or{
+			sq.Like{"locode": strings.ToUpper(prefix)},
+			sq.Like{"LOWER(name)": strings.ToLower(prefix)},
+		})
+	}
+
+	if criteria.Country != nil {
+		// Backed by the ports_idx_country index.
+		wheres = append(wheres, sq.Eq{"country": criteria.Country.String()})
+	}
+
+	if criteria.Cursor != nil {
+		wheres = append(wheres, sq.Gt{"locode": criteria.Cursor.String()})
+	}
+
+	// One extra row is requested to know whether there is a next page or not.
+	ports, err := r.find(ctx, r.portSelectBuilder(criteria.PageSize+1, wheres...))
+	if err != nil {
+		return portdomain.PortSearchResult{}, err
+	}
+
+	result := portdomain.PortSearchResult{Ports: ports, NextCursor: nil}
+	if uint64(len(ports)) > criteria.PageSize {
+		result.Ports = ports[:criteria.PageSize]
+		next := result.Ports[len(result.Ports)-1].LOCODE()
+		result.NextCursor = &next
+	}
+
+	return result, nil
+}
+
+func (r *PostgresPortRepository) ReplaceAll(ctx context.Context, ports ...*portdomain.Port) error {
+	tx, txErr := r.pool.Writer().BeginTx(ctx, nil)
+	if txErr != nil {
+		return ErrSavingPorts.Wrap(txErr)
+	}
+
+	if replaceErr := r.replaceAll(ctx, tx, ports); replaceErr != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return ErrSavingPorts.Wrap(rbErr)
+		}
+
+		return ErrSavingPorts.Wrap(replaceErr)
+	}
+
+	if commitErr := tx.Commit(); commitErr != nil {
+		return ErrSavingPorts.Wrap(commitErr)
+	}
+
+	return nil
+}
+
+func (r *PostgresPortRepository) replaceAll(ctx context.Context, tx *sql.Tx, ports []*portdomain.Port) error {
+	locodes := make([]string, len(ports))
+	for i, port := range ports {
+		locodes[i] = port.LOCODE().String()
+	}
+
+	deleteQuery := sq.Delete(r.tableName).
+		Where(sq.Expr("NOT (locode = ANY(?))", pq.Array(locodes))).
+		PlaceholderFormat(sq.Dollar)
+
+	if _, err := deleteQuery.RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(ports); start += savePortsBatchSize {
+		end := min(start+savePortsBatchSize, len(ports))
+
+		query := sq.Insert(r.tableName).
+			Columns(r.fields...).
+			Suffix("ON CONFLICT (locode) DO UPDATE SET " +
+				"name = EXCLUDED.name, " +
+				"country = EXCLUDED.country, " +
+				"latitude = EXCLUDED.latitude, " +
+				"longitude = EXCLUDED.longitude, " +
+				"time_zone = EXCLUDED.time_zone",
+			).PlaceholderFormat(sq.Dollar)
+
+		for _, port := range ports[start:end] {
+			primitives := port.Primitives()
+			query = query.Values(
+				primitives.LOCODE,
+				primitives.Name,
+				primitives.Country,
+				primitives.Latitude,
+				primitives.Longitude,
+				primitives.TimeZone,
+			)
+		}
+
+		if _, err := query.RunWith(tx).ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresPortRepository) find(ctx context.Context, query sq.SelectBuilder) ([]*portdomain.Port, error) {
+	rows, err := query.RunWith(r.pool.Reader()).QueryContext(ctx)
+	if err != nil {
+		return nil, ErrRunningQuery.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	ports := make([]*portdomain.Port, 0)
+	for rows.Next() {
+		port, decodeErr := r.decoder(rows)
+		if decodeErr != nil {
+			return nil, ErrFetchingPortRows.Wrap(decodeErr)
+		}
+		ports = append(ports, port)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, ErrFetchingPortRows.Wrap(rowsErr)
+	}
+
+	return ports, nil
+}
+
+func (r *PostgresPortRepository) portSelectBuilder(limit uint64, wheres ...sq.Sqlizer) sq.SelectBuilder {
+	qb := sq.Select(r.fields...).From(r.tableName).OrderBy("locode ASC").Limit(limit).PlaceholderFormat(sq.Dollar)
+
+	for _, where := range wheres {
+		qb = qb.Where(where)
+	}
+
+	return qb
+}
+
+// escapeLikePattern makes the wildcards of the given term match themselves.
+func escapeLikePattern(term string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term)
+}
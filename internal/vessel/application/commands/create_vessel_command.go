@@ -0,0 +1,65 @@
+package vesselcommands
+
+import (
+	"context"
+	"fmt"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type CreateVesselCommand struct {
+	ID        string
+	Name      string
+	Capacity  VesselCapacity
+	Latitude  float64
+	Longitude float64
+	// MMSI identifies the vessel AIS messages, nil when it's not known.
+	MMSI *string
+}
+
+func (c *CreateVesselCommand) Type() string {
+	return "create_vessel_command"
+}
+
+func (c *CreateVesselCommand) BlockingKey() string {
+	return "vessel_update:" + c.ID
+}
+
+type CreateVesselCommandHandler struct {
+	creator      *vesseldomain.VesselCreator
+	timeProvider utils.DateTimeProvider
+}
+
+func NewCreateVesselCommandHandler(
+	creator *vesseldomain.VesselCreator,
+	timeProvider utils.DateTimeProvider,
+) *CreateVesselCommandHandler {
+	return &CreateVesselCommandHandler{
+		creator:      creator,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *CreateVesselCommandHandler) Handle(ctx context.Context, cmd *CreateVesselCommand) (interface{}, error) {
+	capacity, err := cmd.Capacity.kilograms()
+	if err != nil {
+		return nil, fmt.Errorf("error creating vessel: %w", err)
+	}
+
+	input := vesseldomain.VesselCreateInput{
+		ID:        cmd.ID,
+		Name:      cmd.Name,
+		Capacity:  capacity,
+		Latitude:  cmd.Latitude,
+		Longitude: cmd.Longitude,
+		MMSI:      cmd.MMSI,
+		At:        h.timeProvider.Now(),
+	}
+
+	if _, err := h.creator.Create(ctx, input); err != nil {
+		return nil, fmt.Errorf("error creating vessel: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,54 @@
+package vesselcommands
+
+import (
+	"context"
+	"fmt"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type DeleteVesselCommand struct {
+	ID string
+	// ExpectedVersion is the version the vessel must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *DeleteVesselCommand) Type() string {
+	return "delete_vessel_command"
+}
+
+// BlockingKeys serializes the deletion with the vessel updates and the cargo creations
+// loading it, so no cargo is created on the vessel while it's being deleted.
+func (c *DeleteVesselCommand) BlockingKeys() []string {
+	return []string{"vessel_update:" + c.ID, "vessel_load:" + c.ID}
+}
+
+type DeleteVesselCommandHandler struct {
+	deleter      *vesseldomain.VesselDeleter
+	timeProvider utils.DateTimeProvider
+}
+
+func NewDeleteVesselCommandHandler(
+	deleter *vesseldomain.VesselDeleter,
+	timeProvider utils.DateTimeProvider,
+) *DeleteVesselCommandHandler {
+	return &DeleteVesselCommandHandler{
+		deleter:      deleter,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *DeleteVesselCommandHandler) Handle(ctx context.Context, cmd *DeleteVesselCommand) (interface{}, error) {
+	input := vesseldomain.VesselDeleteInput{
+		ID:              cmd.ID,
+		At:              h.timeProvider.Now(),
+		ExpectedVersion: cmd.ExpectedVersion,
+	}
+
+	if err := h.deleter.Delete(ctx, input); err != nil {
+		return nil, fmt.Errorf("error deleting vessel: %w", err)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,61 @@
+package vesselcommands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+// ReportVesselPositionCommand moves the vessel to the reported position and keeps it in its track.
+type ReportVesselPositionCommand struct {
+	ID        string
+	Latitude  float64
+	Longitude float64
+	// RecordedAt is the instant the position was recorded at, nil when it's reported live.
+	RecordedAt *time.Time
+}
+
+func (c *ReportVesselPositionCommand) Type() string {
+	return "report_vessel_position_command"
+}
+
+func (c *ReportVesselPositionCommand) BlockingKey() string {
+	return "vessel_update:" + c.ID
+}
+
+type ReportVesselPositionCommandHandler struct {
+	updater      *vesseldomain.VesselUpdater
+	timeProvider utils.DateTimeProvider
+	idProvider   utils.ULIDProvider
+}
+
+func NewReportVesselPositionCommandHandler(
+	updater *vesseldomain.VesselUpdater,
+	timeProvider utils.DateTimeProvider,
+	idProvider utils.ULIDProvider,
+) *ReportVesselPositionCommandHandler {
+	return &ReportVesselPositionCommandHandler{
+		updater:      updater,
+		timeProvider: timeProvider,
+		idProvider:   idProvider,
+	}
+}
+
+func (h *ReportVesselPositionCommandHandler) Handle(
+	ctx context.Context,
+	cmd *ReportVesselPositionCommand,
+) (interface{}, error) {
+	positionID, at := h.idProvider.New().String(), h.timeProvider.Now()
+	if cmd.RecordedAt != nil {
+		at = *cmd.RecordedAt
+	}
+
+	if err := h.updater.ReportPosition(ctx, cmd.ID, positionID, cmd.Latitude, cmd.Longitude, at); err != nil {
+		return nil, fmt.Errorf("error reporting vessel position: %w", err)
+	}
+
+	return struct{}{}, nil
+}
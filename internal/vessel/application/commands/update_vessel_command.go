@@ -0,0 +1,102 @@
+package vesselcommands
+
+import (
+	"context"
+	"fmt"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+var (
+	ErrNoVesselChangesProvided = errutil.NewError("no vessel changes provided")
+)
+
+// UpdateVesselCommand changes the given vessel attributes only, the location must be
+// given as a whole so both coordinates come together.
+type UpdateVesselCommand struct {
+	ID        string
+	Name      *string
+	Capacity  *VesselCapacity
+	Latitude  *float64
+	Longitude *float64
+	// MMSI is assigned to the vessel, an empty one unassigns it.
+	MMSI *string
+	// ExpectedVersion is the version the vessel must be on, nil when any version is fine.
+	ExpectedVersion *uint64
+}
+
+func (c *UpdateVesselCommand) Type() string {
+	return "update_vessel_command"
+}
+
+// BlockingKeys serializes the update with the cargoes loading the vessel, so its capacity
+// isn't lowered while a cargo is being loaded on it.
+func (c *UpdateVesselCommand) BlockingKeys() []string {
+	return []string{"vessel_update:" + c.ID, "vessel_load:" + c.ID}
+}
+
+type UpdateVesselCommandHandler struct {
+	updater      *vesseldomain.VesselUpdater
+	timeProvider utils.DateTimeProvider
+}
+
+func NewUpdateVesselCommandHandler(
+	updater *vesseldomain.VesselUpdater,
+	timeProvider utils.DateTimeProvider,
+) *UpdateVesselCommandHandler {
+	return &UpdateVesselCommandHandler{
+		updater:      updater,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *UpdateVesselCommandHandler) Handle(ctx context.Context, cmd *UpdateVesselCommand) (interface{}, error) {
+	updates, err := h.updates(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("error updating vessel: %w", err)
+	}
+
+	if err := h.updater.Update(ctx, cmd.ID, updates...); err != nil {
+		return nil, fmt.Errorf("error updating vessel: %w", err)
+	}
+
+	return struct{}{}, nil
+}
+
+func (h *UpdateVesselCommandHandler) updates(cmd *UpdateVesselCommand) ([]vesseldomain.VesselUpdateOpt, error) {
+	at := h.timeProvider.Now()
+	updates := []vesseldomain.VesselUpdateOpt{vesseldomain.WithExpectedVersion(cmd.ExpectedVersion)}
+
+	if cmd.Name != nil {
+		updates = append(updates, vesseldomain.WithName(*cmd.Name, at))
+	}
+
+	if cmd.Capacity != nil {
+		capacity, err := cmd.Capacity.kilograms()
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, vesseldomain.WithCapacity(capacity, at))
+	}
+
+	if (cmd.Latitude == nil) != (cmd.Longitude == nil) {
+		return nil, vesseldomain.ErrInvalidLocationProvided
+	}
+
+	if cmd.Latitude != nil {
+		updates = append(updates, vesseldomain.WithLocation(*cmd.Latitude, *cmd.Longitude, at))
+	}
+
+	if cmd.MMSI != nil {
+		updates = append(updates, vesseldomain.WithMMSI(*cmd.MMSI, at))
+	}
+
+	if len(updates) == 1 {
+		return nil, ErrNoVesselChangesProvided
+	}
+
+	return updates, nil
+}
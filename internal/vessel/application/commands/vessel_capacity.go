@@ -0,0 +1,32 @@
+package vesselcommands
+
+import (
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
+)
+
+type VesselCapacity struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// kilograms normalizes the capacity to the whole kilograms the domain expects, it's
+// expressed in kilograms unless another unit is given.
+func (c VesselCapacity) kilograms() (uint64, error) {
+	unit, err := domainweight.NewUnitOrDefault(c.Unit, domainweight.UnitKilogram)
+	if err != nil {
+		return 0, vesseldomain.ErrInvalidCapacityProvided.Wrap(err)
+	}
+
+	weight, err := domainweight.NewWeight(c.Value, unit.String())
+	if err != nil {
+		return 0, vesseldomain.ErrInvalidCapacityProvided.Wrap(err)
+	}
+
+	gramsPerKilogram := domainweight.FromKilograms(1).Grams()
+	if weight.Grams()%gramsPerKilogram != 0 {
+		return 0, vesseldomain.ErrInvalidCapacityProvided
+	}
+
+	return weight.Grams() / gramsPerKilogram, nil
+}
@@ -0,0 +1,40 @@
+package vesselqueries
+
+import (
+	"context"
+	"fmt"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+)
+
+type FetchVesselByMMSIQuery struct {
+	MMSI string
+}
+
+func (q *FetchVesselByMMSIQuery) Type() string {
+	return "fetch_vessel_by_mmsi_query"
+}
+
+type FetchVesselByMMSIQueryHandler struct {
+	repository vesseldomain.VesselRepository
+}
+
+func NewFetchVesselByMMSIQueryHandler(repository vesseldomain.VesselRepository) *FetchVesselByMMSIQueryHandler {
+	return &FetchVesselByMMSIQueryHandler{
+		repository: repository,
+	}
+}
+
+func (h *FetchVesselByMMSIQueryHandler) Handle(ctx context.Context, q *FetchVesselByMMSIQuery) (VesselResponse, error) {
+	mmsi, err := vesseldomain.NewMMSI(q.MMSI)
+	if err != nil {
+		return VesselResponse{}, fmt.Errorf("invalid vessel mmsi: %w", err)
+	}
+
+	vessel, err := h.repository.FindByMMSI(ctx, mmsi)
+	if err != nil {
+		return VesselResponse{}, fmt.Errorf("error fetching vessel: %w", err)
+	}
+
+	return NewVesselResponse(vessel.Primitives()), nil
+}
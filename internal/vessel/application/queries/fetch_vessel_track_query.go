@@ -0,0 +1,67 @@
+package vesselqueries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+type FetchVesselTrackQuery struct {
+	ID             string
+	RecordedAtFrom *time.Time
+	RecordedAtTo   *time.Time
+	Points         uint64
+}
+
+func (q *FetchVesselTrackQuery) Type() string {
+	return "fetch_vessel_track_query"
+}
+
+type FetchVesselTrackQueryHandler struct {
+	repository   vesseldomain.VesselRepository
+	reader       vesseldomain.VesselTrackReader
+	timeProvider utils.DateTimeProvider
+}
+
+func NewFetchVesselTrackQueryHandler(
+	repository vesseldomain.VesselRepository,
+	reader vesseldomain.VesselTrackReader,
+	timeProvider utils.DateTimeProvider,
+) *FetchVesselTrackQueryHandler {
+	return &FetchVesselTrackQueryHandler{
+		repository:   repository,
+		reader:       reader,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *FetchVesselTrackQueryHandler) Handle(ctx context.Context, q *FetchVesselTrackQuery) (VesselTrackResponse, error) {
+	vesselID, err := vesseldomain.NewVesselID(q.ID)
+	if err != nil {
+		return VesselTrackResponse{}, fmt.Errorf("invalid vessel id: %w", err)
+	}
+
+	criteria, err := vesselpositiondomain.NewTrackCriteria(
+		vesselpositiondomain.WithRecordedAtRange(q.RecordedAtFrom, q.RecordedAtTo, h.timeProvider.Now()),
+		vesselpositiondomain.WithPoints(q.Points),
+	)
+	if err != nil {
+		return VesselTrackResponse{}, fmt.Errorf("invalid track criteria: %w", err)
+	}
+
+	// The track of deleted or unknown vessels is not exposed.
+	if _, err := h.repository.Find(ctx, vesselID); err != nil {
+		return VesselTrackResponse{}, fmt.Errorf("error fetching vessel: %w", err)
+	}
+
+	track, err := h.reader.FindTrack(ctx, vesselID, criteria)
+	if err != nil {
+		return VesselTrackResponse{}, fmt.Errorf("error fetching vessel track: %w", err)
+	}
+
+	return NewVesselTrackResponse(vesselID, track.Downsample(criteria.Points)), nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
 )
 
 type VesselResponse struct {
@@ -12,8 +13,10 @@ type VesselResponse struct {
 	Capacity  uint64
 	Latitude  float64
 	Longitude float64
+	MMSI      *string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Version   uint64
 }
 
 func NewVesselResponse(p vesseldomain.VesselPrimitives) VesselResponse {
@@ -23,7 +26,57 @@ func NewVesselResponse(p vesseldomain.VesselPrimitives) VesselResponse {
 		Capacity:  p.Capacity,
 		Latitude:  p.Latitude,
 		Longitude: p.Longitude,
+		MMSI:      p.MMSI,
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
+		Version:   p.Version,
 	}
 }
+
+type VesselTrackResponse struct {
+	VesselID  string
+	Positions []VesselPositionResponse
+}
+
+type VesselPositionResponse struct {
+	ID         string
+	Latitude   float64
+	Longitude  float64
+	RecordedAt time.Time
+}
+
+func NewVesselTrackResponse(id vesseldomain.VesselID, track vesselpositiondomain.Track) VesselTrackResponse {
+	positions := make([]VesselPositionResponse, len(track))
+	for i, p := range vesselpositiondomain.NewTrackPrimitives(id.String(), track) {
+		positions[i] = VesselPositionResponse{
+			ID:         p.ID,
+			Latitude:   p.Latitude,
+			Longitude:  p.Longitude,
+			RecordedAt: p.RecordedAt,
+		}
+	}
+
+	return VesselTrackResponse{VesselID: id.String(), Positions: positions}
+}
+
+type VesselsResponse struct {
+	Items []VesselDistanceResponse
+}
+
+// VesselDistanceResponse is a vessel along with its distance in kilometers to the search origin.
+type VesselDistanceResponse struct {
+	Vessel     VesselResponse
+	DistanceKm float64
+}
+
+func NewVesselsResponse(origin vesseldomain.Location, vessels []*vesseldomain.Vessel) VesselsResponse {
+	items := make([]VesselDistanceResponse, len(vessels))
+	for i, v := range vessels {
+		items[i] = VesselDistanceResponse{
+			Vessel:     NewVesselResponse(v.Primitives()),
+			DistanceKm: origin.DistanceTo(v.Location()),
+		}
+	}
+
+	return VesselsResponse{Items: items}
+}
@@ -0,0 +1,46 @@
+package vesselqueries
+
+import (
+	"context"
+	"fmt"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+)
+
+type SearchVesselsQuery struct {
+	Near        string
+	BoundingBox string
+	PageSize    uint64
+}
+
+func (q *SearchVesselsQuery) Type() string {
+	return "search_vessels_query"
+}
+
+type SearchVesselsQueryHandler struct {
+	repository vesseldomain.VesselRepository
+}
+
+func NewSearchVesselsQueryHandler(repository vesseldomain.VesselRepository) *SearchVesselsQueryHandler {
+	return &SearchVesselsQueryHandler{
+		repository: repository,
+	}
+}
+
+func (h *SearchVesselsQueryHandler) Handle(ctx context.Context, q *SearchVesselsQuery) (VesselsResponse, error) {
+	criteria, err := vesseldomain.NewVesselSearchCriteria(
+		vesseldomain.WithNearFilter(q.Near),
+		vesseldomain.WithBoundingBoxFilter(q.BoundingBox),
+		vesseldomain.WithPageSize(q.PageSize),
+	)
+	if err != nil {
+		return VesselsResponse{}, fmt.Errorf("invalid search criteria: %w", err)
+	}
+
+	vessels, err := h.repository.Search(ctx, criteria)
+	if err != nil {
+		return VesselsResponse{}, fmt.Errorf("error searching vessels: %w", err)
+	}
+
+	return NewVesselsResponse(criteria.Origin(), vessels), nil
+}
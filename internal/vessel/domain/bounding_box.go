@@ -0,0 +1,127 @@
+package vesseldomain
+
+import (
+	"math"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+var (
+	ErrInvalidBoundingBoxProvided = domainvalidation.NewError("invalid bounding box provided")
+)
+
+// BoundingBox is the area between two parallels and two meridians. A box whose west longitude
+// is greater than its east one crosses the antimeridian.
+type BoundingBox struct {
+	south float64
+	west  float64
+	north float64
+	east  float64
+}
+
+func NewBoundingBox(south, west, north, east float64) (BoundingBox, error) {
+	if _, err := NewLocation(south, west); err != nil {
+		return BoundingBox{}, ErrInvalidBoundingBoxProvided.Wrap(err)
+	}
+
+	if _, err := NewLocation(north, east); err != nil {
+		return BoundingBox{}, ErrInvalidBoundingBoxProvided.Wrap(err)
+	}
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.Max(north),
+	)
+
+	if err := validator.Validate(south); err != nil {
+		return BoundingBox{}, ErrInvalidBoundingBoxProvided.Wrap(err)
+	}
+
+	return BoundingBox{south: south, west: west, north: north, east: east}, nil
+}
+
+// NewBoundingBoxAround returns the smallest box holding every location within the given radius of the center,
+// spanning every meridian when the radius reaches one of the poles.
+func NewBoundingBoxAround(center Location, radiusKm float64) BoundingBox {
+	angularRadius := radiusKm / EarthRadiusKm
+	latDelta := radiansToDegrees(angularRadius)
+
+	south, north := center.latitude-latDelta, center.latitude+latDelta
+	if south <= minMaxLatitude[0] || north >= minMaxLatitude[1] {
+		return BoundingBox{
+			south: math.Max(south, minMaxLatitude[0]),
+			west:  minMaxLongitude[0],
+			north: math.Min(north, minMaxLatitude[1]),
+			east:  minMaxLongitude[1],
+		}
+	}
+
+	lonDelta := radiansToDegrees(math.Asin(math.Sin(angularRadius) / math.Cos(degreesToRadians(center.latitude))))
+	if math.IsNaN(lonDelta) || lonDelta >= minMaxLongitude[1] {
+		return BoundingBox{south: south, west: minMaxLongitude[0], north: north, east: minMaxLongitude[1]}
+	}
+
+	return BoundingBox{
+		south: south,
+		west:  normalizeLongitude(center.longitude - lonDelta),
+		north: north,
+		east:  normalizeLongitude(center.longitude + lonDelta),
+	}
+}
+
+func (b BoundingBox) South() float64 {
+	return b.south
+}
+
+func (b BoundingBox) West() float64 {
+	return b.west
+}
+
+func (b BoundingBox) North() float64 {
+	return b.north
+}
+
+func (b BoundingBox) East() float64 {
+	return b.east
+}
+
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.west > b.east
+}
+
+func (b BoundingBox) Center() Location {
+	width := b.east - b.west
+	if b.CrossesAntimeridian() {
+		width += 2 * minMaxLongitude[1]
+	}
+
+	return Location{
+		latitude:  (b.south + b.north) / 2, //nolint:mnd // midpoint
+		longitude: normalizeLongitude(b.west + width/2),
+	}
+}
+
+func (b BoundingBox) Contains(l Location) bool {
+	if l.latitude < b.south || l.latitude > b.north {
+		return false
+	}
+
+	if b.CrossesAntimeridian() {
+		return l.longitude >= b.west || l.longitude <= b.east
+	}
+
+	return l.longitude >= b.west && l.longitude <= b.east
+}
+
+// normalizeLongitude wraps the given longitude back into [-180, 180].
+func normalizeLongitude(longitude float64) float64 {
+	fullTurn := 2 * minMaxLongitude[1]
+
+	switch {
+	case longitude > minMaxLongitude[1]:
+		return longitude - fullTurn
+	case longitude < minMaxLongitude[0]:
+		return longitude + fullTurn
+	default:
+		return longitude
+	}
+}
@@ -2,6 +2,7 @@ package vesseldomain
 
 import (
 	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
 )
 
@@ -31,3 +32,8 @@ func NewCapacity(capacity uint64) (Capacity, error) {
 func (c Capacity) Value() uint64 {
 	return uint64(c)
 }
+
+// Grams converts the capacity, kept in kilograms, to grams as the cargo weights are.
+func (c Capacity) Grams() uint64 {
+	return domainweight.FromKilograms(c.Value()).Grams()
+}
@@ -1,9 +1,14 @@
 package vesseldomain
 
 import (
+	"math"
+
 	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
 )
 
+// EarthRadiusKm is the mean Earth radius, the one haversine distances are computed with.
+const EarthRadiusKm = 6371.0088
+
 var (
 	minMaxLatitude  = [2]float64{-90, 90}
 	minMaxLongitude = [2]float64{-180, 180}
@@ -37,3 +42,30 @@ func NewLocation(latitude, longitude float64) (Location, error) {
 
 	return c, nil
 }
+
+func (l Location) Latitude() float64 {
+	return l.latitude
+}
+
+func (l Location) Longitude() float64 {
+	return l.longitude
+}
+
+// DistanceTo returns the great-circle distance in kilometers to the given location using the haversine formula.
+func (l Location) DistanceTo(other Location) float64 {
+	latDelta := degreesToRadians(other.latitude - l.latitude)
+	lonDelta := degreesToRadians(other.longitude - l.longitude)
+
+	a := math.Pow(math.Sin(latDelta/2), 2) +
+		math.Cos(degreesToRadians(l.latitude))*math.Cos(degreesToRadians(other.latitude))*math.Pow(math.Sin(lonDelta/2), 2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180 //nolint:mnd // half a turn in degrees
+}
+
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi //nolint:mnd // half a turn in degrees
+}
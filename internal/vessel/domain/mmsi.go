@@ -0,0 +1,33 @@
+package vesseldomain
+
+import (
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrInvalidMMSIProvided = errutil.NewError("invalid mmsi provided")
+	ErrMMSIAlreadyAssigned = domain.NewError("mmsi already assigned to another vessel")
+)
+
+// MMSI is the Maritime Mobile Service Identity the vessel broadcasts its AIS messages with.
+type MMSI string
+
+func NewMMSI(mmsi string) (MMSI, error) {
+	vesselMMSI := MMSI(mmsi)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.Regex(`^[0-9]{9}$`),
+	)
+
+	if err := validation.Validate(mmsi); err != nil {
+		return "", ErrInvalidMMSIProvided.Wrap(err)
+	}
+
+	return vesselMMSI, nil
+}
+
+func (m MMSI) String() string {
+	return string(m)
+}
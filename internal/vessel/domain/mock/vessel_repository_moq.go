@@ -22,9 +22,18 @@ var _ vesseldomain.VesselRepository = &VesselRepositoryMock{}
 //			FindFunc: func(ctx context.Context, id vesseldomain.VesselID) (*vesseldomain.Vessel, error) {
 //				panic("mock out the Find method")
 //			},
+//			FindByMMSIFunc: func(ctx context.Context, mmsi vesseldomain.MMSI) (*vesseldomain.Vessel, error) {
+//				panic("mock out the FindByMMSI method")
+//			},
 //			SaveFunc: func(ctx context.Context, v *vesseldomain.Vessel) error {
 //				panic("mock out the Save method")
 //			},
+//			SavePositionFunc: func(ctx context.Context, v *vesseldomain.Vessel) error {
+//				panic("mock out the SavePosition method")
+//			},
+//			SearchFunc: func(ctx context.Context, criteria *vesseldomain.VesselSearchCriteria) ([]*vesseldomain.Vessel, error) {
+//				panic("mock out the Search method")
+//			},
 //		}
 //
 //		// use mockedVesselRepository in code that requires vesseldomain.VesselRepository
@@ -35,9 +44,18 @@ type VesselRepositoryMock struct {
 	// FindFunc mocks the Find method.
 	FindFunc func(ctx context.Context, id vesseldomain.VesselID) (*vesseldomain.Vessel, error)
 
+	// FindByMMSIFunc mocks the FindByMMSI method.
+	FindByMMSIFunc func(ctx context.Context, mmsi vesseldomain.MMSI) (*vesseldomain.Vessel, error)
+
 	// SaveFunc mocks the Save method.
 	SaveFunc func(ctx context.Context, v *vesseldomain.Vessel) error
 
+	// SavePositionFunc mocks the SavePosition method.
+	SavePositionFunc func(ctx context.Context, v *vesseldomain.Vessel) error
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(ctx context.Context, criteria *vesseldomain.VesselSearchCriteria) ([]*vesseldomain.Vessel, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Find holds details about calls to the Find method.
@@ -47,6 +65,13 @@ type VesselRepositoryMock struct {
 			// ID is the id argument value.
 			ID vesseldomain.VesselID
 		}
+		// FindByMMSI holds details about calls to the FindByMMSI method.
+		FindByMMSI []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Mmsi is the mmsi argument value.
+			Mmsi vesseldomain.MMSI
+		}
 		// Save holds details about calls to the Save method.
 		Save []struct {
 			// Ctx is the ctx argument value.
@@ -54,9 +79,26 @@ type VesselRepositoryMock struct {
 			// V is the v argument value.
 			V *vesseldomain.Vessel
 		}
+		// SavePosition holds details about calls to the SavePosition method.
+		SavePosition []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// V is the v argument value.
+			V *vesseldomain.Vessel
+		}
+		// Search holds details about calls to the Search method.
+		Search []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Criteria is the criteria argument value.
+			Criteria *vesseldomain.VesselSearchCriteria
+		}
 	}
-	lockFind sync.RWMutex
-	lockSave sync.RWMutex
+	lockFind         sync.RWMutex
+	lockFindByMMSI   sync.RWMutex
+	lockSave         sync.RWMutex
+	lockSavePosition sync.RWMutex
+	lockSearch       sync.RWMutex
 }
 
 // Find calls FindFunc.
@@ -95,6 +137,42 @@ func (mock *VesselRepositoryMock) FindCalls() []struct {
 	return calls
 }
 
+// FindByMMSI calls FindByMMSIFunc.
+func (mock *VesselRepositoryMock) FindByMMSI(ctx context.Context, mmsi vesseldomain.MMSI) (*vesseldomain.Vessel, error) {
+	if mock.FindByMMSIFunc == nil {
+		panic("VesselRepositoryMock.FindByMMSIFunc: method is nil but VesselRepository.FindByMMSI was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Mmsi vesseldomain.MMSI
+	}{
+		Ctx:  ctx,
+		Mmsi: mmsi,
+	}
+	mock.lockFindByMMSI.Lock()
+	mock.calls.FindByMMSI = append(mock.calls.FindByMMSI, callInfo)
+	mock.lockFindByMMSI.Unlock()
+	return mock.FindByMMSIFunc(ctx, mmsi)
+}
+
+// FindByMMSICalls gets all the calls that were made to FindByMMSI.
+// Check the length with:
+//
+//	len(mockedVesselRepository.FindByMMSICalls())
+func (mock *VesselRepositoryMock) FindByMMSICalls() []struct {
+	Ctx  context.Context
+	Mmsi vesseldomain.MMSI
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Mmsi vesseldomain.MMSI
+	}
+	mock.lockFindByMMSI.RLock()
+	calls = mock.calls.FindByMMSI
+	mock.lockFindByMMSI.RUnlock()
+	return calls
+}
+
 // Save calls SaveFunc.
 func (mock *VesselRepositoryMock) Save(ctx context.Context, v *vesseldomain.Vessel) error {
 	if mock.SaveFunc == nil {
@@ -130,3 +208,75 @@ func (mock *VesselRepositoryMock) SaveCalls() []struct {
 	mock.lockSave.RUnlock()
 	return calls
 }
+
+// SavePosition calls SavePositionFunc.
+func (mock *VesselRepositoryMock) SavePosition(ctx context.Context, v *vesseldomain.Vessel) error {
+	if mock.SavePositionFunc == nil {
+		panic("VesselRepositoryMock.SavePositionFunc: method is nil but VesselRepository.SavePosition was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		V   *vesseldomain.Vessel
+	}{
+		Ctx: ctx,
+		V:   v,
+	}
+	mock.lockSavePosition.Lock()
+	mock.calls.SavePosition = append(mock.calls.SavePosition, callInfo)
+	mock.lockSavePosition.Unlock()
+	return mock.SavePositionFunc(ctx, v)
+}
+
+// SavePositionCalls gets all the calls that were made to SavePosition.
+// Check the length with:
+//
+//	len(mockedVesselRepository.SavePositionCalls())
+func (mock *VesselRepositoryMock) SavePositionCalls() []struct {
+	Ctx context.Context
+	V   *vesseldomain.Vessel
+} {
+	var calls []struct {
+		Ctx context.Context
+		V   *vesseldomain.Vessel
+	}
+	mock.lockSavePosition.RLock()
+	calls = mock.calls.SavePosition
+	mock.lockSavePosition.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *VesselRepositoryMock) Search(ctx context.Context, criteria *vesseldomain.VesselSearchCriteria) ([]*vesseldomain.Vessel, error) {
+	if mock.SearchFunc == nil {
+		panic("VesselRepositoryMock.SearchFunc: method is nil but VesselRepository.Search was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Criteria *vesseldomain.VesselSearchCriteria
+	}{
+		Ctx:      ctx,
+		Criteria: criteria,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(ctx, criteria)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+// Check the length with:
+//
+//	len(mockedVesselRepository.SearchCalls())
+func (mock *VesselRepositoryMock) SearchCalls() []struct {
+	Ctx      context.Context
+	Criteria *vesseldomain.VesselSearchCriteria
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Criteria *vesseldomain.VesselSearchCriteria
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
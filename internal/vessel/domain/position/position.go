@@ -0,0 +1,62 @@
+package vesselpositiondomain
+
+import (
+	"time"
+)
+
+// Track holds the positions reported by a vessel in chronological order.
+type Track []Position
+
+func NewEmptyTrack() Track {
+	return make(Track, 0)
+}
+
+// Downsample returns up to the given number of positions evenly spread along the track,
+// always keeping the first and the last ones. Zero points means no downsampling at all.
+func (t Track) Downsample(points uint64) Track {
+	if points == 0 || uint64(len(t)) <= points {
+		return t
+	}
+
+	if points == 1 {
+		return Track{t[len(t)-1]}
+	}
+
+	last := uint64(len(t) - 1)
+	sampled := make(Track, points)
+	for i := range points {
+		sampled[i] = t[(i*last+(points-1)/2)/(points-1)]
+	}
+
+	return sampled
+}
+
+// Position is a location reported by a vessel at a given instant.
+type Position struct {
+	id         PositionID
+	latitude   float64
+	longitude  float64
+	recordedAt time.Time
+}
+
+// NewPosition expects coordinates already validated as a vessel location.
+func NewPosition(id PositionID, latitude, longitude float64, recordedAt time.Time) Position {
+	return Position{
+		id:         id,
+		latitude:   latitude,
+		longitude:  longitude,
+		recordedAt: recordedAt,
+	}
+}
+
+func NewPositionFromPrimitives(p PositionPrimitives) Position {
+	return NewPosition(PositionID(p.ID), p.Latitude, p.Longitude, p.RecordedAt)
+}
+
+func (p Position) ID() PositionID {
+	return p.id
+}
+
+func (p Position) RecordedAt() time.Time {
+	return p.recordedAt
+}
@@ -0,0 +1,31 @@
+package vesselpositiondomain
+
+import (
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrInvalidPositionIDProvided = errutil.NewError("invalid position id provided")
+)
+
+type PositionID string
+
+func NewPositionID(id string) (PositionID, error) {
+	positionID := PositionID(id)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+		domainvalidation.ULIDIdentifier(),
+	)
+
+	if err := validation.Validate(id); err != nil {
+		return "", ErrInvalidPositionIDProvided.Wrap(err)
+	}
+
+	return positionID, nil
+}
+
+func (p PositionID) String() string {
+	return string(p)
+}
@@ -0,0 +1,121 @@
+package vesselpositiondomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+func TestTrack_Downsample(t *testing.T) {
+	start := time.Now()
+
+	track := vesselpositiondomain.NewEmptyTrack()
+	for i := range 10 {
+		id := vesselpositiondomain.PositionID(utils.NewULID().String())
+		track = append(track, vesselpositiondomain.NewPosition(id, float64(i), float64(i), start.Add(time.Duration(i)*time.Minute)))
+	}
+
+	tests := []struct {
+		name            string
+		points          uint64
+		expectedIndexes []int
+	}{
+		{name: "should keep the whole track when no points are given", points: 0, expectedIndexes: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{name: "should keep the whole track when it's short enough", points: 20, expectedIndexes: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{name: "should keep the first and last positions only", points: 2, expectedIndexes: []int{0, 9}},
+		{name: "should spread the positions along the track", points: 4, expectedIndexes: []int{0, 3, 6, 9}},
+		{name: "should spread the positions rounding to the nearest one", points: 5, expectedIndexes: []int{0, 2, 5, 7, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampled := track.Downsample(tt.points)
+
+			require.Len(t, sampled, len(tt.expectedIndexes))
+			for i, index := range tt.expectedIndexes {
+				assert.Equal(t, track[index].ID(), sampled[i].ID())
+			}
+		})
+	}
+}
+
+func TestNewTrackCriteria(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		opts          []vesselpositiondomain.TrackCriteriaOpt
+		assertion     func(t *testing.T, criteria *vesselpositiondomain.TrackCriteria)
+		expectedError bool
+	}{
+		{
+			name: "should build criteria on the default window without downsampling",
+			opts: []vesselpositiondomain.TrackCriteriaOpt{vesselpositiondomain.WithRecordedAtRange(nil, nil, now)},
+			assertion: func(t *testing.T, criteria *vesselpositiondomain.TrackCriteria) {
+				require.NotNil(t, criteria.RecordedAtFrom)
+				assert.Equal(t, now.Add(-vesselpositiondomain.DefaultTrackWindow), *criteria.RecordedAtFrom)
+				assert.Equal(t, &now, criteria.RecordedAtTo)
+				assert.Zero(t, criteria.Points)
+			},
+		},
+		{
+			name: "should build criteria on the default window ending at the given end",
+			opts: []vesselpositiondomain.TrackCriteriaOpt{vesselpositiondomain.WithRecordedAtRange(nil, &before, now)},
+			assertion: func(t *testing.T, criteria *vesselpositiondomain.TrackCriteria) {
+				require.NotNil(t, criteria.RecordedAtFrom)
+				assert.Equal(t, before.Add(-vesselpositiondomain.DefaultTrackWindow), *criteria.RecordedAtFrom)
+				assert.Equal(t, &before, criteria.RecordedAtTo)
+			},
+		},
+		{
+			name: "should build criteria with every option provided",
+			opts: []vesselpositiondomain.TrackCriteriaOpt{
+				vesselpositiondomain.WithRecordedAtRange(&before, &now, now),
+				vesselpositiondomain.WithPoints(100),
+			},
+			assertion: func(t *testing.T, criteria *vesselpositiondomain.TrackCriteria) {
+				assert.Equal(t, &before, criteria.RecordedAtFrom)
+				assert.Equal(t, &now, criteria.RecordedAtTo)
+				assert.Equal(t, uint64(100), criteria.Points)
+			},
+		},
+		{
+			name:          "should fail when range is not provided",
+			expectedError: true,
+		},
+		{
+			name:          "should fail when range is reversed",
+			opts:          []vesselpositiondomain.TrackCriteriaOpt{vesselpositiondomain.WithRecordedAtRange(&now, &before, now)},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when a single point is requested",
+			opts:          []vesselpositiondomain.TrackCriteriaOpt{vesselpositiondomain.WithPoints(1)},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when too many points are requested",
+			opts:          []vesselpositiondomain.TrackCriteriaOpt{vesselpositiondomain.WithPoints(vesselpositiondomain.MaxTrackPoints + 1)},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := vesselpositiondomain.NewTrackCriteria(tt.opts...)
+			if tt.expectedError {
+				require.ErrorIs(t, err, vesselpositiondomain.ErrInvalidTrackCriteria)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.assertion(t, criteria)
+		})
+	}
+}
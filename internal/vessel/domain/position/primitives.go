@@ -0,0 +1,32 @@
+package vesselpositiondomain
+
+import (
+	"time"
+)
+
+type PositionPrimitives struct {
+	ID         string
+	VesselID   string
+	Latitude   float64
+	Longitude  float64
+	RecordedAt time.Time
+}
+
+func NewTrackPrimitives(vesselID string, track Track) []PositionPrimitives {
+	primitives := make([]PositionPrimitives, len(track))
+	for i, position := range track {
+		primitives[i] = NewPositionPrimitives(vesselID, position)
+	}
+
+	return primitives
+}
+
+func NewPositionPrimitives(vesselID string, p Position) PositionPrimitives {
+	return PositionPrimitives{
+		ID:         p.id.String(),
+		VesselID:   vesselID,
+		Latitude:   p.latitude,
+		Longitude:  p.longitude,
+		RecordedAt: p.recordedAt,
+	}
+}
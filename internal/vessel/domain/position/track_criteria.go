@@ -0,0 +1,88 @@
+package vesselpositiondomain
+
+import (
+	"time"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	MinTrackPoints uint64 = 2
+	MaxTrackPoints uint64 = 5000
+
+	// DefaultTrackWindow is the range read when the track is requested without a start.
+	DefaultTrackWindow = 24 * time.Hour
+)
+
+var (
+	ErrInvalidTrackCriteria = domainvalidation.NewError("invalid track criteria provided")
+	ErrTrackTooLong         = domainvalidation.NewError("track has too many positions, downsample it with points")
+)
+
+type TrackCriteriaOpt func(*TrackCriteria) error
+
+// TrackCriteria narrows the track of a vessel to the positions recorded within the given
+// range, downsampled to the given number of points unless it's zero. The range is always
+// bounded so a track is never read whole.
+type TrackCriteria struct {
+	RecordedAtFrom *time.Time
+	RecordedAtTo   *time.Time
+	Points         uint64
+}
+
+func NewTrackCriteria(opts ...TrackCriteriaOpt) (*TrackCriteria, error) {
+	criteria := &TrackCriteria{RecordedAtFrom: nil, RecordedAtTo: nil, Points: 0}
+	for _, opt := range opts {
+		if err := opt(criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	if criteria.RecordedAtFrom == nil || criteria.RecordedAtTo == nil {
+		return nil, ErrInvalidTrackCriteria
+	}
+
+	if criteria.RecordedAtFrom.After(*criteria.RecordedAtTo) {
+		return nil, ErrInvalidTrackCriteria
+	}
+
+	return criteria, nil
+}
+
+// WithRecordedAtRange bounds the track to the given range, which ends now unless told otherwise
+// and spans the DefaultTrackWindow when it has no start.
+func WithRecordedAtRange(from, to *time.Time, now time.Time) TrackCriteriaOpt {
+	return func(c *TrackCriteria) error {
+		if to == nil {
+			to = &now
+		}
+
+		if from == nil {
+			start := to.Add(-DefaultTrackWindow)
+			from = &start
+		}
+
+		c.RecordedAtFrom = from
+		c.RecordedAtTo = to
+		return nil
+	}
+}
+
+func WithPoints(points uint64) TrackCriteriaOpt {
+	return func(c *TrackCriteria) error {
+		if points == 0 {
+			return nil
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.WithinBounds(MinTrackPoints, MaxTrackPoints),
+		)
+
+		if err := validator.Validate(points); err != nil {
+			return ErrInvalidTrackCriteria.Wrap(err)
+		}
+
+		c.Points = points
+		return nil
+	}
+}
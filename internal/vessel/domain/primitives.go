@@ -10,20 +10,30 @@ type VesselPrimitives struct {
 	Capacity  uint64
 	Latitude  float64
 	Longitude float64
+	MMSI      *string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
+	Version   uint64
 }
 
 func newVesselPrimitives(v *Vessel) VesselPrimitives {
+	var mmsi *string
+	if v.mmsi != nil {
+		vesselMMSI := v.mmsi.String()
+		mmsi = &vesselMMSI
+	}
+
 	return VesselPrimitives{
 		ID:        v.id.String(),
 		Name:      v.name.String(),
 		Capacity:  v.capacity.Value(),
 		Latitude:  v.location.latitude,
 		Longitude: v.location.longitude,
+		MMSI:      mmsi,
 		CreatedAt: v.createdAt,
 		UpdatedAt: v.updatedAt,
 		DeletedAt: v.deletedAt,
+		Version:   v.version,
 	}
 }
@@ -2,14 +2,23 @@ package vesseldomain
 
 import (
 	"context"
+
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
 )
 
 type VesselRepositoryReader interface {
 	Find(ctx context.Context, id VesselID) (*Vessel, error)
+	FindByMMSI(ctx context.Context, mmsi MMSI) (*Vessel, error)
+	// Search returns the vessels matching the criteria, the closest to the criteria origin first.
+	Search(ctx context.Context, criteria *VesselSearchCriteria) ([]*Vessel, error)
 }
 
 type VesselRepositoryWriter interface {
 	Save(ctx context.Context, v *Vessel) error
+	// SavePosition stores the positions reported by the vessel, moving it to its current location unless
+	// a later position was stored already. Its version is left untouched so a position report doesn't
+	// outdate the version clients hold.
+	SavePosition(ctx context.Context, v *Vessel) error
 }
 
 //go:generate moq -pkg vesseldomainmock -out mock/vessel_repository_moq.go . VesselRepository
@@ -17,3 +26,13 @@ type VesselRepository interface {
 	VesselRepositoryReader
 	VesselRepositoryWriter
 }
+
+// VesselTrackReader reads the positions reported by a vessel in chronological order. Tracks beyond
+// MaxTrackPoints are only read downsampled, otherwise ErrTrackTooLong is returned.
+type VesselTrackReader interface {
+	FindTrack(
+		ctx context.Context,
+		id VesselID,
+		criteria *vesselpositiondomain.TrackCriteria,
+	) (vesselpositiondomain.Track, error)
+}
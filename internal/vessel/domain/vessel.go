@@ -2,27 +2,72 @@ package vesseldomain
 
 import (
 	"time"
+
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
 )
 
 type Vessel struct {
-	id        VesselID
-	name      Name
-	capacity  Capacity
-	location  Location
+	id       VesselID
+	name     Name
+	capacity Capacity
+	location Location
+	// mmsi identifies the vessel AIS messages, nil when it's not known.
+	mmsi      *MMSI
 	createdAt time.Time
 	updatedAt time.Time
 	deletedAt *time.Time
+	// version is the one the vessel was loaded with, zero when it was never saved.
+	version uint64
+	// positions holds the ones reported since the vessel was loaded, saved along with it.
+	positions vesselpositiondomain.Track
+}
+
+func NewVessel(id VesselID, name string, capacity uint64, latitude, longitude float64, at time.Time) (*Vessel, error) {
+	vesselName, err := NewName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	vesselCapacity, err := NewCapacity(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := NewLocation(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vessel{
+		id:        id,
+		name:      vesselName,
+		capacity:  vesselCapacity,
+		location:  location,
+		createdAt: at,
+		updatedAt: at,
+		deletedAt: nil,
+		positions: vesselpositiondomain.NewEmptyTrack(),
+	}, nil
 }
 
 func NewVesselFromPrimitives(v VesselPrimitives) *Vessel {
+	var mmsi *MMSI
+	if v.MMSI != nil {
+		vesselMMSI := MMSI(*v.MMSI)
+		mmsi = &vesselMMSI
+	}
+
 	return &Vessel{
 		id:        VesselID(v.ID),
 		name:      Name(v.Name),
 		capacity:  Capacity(v.Capacity),
 		location:  Location{latitude: v.Latitude, longitude: v.Longitude},
+		mmsi:      mmsi,
 		createdAt: v.CreatedAt,
 		updatedAt: v.UpdatedAt,
 		deletedAt: v.DeletedAt,
+		version:   v.Version,
+		positions: vesselpositiondomain.NewEmptyTrack(),
 	}
 }
 
@@ -33,3 +78,26 @@ func (v *Vessel) Primitives() VesselPrimitives {
 func (v *Vessel) ID() VesselID {
 	return v.id
 }
+
+func (v *Vessel) Version() uint64 {
+	return v.version
+}
+
+func (v *Vessel) Location() Location {
+	return v.location
+}
+
+// Positions returns the positions reported since the vessel was loaded.
+func (v *Vessel) Positions() vesselpositiondomain.Track {
+	return v.positions
+}
+
+func (v *Vessel) Update(updates ...VesselUpdateOpt) error {
+	for _, update := range updates {
+		if updateErr := update(v); updateErr != nil {
+			return updateErr
+		}
+	}
+
+	return nil
+}
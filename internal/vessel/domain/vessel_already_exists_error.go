@@ -1,6 +1,8 @@
 package vesseldomain
 
 import (
+	"errors"
+
 	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
 )
@@ -19,3 +21,8 @@ func NewVesselAlreadyExistsError(id VesselID) *VesselAlreadyExistsError {
 		),
 	}
 }
+
+func IsVesselAlreadyExistsError(err error) bool {
+	var self *VesselAlreadyExistsError
+	return errors.As(err, &self)
+}
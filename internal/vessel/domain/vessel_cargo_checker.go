@@ -0,0 +1,15 @@
+package vesseldomain
+
+import (
+	"context"
+)
+
+// VesselCargoChecker tells whether a vessel still carries cargoes, i.e. ones that
+// aren't delivered or otherwise finished yet, and how much they weigh.
+//
+//go:generate moq -pkg vesseldomainmock -out mock/vessel_cargo_checker_moq.go . VesselCargoChecker
+type VesselCargoChecker interface {
+	HasActiveCargoes(ctx context.Context, id VesselID) (bool, error)
+	// ActiveCargoWeight sums, in grams, the weight of the cargoes the vessel still carries.
+	ActiveCargoWeight(ctx context.Context, id VesselID) (uint64, error)
+}
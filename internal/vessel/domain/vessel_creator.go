@@ -0,0 +1,58 @@
+package vesseldomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type VesselCreateInput struct {
+	ID        string
+	Name      string
+	Capacity  uint64 // in kilograms
+	Latitude  float64
+	Longitude float64
+	MMSI      *string
+	At        time.Time
+}
+
+type VesselCreator struct {
+	repository VesselRepository
+}
+
+func NewVesselCreator(repository VesselRepository) *VesselCreator {
+	return &VesselCreator{repository: repository}
+}
+
+func (vc *VesselCreator) Create(ctx context.Context, input VesselCreateInput) (*Vessel, error) {
+	vesselID, err := NewVesselID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, findErr := vc.repository.Find(ctx, vesselID)
+	if findErr != nil && !IsVesselNotExistsError(findErr) {
+		return nil, fmt.Errorf("error checking existing vessel: %w", findErr)
+	}
+
+	if existing != nil {
+		return nil, NewVesselAlreadyExistsError(vesselID)
+	}
+
+	vessel, err := NewVessel(vesselID, input.Name, input.Capacity, input.Latitude, input.Longitude, input.At)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.MMSI != nil {
+		if mmsiErr := vessel.Update(WithMMSI(*input.MMSI, input.At)); mmsiErr != nil {
+			return nil, mmsiErr
+		}
+	}
+
+	if saveErr := vc.repository.Save(ctx, vessel); saveErr != nil {
+		return nil, fmt.Errorf("error saving vessel: %w", saveErr)
+	}
+
+	return vessel, nil
+}
@@ -0,0 +1,64 @@
+package vesseldomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+)
+
+var (
+	ErrVesselHasActiveCargoes = domain.NewError("vessel still carries cargoes not delivered yet")
+)
+
+type VesselDeleteInput struct {
+	ID string
+	At time.Time
+	// ExpectedVersion rejects the deletion unless the vessel is on it, nil means any version.
+	ExpectedVersion *uint64
+}
+
+// VesselDeleter soft deletes a vessel once it no longer carries any cargo, so no cargo
+// is left on a vessel which doesn't exist anymore.
+type VesselDeleter struct {
+	updater      *VesselUpdater
+	cargoChecker VesselCargoChecker
+}
+
+func NewVesselDeleter(updater *VesselUpdater, checker VesselCargoChecker) *VesselDeleter {
+	return &VesselDeleter{
+		updater:      updater,
+		cargoChecker: checker,
+	}
+}
+
+func (vd *VesselDeleter) Delete(ctx context.Context, input VesselDeleteInput) error {
+	vessel, err := vd.updater.find(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+
+	if versionErr := vessel.Update(WithExpectedVersion(input.ExpectedVersion)); versionErr != nil {
+		return ErrVesselUpdateFailed.Wrap(versionErr)
+	}
+
+	hasCargoes, err := vd.cargoChecker.HasActiveCargoes(ctx, vessel.id)
+	if err != nil {
+		return fmt.Errorf("error checking vessel cargoes: %w", err)
+	}
+
+	if hasCargoes {
+		return ErrVesselUpdateFailed.Wrap(ErrVesselHasActiveCargoes)
+	}
+
+	if deleteErr := vessel.Update(WithSoftDeletion(input.At)); deleteErr != nil {
+		return ErrVesselUpdateFailed.Wrap(deleteErr)
+	}
+
+	if saveErr := vd.updater.repository.Save(ctx, vessel); saveErr != nil {
+		return ErrVesselUpdateFailed.Wrap(saveErr)
+	}
+
+	return nil
+}
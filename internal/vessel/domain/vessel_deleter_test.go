@@ -0,0 +1,111 @@
+package vesseldomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesseldomainmock "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+func TestVesselDeleter_Delete(t *testing.T) {
+	ctx := context.Background()
+	vesselID := utils.NewFixedULIDProvider().New().String()
+	now := time.Now()
+	currentVersion, staleVersion := uint64(0), uint64(4)
+
+	tests := []struct {
+		name            string
+		expectedVersion *uint64
+		setupMocks      func(repo *vesseldomainmock.VesselRepositoryMock, checker *vesseldomainmock.VesselCargoCheckerMock)
+		expectedErr     error
+		expectedSaves   int
+	}{
+		{
+			name:            "should soft delete vessel without active cargoes",
+			expectedVersion: &currentVersion,
+			setupMocks: func(repo *vesseldomainmock.VesselRepositoryMock, checker *vesseldomainmock.VesselCargoCheckerMock) {
+				repo.SaveFunc = func(_ context.Context, v *vesseldomain.Vessel) error {
+					if deletedAt := v.Primitives().DeletedAt; deletedAt == nil || !deletedAt.Equal(now) {
+						return errors.New("vessel was not soft deleted")
+					}
+					return nil
+				}
+				checker.HasActiveCargoesFunc = func(_ context.Context, _ vesseldomain.VesselID) (bool, error) {
+					return false, nil
+				}
+			},
+			expectedSaves: 1,
+		},
+		{
+			name: "should fail when vessel still carries cargoes",
+			setupMocks: func(_ *vesseldomainmock.VesselRepositoryMock, checker *vesseldomainmock.VesselCargoCheckerMock) {
+				checker.HasActiveCargoesFunc = func(_ context.Context, _ vesseldomain.VesselID) (bool, error) {
+					return true, nil
+				}
+			},
+			expectedErr: vesseldomain.ErrVesselHasActiveCargoes,
+		},
+		{
+			name:            "should fail when vessel is not on the expected version",
+			expectedVersion: &staleVersion,
+			setupMocks:      func(_ *vesseldomainmock.VesselRepositoryMock, _ *vesseldomainmock.VesselCargoCheckerMock) {},
+			expectedErr:     vesseldomain.ErrVesselVersionMismatch,
+		},
+		{
+			name: "should fail when cargoes can't be checked",
+			setupMocks: func(_ *vesseldomainmock.VesselRepositoryMock, checker *vesseldomainmock.VesselCargoCheckerMock) {
+				checker.HasActiveCargoesFunc = func(_ context.Context, _ vesseldomain.VesselID) (bool, error) {
+					return false, vesseldomain.ErrVesselUpdateFailed
+				}
+			},
+			expectedErr: vesseldomain.ErrVesselUpdateFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &vesseldomainmock.VesselRepositoryMock{
+				FindFunc: func(_ context.Context, _ vesseldomain.VesselID) (*vesseldomain.Vessel, error) {
+					return vesseltest.NewVesselMother(vesseltest.WithVesselID(vesselID)).Build(t), nil
+				},
+			}
+			checker := &vesseldomainmock.VesselCargoCheckerMock{}
+			tt.setupMocks(repo, checker)
+
+			deleter := vesseldomain.NewVesselDeleter(vesseldomain.NewVesselUpdater(repo, checker), checker)
+			input := vesseldomain.VesselDeleteInput{ID: vesselID, At: now, ExpectedVersion: tt.expectedVersion}
+
+			err := deleter.Delete(ctx, input)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Len(t, repo.SaveCalls(), tt.expectedSaves)
+		})
+	}
+}
+
+func TestVesselDeleter_DeleteFailsWhenVesselDoesNotExist(t *testing.T) {
+	vesselID := vesseldomain.VesselID(utils.NewFixedULIDProvider().New().String())
+	repo := &vesseldomainmock.VesselRepositoryMock{
+		FindFunc: func(_ context.Context, id vesseldomain.VesselID) (*vesseldomain.Vessel, error) {
+			return nil, vesseldomain.NewVesselNotExistsError(id)
+		},
+	}
+
+	checker := &vesseldomainmock.VesselCargoCheckerMock{}
+	deleter := vesseldomain.NewVesselDeleter(vesseldomain.NewVesselUpdater(repo, checker), checker)
+	err := deleter.Delete(context.Background(), vesseldomain.VesselDeleteInput{ID: vesselID.String(), At: time.Now()})
+
+	assert.True(t, vesseldomain.IsVesselNotExistsError(err))
+}
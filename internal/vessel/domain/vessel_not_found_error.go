@@ -22,6 +22,15 @@ func NewVesselNotExistsError(id VesselID) *VesselNotExistsError {
 	}
 }
 
+func NewVesselWithMMSINotExistsError(mmsi MMSI) *VesselNotExistsError {
+	return &VesselNotExistsError{
+		BaseError: domain.NewError(
+			vesselNotFoundErrorMsg,
+			errutil.WithMetadataKeyValue("domain.vessel.mmsi", mmsi.String()),
+		),
+	}
+}
+
 func IsVesselNotExistsError(err error) bool {
 	var self *VesselNotExistsError
 	return errors.As(err, &self)
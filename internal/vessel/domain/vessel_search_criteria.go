@@ -0,0 +1,162 @@
+package vesseldomain
+
+import (
+	"strconv"
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	DefaultVesselSearchPageSize uint64  = 20
+	MaxVesselSearchPageSize     uint64  = 100
+	MaxVesselSearchRadiusKm     float64 = 20000
+
+	nearFilterValues        = 3
+	boundingBoxFilterValues = 4
+)
+
+var (
+	ErrInvalidVesselSearchCriteria = domainvalidation.NewError("invalid vessel search criteria provided")
+)
+
+// NearFilter matches the vessels located within the given radius of the center.
+type NearFilter struct {
+	Center   Location
+	RadiusKm float64
+}
+
+// Bounds returns the box every vessel matching the filter is located in, cheap to check before the exact distance.
+func (f NearFilter) Bounds() BoundingBox {
+	return NewBoundingBoxAround(f.Center, f.RadiusKm)
+}
+
+type VesselSearchOpt func(*VesselSearchCriteria) error
+
+// VesselSearchCriteria matches the vessels located near a point and/or within a bounding box,
+// sorted by their distance to the point or to the center of the box when there is no point.
+type VesselSearchCriteria struct {
+	Near        *NearFilter
+	BoundingBox *BoundingBox
+	PageSize    uint64
+}
+
+func NewVesselSearchCriteria(opts ...VesselSearchOpt) (*VesselSearchCriteria, error) {
+	criteria := &VesselSearchCriteria{Near: nil, BoundingBox: nil, PageSize: DefaultVesselSearchPageSize}
+	for _, opt := range opts {
+		if err := opt(criteria); err != nil {
+			return nil, err
+		}
+	}
+
+	if criteria.Near == nil && criteria.BoundingBox == nil {
+		return nil, ErrInvalidVesselSearchCriteria
+	}
+
+	return criteria, nil
+}
+
+// Origin returns the location distances are measured from.
+func (c *VesselSearchCriteria) Origin() Location {
+	if c.Near != nil {
+		return c.Near.Center
+	}
+
+	return c.BoundingBox.Center()
+}
+
+// WithNearFilter parses a "lat,lon,radius_km" expression.
+func WithNearFilter(raw string) VesselSearchOpt {
+	return func(c *VesselSearchCriteria) error {
+		if raw == "" {
+			return nil
+		}
+
+		values, err := parseCoordinates(raw, nearFilterValues)
+		if err != nil {
+			return ErrInvalidVesselSearchCriteria.Wrap(err)
+		}
+
+		center, err := NewLocation(values[0], values[1])
+		if err != nil {
+			return ErrInvalidVesselSearchCriteria.Wrap(err)
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.Min(0.0),
+			domainvalidation.WithinBounds(0, MaxVesselSearchRadiusKm),
+		)
+
+		if radiusErr := validator.Validate(values[2]); radiusErr != nil {
+			return ErrInvalidVesselSearchCriteria.Wrap(radiusErr)
+		}
+
+		c.Near = &NearFilter{Center: center, RadiusKm: values[2]}
+		return nil
+	}
+}
+
+// WithBoundingBoxFilter parses a "south_lat,west_lon,north_lat,east_lon" expression.
+func WithBoundingBoxFilter(raw string) VesselSearchOpt {
+	return func(c *VesselSearchCriteria) error {
+		if raw == "" {
+			return nil
+		}
+
+		values, err := parseCoordinates(raw, boundingBoxFilterValues)
+		if err != nil {
+			return ErrInvalidVesselSearchCriteria.Wrap(err)
+		}
+
+		box, err := NewBoundingBox(values[0], values[1], values[2], values[3])
+		if err != nil {
+			return ErrInvalidVesselSearchCriteria.Wrap(err)
+		}
+
+		c.BoundingBox = &box
+		return nil
+	}
+}
+
+func WithPageSize(size uint64) VesselSearchOpt {
+	return func(c *VesselSearchCriteria) error {
+		if size == 0 {
+			return nil
+		}
+
+		validator := domainvalidation.NewValidator(
+			domainvalidation.WithinBounds(1, MaxVesselSearchPageSize),
+		)
+
+		if err := validator.Validate(size); err != nil {
+			return ErrInvalidVesselSearchCriteria.Wrap(err)
+		}
+
+		c.PageSize = size
+		return nil
+	}
+}
+
+func parseCoordinates(raw string, expected int) ([]float64, error) {
+	parts := strings.Split(raw, ",")
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.WithinBounds(expected, expected),
+	)
+
+	if err := validator.Validate(len(parts)); err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
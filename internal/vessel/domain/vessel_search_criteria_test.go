@@ -0,0 +1,142 @@
+package vesseldomain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+)
+
+func TestNewVesselSearchCriteria(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []vesseldomain.VesselSearchOpt
+		assertion     func(t *testing.T, criteria *vesseldomain.VesselSearchCriteria)
+		expectedError bool
+	}{
+		{
+			name: "should build criteria with a near filter measuring distances from its center",
+			opts: []vesseldomain.VesselSearchOpt{
+				vesseldomain.WithNearFilter("51.9225, 4.47917, 92.6"),
+			},
+			assertion: func(t *testing.T, criteria *vesseldomain.VesselSearchCriteria) {
+				require.NotNil(t, criteria.Near)
+				assert.InDelta(t, 92.6, criteria.Near.RadiusKm, 0.0001)
+				assert.Nil(t, criteria.BoundingBox)
+				assert.InDelta(t, 51.9225, criteria.Origin().Latitude(), 0.0001)
+				assert.InDelta(t, 4.47917, criteria.Origin().Longitude(), 0.0001)
+				assert.Equal(t, vesseldomain.DefaultVesselSearchPageSize, criteria.PageSize)
+			},
+		},
+		{
+			name: "should build criteria with a bounding box filter measuring distances from its center",
+			opts: []vesseldomain.VesselSearchOpt{
+				vesseldomain.WithBoundingBoxFilter("50,2,54,8"),
+				vesseldomain.WithPageSize(50),
+			},
+			assertion: func(t *testing.T, criteria *vesseldomain.VesselSearchCriteria) {
+				require.NotNil(t, criteria.BoundingBox)
+				assert.Nil(t, criteria.Near)
+				assert.InDelta(t, 52, criteria.Origin().Latitude(), 0.0001)
+				assert.InDelta(t, 5, criteria.Origin().Longitude(), 0.0001)
+				assert.Equal(t, uint64(50), criteria.PageSize)
+			},
+		},
+		{
+			name: "should measure distances from the near filter center when both filters are provided",
+			opts: []vesseldomain.VesselSearchOpt{
+				vesseldomain.WithNearFilter("51.9225,4.47917,50"),
+				vesseldomain.WithBoundingBoxFilter("50,2,54,8"),
+			},
+			assertion: func(t *testing.T, criteria *vesseldomain.VesselSearchCriteria) {
+				assert.InDelta(t, 51.9225, criteria.Origin().Latitude(), 0.0001)
+			},
+		},
+		{
+			name:          "should fail when no filter is provided",
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the near filter misses the radius",
+			opts:          []vesseldomain.VesselSearchOpt{vesseldomain.WithNearFilter("51.9225,4.47917")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the near filter center is out of bounds",
+			opts:          []vesseldomain.VesselSearchOpt{vesseldomain.WithNearFilter("91,4.47917,50")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the near filter radius is not positive",
+			opts:          []vesseldomain.VesselSearchOpt{vesseldomain.WithNearFilter("51.9225,4.47917,0")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the near filter radius is not a number",
+			opts:          []vesseldomain.VesselSearchOpt{vesseldomain.WithNearFilter("51.9225,4.47917,far")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the bounding box south is above its north",
+			opts:          []vesseldomain.VesselSearchOpt{vesseldomain.WithBoundingBoxFilter("54,2,50,8")},
+			expectedError: true,
+		},
+		{
+			name:          "should fail when the page size exceeds the maximum",
+			opts:          []vesseldomain.VesselSearchOpt{vesseldomain.WithBoundingBoxFilter("50,2,54,8"), vesseldomain.WithPageSize(101)},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := vesseldomain.NewVesselSearchCriteria(tt.opts...)
+			if tt.expectedError {
+				require.ErrorIs(t, err, vesseldomain.ErrInvalidVesselSearchCriteria)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.assertion(t, criteria)
+		})
+	}
+}
+
+func TestNearFilter_Bounds(t *testing.T) {
+	rotterdam, err := vesseldomain.NewLocation(51.9225, 4.47917)
+	require.NoError(t, err)
+	antwerp, err := vesseldomain.NewLocation(51.2194, 4.4025)
+	require.NoError(t, err)
+	suva, err := vesseldomain.NewLocation(-18.1416, 178.4419)
+	require.NoError(t, err)
+	longyearbyen, err := vesseldomain.NewLocation(78.2232, 15.6267)
+	require.NoError(t, err)
+
+	t.Run("should hold every location within the radius", func(t *testing.T) {
+		distance := rotterdam.DistanceTo(antwerp)
+		assert.InDelta(t, 78.3, distance, 0.5)
+
+		assert.True(t, vesseldomain.NearFilter{Center: rotterdam, RadiusKm: distance + 1}.Bounds().Contains(antwerp))
+		assert.False(t, vesseldomain.NearFilter{Center: rotterdam, RadiusKm: 10}.Bounds().Contains(antwerp))
+	})
+
+	t.Run("should wrap around when the radius crosses the antimeridian", func(t *testing.T) {
+		bounds := vesseldomain.NearFilter{Center: suva, RadiusKm: 500}.Bounds()
+		beyond, locErr := vesseldomain.NewLocation(-18.1416, -178.5)
+		require.NoError(t, locErr)
+
+		assert.True(t, bounds.CrossesAntimeridian())
+		assert.True(t, bounds.Contains(beyond))
+		assert.InDelta(t, suva.Longitude(), bounds.Center().Longitude(), 0.0001)
+	})
+
+	t.Run("should span every meridian when the radius reaches a pole", func(t *testing.T) {
+		bounds := vesseldomain.NearFilter{Center: longyearbyen, RadiusKm: 1500}.Bounds()
+
+		assert.InDelta(t, 90, bounds.North(), 0.0001)
+		assert.InDelta(t, -180, bounds.West(), 0.0001)
+		assert.InDelta(t, 180, bounds.East(), 0.0001)
+	})
+}
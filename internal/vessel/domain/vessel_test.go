@@ -0,0 +1,97 @@
+package vesseldomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+func TestNewVessel(t *testing.T) {
+	id := vesseldomain.VesselID(utils.NewFixedULIDProvider().New().String())
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		vesselName  string
+		capacity    uint64
+		latitude    float64
+		longitude   float64
+		expectedErr error
+	}{
+		{name: "should create vessel", vesselName: "Falcon 9", capacity: 5000, latitude: 37.7749, longitude: -122.4194},
+		{name: "should fail when name is empty", capacity: 5000, expectedErr: vesseldomain.ErrInvalidVesselNameProvided},
+		{name: "should fail when capacity is zero", vesselName: "Falcon 9", expectedErr: vesseldomain.ErrInvalidCapacityProvided},
+		{
+			name:        "should fail when capacity exceeds the maximum",
+			vesselName:  "Falcon 9",
+			capacity:    100_001,
+			expectedErr: vesseldomain.ErrInvalidCapacityProvided,
+		},
+		{
+			name:        "should fail when latitude is out of bounds",
+			vesselName:  "Falcon 9",
+			capacity:    5000,
+			latitude:    91,
+			expectedErr: vesseldomain.ErrInvalidLocationProvided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vessel, err := vesseldomain.NewVessel(id, tt.vesselName, tt.capacity, tt.latitude, tt.longitude, now)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, vessel)
+				return
+			}
+
+			require.NoError(t, err)
+			primitives := vessel.Primitives()
+			assert.Equal(t, id.String(), primitives.ID)
+			assert.Equal(t, tt.vesselName, primitives.Name)
+			assert.Equal(t, tt.capacity, primitives.Capacity)
+			assert.Equal(t, now, primitives.CreatedAt)
+			assert.Nil(t, primitives.DeletedAt)
+			assert.Zero(t, primitives.Version)
+		})
+	}
+}
+
+func TestVessel_UpdateWithMMSI(t *testing.T) {
+	now := time.Now()
+	assigned := "477553000"
+
+	tests := []struct {
+		name         string
+		mmsi         string
+		expectedMMSI *string
+		expectedErr  error
+	}{
+		{name: "should assign the mmsi", mmsi: "477553000", expectedMMSI: &assigned},
+		{name: "should unassign the mmsi when it's empty", mmsi: ""},
+		{name: "should fail when mmsi has not 9 digits", mmsi: "47755300", expectedErr: vesseldomain.ErrInvalidMMSIProvided},
+		{name: "should fail when mmsi is not numeric", mmsi: "47755300A", expectedErr: vesseldomain.ErrInvalidMMSIProvided},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vessel := vesseltest.NewVesselMother(vesseltest.WithMMSI("123456789")).Build(t)
+
+			err := vessel.Update(vesseldomain.WithMMSI(tt.mmsi, now))
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMMSI, vessel.Primitives().MMSI)
+			assert.Equal(t, now, vessel.Primitives().UpdatedAt)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package vesseldomain
+
+import (
+	"time"
+
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+)
+
+var (
+	ErrVesselVersionMismatch = domain.NewError("vessel version doesn't match the expected one")
+)
+
+type VesselUpdateOpt func(*Vessel) error
+
+// WithExpectedVersion rejects the update unless the vessel is on the expected version, meant to
+// be applied first. No version expected means no precondition at all.
+func WithExpectedVersion(expected *uint64) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		if expected != nil && *expected != v.version {
+			return ErrVesselVersionMismatch
+		}
+
+		return nil
+	}
+}
+
+func WithName(name string, at time.Time) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		newName, err := NewName(name)
+		if err != nil {
+			return err
+		}
+
+		v.name = newName
+		v.updatedAt = at
+
+		return nil
+	}
+}
+
+// WithCapacity changes the vessel capacity, in kilograms.
+func WithCapacity(capacity uint64, at time.Time) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		newCapacity, err := NewCapacity(capacity)
+		if err != nil {
+			return err
+		}
+
+		v.capacity = newCapacity
+		v.updatedAt = at
+
+		return nil
+	}
+}
+
+func WithLocation(latitude, longitude float64, at time.Time) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		newLocation, err := NewLocation(latitude, longitude)
+		if err != nil {
+			return err
+		}
+
+		v.location = newLocation
+		v.updatedAt = at
+
+		return nil
+	}
+}
+
+// WithMMSI assigns the vessel the MMSI its AIS messages are broadcast with, an empty one
+// unassigns it.
+func WithMMSI(mmsi string, at time.Time) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		var newMMSI *MMSI
+		if mmsi != "" {
+			vesselMMSI, err := NewMMSI(mmsi)
+			if err != nil {
+				return err
+			}
+
+			newMMSI = &vesselMMSI
+		}
+
+		v.mmsi = newMMSI
+		v.updatedAt = at
+
+		return nil
+	}
+}
+
+// WithReportedPosition moves the vessel to the reported position, which is kept in its track. The
+// instant is the one the position was recorded at, so it's not taken as the vessel last update.
+func WithReportedPosition(positionID string, latitude, longitude float64, at time.Time) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		id, err := vesselpositiondomain.NewPositionID(positionID)
+		if err != nil {
+			return err
+		}
+
+		location, err := NewLocation(latitude, longitude)
+		if err != nil {
+			return err
+		}
+
+		v.location = location
+
+		v.positions = append(v.positions, vesselpositiondomain.NewPosition(id, latitude, longitude, at))
+
+		return nil
+	}
+}
+
+func WithSoftDeletion(at time.Time) VesselUpdateOpt {
+	return func(v *Vessel) error {
+		v.deletedAt = &at
+		v.updatedAt = at
+
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package vesseldomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	ErrVesselUpdateFailed             = errutil.NewError("vessel update failed")
+	ErrVesselCapacityBelowCargoWeight = domain.NewError("vessel capacity is below the weight of the cargoes it carries")
+)
+
+type VesselUpdater struct {
+	repository   VesselRepository
+	cargoChecker VesselCargoChecker
+}
+
+func NewVesselUpdater(repository VesselRepository, checker VesselCargoChecker) *VesselUpdater {
+	return &VesselUpdater{
+		repository:   repository,
+		cargoChecker: checker,
+	}
+}
+
+func (vu *VesselUpdater) Update(ctx context.Context, id string, opts ...VesselUpdateOpt) error {
+	vessel, err := vu.find(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	capacityBefore := vessel.capacity
+	if updateErr := vessel.Update(opts...); updateErr != nil {
+		return ErrVesselUpdateFailed.Wrap(updateErr)
+	}
+
+	// Only a lowered capacity can leave the vessel carrying more than it's able to.
+	if vessel.capacity < capacityBefore {
+		if guardErr := vu.guardCapacity(ctx, vessel); guardErr != nil {
+			return guardErr
+		}
+	}
+
+	if saveErr := vu.repository.Save(ctx, vessel); saveErr != nil {
+		return ErrVesselUpdateFailed.Wrap(saveErr)
+	}
+
+	return nil
+}
+
+// ReportPosition moves the vessel to the reported position without bumping its version, positions
+// are reported far too often for them to outdate the version a client updates the vessel with.
+func (vu *VesselUpdater) ReportPosition(
+	ctx context.Context,
+	id, positionID string,
+	latitude, longitude float64,
+	at time.Time,
+) error {
+	vessel, err := vu.find(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if updateErr := vessel.Update(WithReportedPosition(positionID, latitude, longitude, at)); updateErr != nil {
+		return ErrVesselUpdateFailed.Wrap(updateErr)
+	}
+
+	if saveErr := vu.repository.SavePosition(ctx, vessel); saveErr != nil {
+		return ErrVesselUpdateFailed.Wrap(saveErr)
+	}
+
+	return nil
+}
+
+func (vu *VesselUpdater) guardCapacity(ctx context.Context, vessel *Vessel) error {
+	loadedWeight, err := vu.cargoChecker.ActiveCargoWeight(ctx, vessel.id)
+	if err != nil {
+		return fmt.Errorf("error checking vessel cargoes: %w", err)
+	}
+
+	if loadedWeight > vessel.capacity.Grams() {
+		return ErrVesselUpdateFailed.Wrap(ErrVesselCapacityBelowCargoWeight)
+	}
+
+	return nil
+}
+
+func (vu *VesselUpdater) find(ctx context.Context, id string) (*Vessel, error) {
+	vesselID, err := NewVesselID(id)
+	if err != nil {
+		return nil, ErrVesselUpdateFailed.Wrap(err)
+	}
+
+	vessel, err := vu.repository.Find(ctx, vesselID)
+	if err != nil {
+		return nil, ErrVesselUpdateFailed.Wrap(err)
+	}
+
+	return vessel, nil
+}
@@ -0,0 +1,92 @@
+package vesseldomain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesseldomainmock "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/mock"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+func TestVesselUpdater_UpdateCapacity(t *testing.T) {
+	ctx := context.Background()
+	vesselID := utils.NewFixedULIDProvider().New().String()
+	now := time.Now()
+
+	const (
+		currentCapacity = 100       // in kilograms
+		loadedWeight    = 60 * 1000 // in grams
+	)
+
+	tests := []struct {
+		name           string
+		capacity       uint64
+		checkerErr     error
+		expectedErr    error
+		expectedChecks int
+		expectedSaves  int
+	}{
+		{
+			name:          "should raise the capacity without checking the cargoes",
+			capacity:      200,
+			expectedSaves: 1,
+		},
+		{
+			name:           "should lower the capacity down to the loaded weight",
+			capacity:       60,
+			expectedChecks: 1,
+			expectedSaves:  1,
+		},
+		{
+			name:           "should fail lowering the capacity below the loaded weight",
+			capacity:       59,
+			expectedErr:    vesseldomain.ErrVesselCapacityBelowCargoWeight,
+			expectedChecks: 1,
+		},
+		{
+			name:           "should fail when cargoes can't be checked",
+			capacity:       50,
+			checkerErr:     vesseldomain.ErrVesselUpdateFailed,
+			expectedErr:    vesseldomain.ErrVesselUpdateFailed,
+			expectedChecks: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &vesseldomainmock.VesselRepositoryMock{
+				FindFunc: func(_ context.Context, _ vesseldomain.VesselID) (*vesseldomain.Vessel, error) {
+					return vesseltest.NewVesselMother(
+						vesseltest.WithVesselID(vesselID),
+						vesseltest.WithCapacity(currentCapacity),
+					).Build(t), nil
+				},
+				SaveFunc: func(_ context.Context, _ *vesseldomain.Vessel) error {
+					return nil
+				},
+			}
+			checker := &vesseldomainmock.VesselCargoCheckerMock{
+				ActiveCargoWeightFunc: func(_ context.Context, _ vesseldomain.VesselID) (uint64, error) {
+					return loadedWeight, tt.checkerErr
+				},
+			}
+
+			updater := vesseldomain.NewVesselUpdater(repo, checker)
+			err := updater.Update(ctx, vesselID, vesseldomain.WithCapacity(tt.capacity, now))
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Len(t, checker.ActiveCargoWeightCalls(), tt.expectedChecks)
+			assert.Len(t, repo.SaveCalls(), tt.expectedSaves)
+		})
+	}
+}
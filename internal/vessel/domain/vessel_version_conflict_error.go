@@ -0,0 +1,31 @@
+package vesseldomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const vesselVersionConflictErrorMsg = "vessel was modified concurrently."
+
+// VesselVersionConflictError is returned when a vessel is saved but the stored one is no
+// longer on the version it was loaded with, i.e. someone else saved it in between.
+type VesselVersionConflictError struct {
+	domain.BaseError
+}
+
+func NewVesselVersionConflictError(id VesselID, version uint64) *VesselVersionConflictError {
+	return &VesselVersionConflictError{
+		BaseError: domain.NewError(
+			vesselVersionConflictErrorMsg,
+			errutil.WithMetadataKeyValue("domain.vessel.id", id.String()),
+			errutil.WithMetadataKeyValue("domain.vessel.version", version),
+		),
+	}
+}
+
+func IsVesselVersionConflictError(err error) bool {
+	var self *VesselVersionConflictError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,77 @@
+package vesselentrypoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	vesselcommands "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/commands"
+	vesselqueries "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/queries"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/ais"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/logger"
+)
+
+// AISPositionIngestor reports the positions broadcast by our vessels over AIS, which are told
+// apart by the MMSI assigned to them. Messages from vessels we don't know are ignored, as well
+// as the ones not carrying a position.
+type AISPositionIngestor struct {
+	queryBus   querybus.Bus
+	commandBus commandbus.Bus
+	mutex      distributedsync.MutexService
+	logger     logger.ZerologLogger
+}
+
+func NewAISPositionIngestor(
+	queryBus querybus.Bus,
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	logger logger.ZerologLogger,
+) *AISPositionIngestor {
+	return &AISPositionIngestor{
+		queryBus:   queryBus,
+		commandBus: commandBus,
+		mutex:      mutex,
+		logger:     logger,
+	}
+}
+
+// Ingest reports the position at the instant a recorded feed received it, the current one when
+// it's received live.
+func (i *AISPositionIngestor) Ingest(ctx context.Context, msg ais.Message, recordedAt *time.Time) error {
+	report, isPosition := msg.(ais.PositionReport)
+	if !isPosition || report.Latitude == nil || report.Longitude == nil {
+		return nil
+	}
+
+	query := &vesselqueries.FetchVesselByMMSIQuery{MMSI: fmt.Sprintf("%09d", report.MMSI())}
+	vessel, err := bus.DispatchWithResponse[*vesselqueries.FetchVesselByMMSIQuery, vesselqueries.VesselResponse](
+		i.queryBus,
+	)(ctx, query)
+	if err != nil {
+		if vesseldomain.IsVesselNotExistsError(err) || errors.Is(err, vesseldomain.ErrInvalidMMSIProvided) {
+			i.logger.Debug().Ctx(ctx).Str("ais.mmsi", query.MMSI).Msg("ignoring ais position of unknown vessel")
+			return nil
+		}
+
+		return fmt.Errorf("error resolving ais vessel: %w", err)
+	}
+
+	cmd := &vesselcommands.ReportVesselPositionCommand{
+		ID:         vessel.ID,
+		Latitude:   *report.Latitude,
+		Longitude:  *report.Longitude,
+		RecordedAt: recordedAt,
+	}
+
+	if err = bus.DispatchBlocking(i.commandBus, i.mutex)(ctx, cmd); err != nil {
+		return fmt.Errorf("error reporting ais position: %w", err)
+	}
+
+	return nil
+}
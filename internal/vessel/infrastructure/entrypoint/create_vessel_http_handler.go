@@ -0,0 +1,99 @@
+package vesselentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/jsonapi"
+
+	vesselcommands "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/commands"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type VesselCapacityRequest struct {
+	Value float64 `jsonapi:"attr,value"`
+	Unit  string  `jsonapi:"attr,unit"`
+}
+
+type CreateVesselRequest struct {
+	ID        string                `jsonapi:"primary,vessel"`
+	Name      string                `jsonapi:"attr,name"`
+	Capacity  VesselCapacityRequest `jsonapi:"attr,capacity"`
+	Latitude  float64               `jsonapi:"attr,latitude"`
+	Longitude float64               `jsonapi:"attr,longitude"`
+	MMSI      *string               `jsonapi:"attr,mmsi"`
+}
+
+func HandlePOSTCreateVesselV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateVesselRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		cmd := &vesselcommands.CreateVesselCommand{
+			ID:        req.ID,
+			Name:      req.Name,
+			Capacity:  vesselcommands.VesselCapacity(req.Capacity),
+			Latitude:  req.Latitude,
+			Longitude: req.Longitude,
+			MMSI:      req.MMSI,
+		}
+
+		err := bus.DispatchBlocking(commandBus, mutex)(r.Context(), cmd)
+		if err != nil {
+			res, statusCode := newVesselErrorResponse(err)
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+	}
+}
+
+// newVesselErrorResponse maps a vessel command failure to its error response.
+func newVesselErrorResponse(err error) ([]*jsonapi.ErrorObject, int) {
+	switch {
+	case vesseldomain.IsVesselNotExistsError(err):
+		return jsonapiresponse.NewNotFound("vessel not found"), http.StatusNotFound
+	case vesseldomain.IsVesselAlreadyExistsError(err):
+		return jsonapiresponse.NewConflict("vessel already exists"), http.StatusConflict
+	case vesseldomain.IsVesselVersionConflictError(err):
+		return jsonapiresponse.NewConflict("vessel was modified concurrently"), http.StatusConflict
+	case errors.Is(err, vesseldomain.ErrMMSIAlreadyAssigned):
+		return jsonapiresponse.NewConflict("mmsi already assigned to another vessel"), http.StatusConflict
+	case errors.Is(err, vesseldomain.ErrVesselHasActiveCargoes):
+		return jsonapiresponse.NewConflict("vessel still carries cargoes not delivered yet"), http.StatusConflict
+	case errors.Is(err, vesseldomain.ErrVesselCapacityBelowCargoWeight):
+		return jsonapiresponse.NewConflict("vessel capacity is below the weight of the cargoes it carries"), http.StatusConflict
+	case errors.Is(err, vesseldomain.ErrVesselVersionMismatch):
+		return jsonapiresponse.NewPreconditionFailed(
+			"vessel version doesn't match the If-Match header",
+		), http.StatusPreconditionFailed
+	case errors.Is(err, vesseldomain.ErrInvalidVesselIDProvided):
+		return jsonapiresponse.NewBadRequest("invalid vessel ID provided"), http.StatusBadRequest
+	case errors.Is(err, vesseldomain.ErrInvalidVesselNameProvided):
+		return jsonapiresponse.NewBadRequest("invalid vessel name provided"), http.StatusBadRequest
+	case errors.Is(err, vesseldomain.ErrInvalidCapacityProvided):
+		return jsonapiresponse.NewBadRequest("invalid vessel capacity provided"), http.StatusBadRequest
+	case errors.Is(err, vesseldomain.ErrInvalidLocationProvided):
+		return jsonapiresponse.NewBadRequest("invalid vessel location provided"), http.StatusBadRequest
+	case errors.Is(err, vesseldomain.ErrInvalidMMSIProvided):
+		return jsonapiresponse.NewBadRequest("invalid vessel mmsi provided"), http.StatusBadRequest
+	case errors.Is(err, vesselcommands.ErrNoVesselChangesProvided):
+		return jsonapiresponse.NewBadRequest("no vessel changes provided"), http.StatusBadRequest
+	default:
+		return jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+	}
+}
@@ -11,32 +11,42 @@ import (
 	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
 	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
 	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
 	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
 )
 
 type FetchVesselByIDResponse struct {
-	ID        string    `jsonapi:"primary,vessel"`
-	Name      string    `jsonapi:"attr,name"`
-	Capacity  uint64    `jsonapi:"attr,capacity"`
-	Latitude  float64   `jsonapi:"attr,latitude"`
-	Longitude float64   `jsonapi:"attr,longitude"`
-	CreatedAt time.Time `jsonapi:"attr,created_at"`
-	UpdatedAt time.Time `jsonapi:"attr,updated_at"`
+	ID           string    `jsonapi:"primary,vessel"`
+	Name         string    `jsonapi:"attr,name"`
+	Capacity     float64   `jsonapi:"attr,capacity"`
+	CapacityUnit string    `jsonapi:"attr,capacity_unit"`
+	Latitude     float64   `jsonapi:"attr,latitude"`
+	Longitude    float64   `jsonapi:"attr,longitude"`
+	MMSI         *string   `jsonapi:"attr,mmsi,omitempty"`
+	CreatedAt    time.Time `jsonapi:"attr,created_at"`
+	UpdatedAt    time.Time `jsonapi:"attr,updated_at"`
 }
 
-func newFetchVesselByIDResponse(resp vesselqueries.VesselResponse) *FetchVesselByIDResponse {
+// newFetchVesselByIDResponse expresses the capacity, handled in kilograms internally, in the given unit.
+func newFetchVesselByIDResponse(resp vesselqueries.VesselResponse, unit domainweight.Unit) *FetchVesselByIDResponse {
 	return &FetchVesselByIDResponse{
-		ID:        resp.ID,
-		Name:      resp.Name,
-		Capacity:  resp.Capacity,
-		Latitude:  resp.Latitude,
-		Longitude: resp.Longitude,
-		CreatedAt: resp.CreatedAt,
-		UpdatedAt: resp.UpdatedAt,
+		ID:           resp.ID,
+		Name:         resp.Name,
+		Capacity:     domainweight.FromKilograms(resp.Capacity).In(unit),
+		CapacityUnit: unit.String(),
+		Latitude:     resp.Latitude,
+		Longitude:    resp.Longitude,
+		MMSI:         resp.MMSI,
+		CreatedAt:    resp.CreatedAt,
+		UpdatedAt:    resp.UpdatedAt,
 	}
 }
 
+const (
+	unitsQueryParam = "units"
+)
+
 func HandleGETFetchVesselByIDV1HTTP(
 	queryBus querybus.Bus,
 	middleware *httpserver.JSONAPIResponseMiddleware,
@@ -49,6 +59,14 @@ func HandleGETFetchVesselByIDV1HTTP(
 			return
 		}
 
+		rawUnit := httpserver.FetchStringQueryParamValue(r.URL.Query(), unitsQueryParam, "")
+		unit, unitErr := domainweight.NewUnitOrDefault(rawUnit, domainweight.UnitKilogram)
+		if unitErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid units provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, unitErr)
+			return
+		}
+
 		query := &vesselqueries.FetchVesselByIDQuery{ID: vesselID}
 
 		result, err := bus.DispatchWithResponse[*vesselqueries.FetchVesselByIDQuery, vesselqueries.VesselResponse](
@@ -57,7 +75,8 @@ func HandleGETFetchVesselByIDV1HTTP(
 
 		switch {
 		case err == nil:
-			middleware.WriteResponse(r.Context(), w, newFetchVesselByIDResponse(result), http.StatusOK)
+			w.Header().Set(httpserver.HeaderETag, httpserver.VersionETag(result.Version))
+			middleware.WriteResponse(r.Context(), w, newFetchVesselByIDResponse(result, unit), http.StatusOK)
 		case vesseldomain.IsVesselNotExistsError(err):
 			res, statusCode := jsonapiresponse.NewNotFound("vessel not found"), http.StatusNotFound
 			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
@@ -0,0 +1,108 @@
+package vesselentrypoint
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	vesselqueries "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/queries"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const (
+	trackFromQueryParam   = "from"
+	trackToQueryParam     = "to"
+	trackPointsQueryParam = "points"
+)
+
+type VesselPosition struct {
+	ID         string    `jsonapi:"primary,vessel_position"`
+	Latitude   float64   `jsonapi:"attr,latitude"`
+	Longitude  float64   `jsonapi:"attr,longitude"`
+	RecordedAt time.Time `jsonapi:"attr,recorded_at,rfc3339"`
+}
+
+func newVesselTrack(positions []vesselqueries.VesselPositionResponse) []*VesselPosition {
+	track := make([]*VesselPosition, 0, len(positions))
+	for _, p := range positions {
+		track = append(track, &VesselPosition{
+			ID:         p.ID,
+			Latitude:   p.Latitude,
+			Longitude:  p.Longitude,
+			RecordedAt: p.RecordedAt,
+		})
+	}
+
+	return track
+}
+
+func HandleGETFetchVesselTrackV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := newFetchVesselTrackQuery(r)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest(err.Error()), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		result, err := bus.DispatchWithResponse[*vesselqueries.FetchVesselTrackQuery, vesselqueries.VesselTrackResponse](
+			queryBus,
+		)(r.Context(), query)
+
+		switch {
+		case err == nil:
+			middleware.WriteCollectionResponse(r.Context(), w, newVesselTrack(result.Positions), nil, http.StatusOK)
+		case vesseldomain.IsVesselNotExistsError(err):
+			res, statusCode := jsonapiresponse.NewNotFound("vessel not found"), http.StatusNotFound
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, vesseldomain.ErrInvalidVesselIDProvided):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid vessel id provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, vesselpositiondomain.ErrInvalidTrackCriteria):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid track criteria provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		case errors.Is(err, vesselpositiondomain.ErrTrackTooLong):
+			res, statusCode := jsonapiresponse.NewBadRequest("track too long, downsample it with points"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
+
+func newFetchVesselTrackQuery(r *http.Request) (*vesselqueries.FetchVesselTrackQuery, error) {
+	values := r.URL.Query()
+
+	from, err := httpserver.FetchTimeQueryParamValue(values, trackFromQueryParam)
+	if err != nil {
+		return nil, errors.New("invalid from provided, RFC3339 expected")
+	}
+
+	to, err := httpserver.FetchTimeQueryParamValue(values, trackToQueryParam)
+	if err != nil {
+		return nil, errors.New("invalid to provided, RFC3339 expected")
+	}
+
+	points, err := httpserver.FetchUintQueryParamValue(values, trackPointsQueryParam, 0)
+	if err != nil {
+		return nil, errors.New("invalid points provided")
+	}
+
+	return &vesselqueries.FetchVesselTrackQuery{
+		ID:             mux.Vars(r)["vessel_id"],
+		RecordedAtFrom: from,
+		RecordedAtTo:   to,
+		Points:         points,
+	}, nil
+}
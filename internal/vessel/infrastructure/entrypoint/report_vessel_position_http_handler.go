@@ -0,0 +1,59 @@
+package vesselentrypoint
+
+import (
+	"net/http"
+
+	"github.com/google/jsonapi"
+	"github.com/gorilla/mux"
+
+	vesselcommands "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/commands"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+type ReportVesselPositionRequest struct {
+	ID        string  `jsonapi:"primary,vessel_position"`
+	Latitude  float64 `jsonapi:"attr,latitude"`
+	Longitude float64 `jsonapi:"attr,longitude"`
+}
+
+func HandlePOSTReportVesselPositionV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vesselID := mux.Vars(r)["vessel_id"]
+		if vesselID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("vessel_id is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, vesseldomain.ErrInvalidVesselIDProvided)
+			return
+		}
+
+		var req ReportVesselPositionRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		cmd := &vesselcommands.ReportVesselPositionCommand{
+			ID:        vesselID,
+			Latitude:  req.Latitude,
+			Longitude: req.Longitude,
+		}
+
+		err := bus.DispatchBlocking(commandBus, mutex)(r.Context(), cmd)
+		if err != nil {
+			res, statusCode := newVesselErrorResponse(err)
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,107 @@
+package vesselentrypoint
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	vesselqueries "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/queries"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const (
+	nearFilterQueryParam        = "filter[near]"
+	boundingBoxFilterQueryParam = "filter[bbox]"
+	pageSizeQueryParam          = "page[size]"
+)
+
+type SearchVesselResponse struct {
+	ID           string    `jsonapi:"primary,vessel"`
+	Name         string    `jsonapi:"attr,name"`
+	Capacity     float64   `jsonapi:"attr,capacity"`
+	CapacityUnit string    `jsonapi:"attr,capacity_unit"`
+	Latitude     float64   `jsonapi:"attr,latitude"`
+	Longitude    float64   `jsonapi:"attr,longitude"`
+	MMSI         *string   `jsonapi:"attr,mmsi,omitempty"`
+	DistanceKm   float64   `jsonapi:"attr,distance_km"`
+	CreatedAt    time.Time `jsonapi:"attr,created_at"`
+	UpdatedAt    time.Time `jsonapi:"attr,updated_at"`
+}
+
+func newSearchVesselsResponse(resp vesselqueries.VesselsResponse, unit domainweight.Unit) []*SearchVesselResponse {
+	vessels := make([]*SearchVesselResponse, len(resp.Items))
+	for i, item := range resp.Items {
+		vessels[i] = &SearchVesselResponse{
+			ID:           item.Vessel.ID,
+			Name:         item.Vessel.Name,
+			Capacity:     domainweight.FromKilograms(item.Vessel.Capacity).In(unit),
+			CapacityUnit: unit.String(),
+			Latitude:     item.Vessel.Latitude,
+			Longitude:    item.Vessel.Longitude,
+			MMSI:         item.Vessel.MMSI,
+			DistanceKm:   item.DistanceKm,
+			CreatedAt:    item.Vessel.CreatedAt,
+			UpdatedAt:    item.Vessel.UpdatedAt,
+		}
+	}
+
+	return vessels
+}
+
+func HandleGETSearchVesselsV1HTTP(
+	queryBus querybus.Bus,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, unit, err := newSearchVesselsQuery(r)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest(err.Error()), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		result, err := bus.DispatchWithResponse[*vesselqueries.SearchVesselsQuery, vesselqueries.VesselsResponse](queryBus)(
+			r.Context(),
+			query,
+		)
+
+		switch {
+		case err == nil:
+			middleware.WriteCollectionResponse(r.Context(), w, newSearchVesselsResponse(result, unit), nil, http.StatusOK)
+		case errors.Is(err, vesseldomain.ErrInvalidVesselSearchCriteria):
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid vessel search criteria provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+		}
+	}
+}
+
+func newSearchVesselsQuery(r *http.Request) (*vesselqueries.SearchVesselsQuery, domainweight.Unit, error) {
+	values := r.URL.Query()
+
+	near := httpserver.FetchStringQueryParamValue(values, nearFilterQueryParam, "")
+	boundingBox := httpserver.FetchStringQueryParamValue(values, boundingBoxFilterQueryParam, "")
+	if near == "" && boundingBox == "" {
+		return nil, "", errors.New("either a near or a bbox filter is required")
+	}
+
+	pageSize, err := httpserver.FetchUintQueryParamValue(values, pageSizeQueryParam, vesseldomain.DefaultVesselSearchPageSize)
+	if err != nil {
+		return nil, "", errors.New("invalid page size provided")
+	}
+
+	rawUnit := httpserver.FetchStringQueryParamValue(values, unitsQueryParam, "")
+	unit, err := domainweight.NewUnitOrDefault(rawUnit, domainweight.UnitKilogram)
+	if err != nil {
+		return nil, "", errors.New("invalid units provided")
+	}
+
+	return &vesselqueries.SearchVesselsQuery{Near: near, BoundingBox: boundingBox, PageSize: pageSize}, unit, nil
+}
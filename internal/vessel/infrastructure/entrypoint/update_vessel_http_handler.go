@@ -0,0 +1,73 @@
+package vesselentrypoint
+
+import (
+	"net/http"
+
+	"github.com/google/jsonapi"
+	"github.com/gorilla/mux"
+
+	vesselcommands "github.com/soulcodex/deus-cargo-tracker/internal/vessel/application/commands"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	commandbus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/command"
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+// UpdateVesselRequest holds the attributes to change, the ones not sent are kept.
+type UpdateVesselRequest struct {
+	Name      *string                `jsonapi:"attr,name"`
+	Capacity  *VesselCapacityRequest `jsonapi:"attr,capacity"`
+	Latitude  *float64               `jsonapi:"attr,latitude"`
+	Longitude *float64               `jsonapi:"attr,longitude"`
+	MMSI      *string                `jsonapi:"attr,mmsi"`
+}
+
+func HandlePATCHUpdateVesselV1HTTP(
+	commandBus commandbus.Bus,
+	mutex distributedsync.MutexService,
+	middleware *httpserver.JSONAPIResponseMiddleware,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vesselID := mux.Vars(r)["vessel_id"]
+		if vesselID == "" {
+			res, statusCode := jsonapiresponse.NewBadRequest("vessel_id is required"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, vesseldomain.ErrInvalidVesselIDProvided)
+			return
+		}
+
+		expectedVersion, ifMatchErr := httpserver.FetchIfMatchVersion(r)
+		if ifMatchErr != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid If-Match header provided"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, ifMatchErr)
+			return
+		}
+
+		var req UpdateVesselRequest
+		if err := jsonapi.UnmarshalPayload(r.Body, &req); err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		cmd := &vesselcommands.UpdateVesselCommand{
+			ID:              vesselID,
+			Name:            req.Name,
+			Capacity:        (*vesselcommands.VesselCapacity)(req.Capacity),
+			Latitude:        req.Latitude,
+			Longitude:       req.Longitude,
+			MMSI:            req.MMSI,
+			ExpectedVersion: expectedVersion,
+		}
+
+		err := bus.DispatchMultiBlocking(commandBus, mutex)(r.Context(), cmd)
+		if err != nil {
+			res, statusCode := newVesselErrorResponse(err)
+			middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+
+		middleware.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+	}
+}
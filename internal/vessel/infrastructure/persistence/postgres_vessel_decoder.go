@@ -21,14 +21,16 @@ func newPostgresVesselDecoder() postgres.DecodeFunc[*vesseldomain.Vessel] {
 			capacity     uint64
 			latitude     float64
 			longitude    float64
+			rawMMSI      sql.NullString
 			createdAt    time.Time
 			updatedAt    time.Time
 			rawDeletedAt sql.NullTime
+			version      uint64
 		)
 
 		err := rows.Scan(
-			&id, &name, &capacity, &latitude, &longitude,
-			&createdAt, &updatedAt, &rawDeletedAt,
+			&id, &name, &capacity, &latitude, &longitude, &rawMMSI,
+			&createdAt, &updatedAt, &rawDeletedAt, &version,
 		)
 		if err != nil {
 			return nil, ErrScanningVesselRow.Wrap(err)
@@ -39,15 +41,22 @@ func newPostgresVesselDecoder() postgres.DecodeFunc[*vesseldomain.Vessel] {
 			deletedAt = &rawDeletedAt.Time
 		}
 
+		var mmsi *string
+		if rawMMSI.Valid {
+			mmsi = &rawMMSI.String
+		}
+
 		primitives := vesseldomain.VesselPrimitives{
 			ID:        id,
 			Name:      name,
 			Capacity:  capacity,
 			Latitude:  latitude,
 			Longitude: longitude,
+			MMSI:      mmsi,
 			CreatedAt: createdAt,
 			UpdatedAt: updatedAt,
 			DeletedAt: deletedAt,
+			Version:   version,
 		}
 
 		return vesseldomain.NewVesselFromPrimitives(primitives), nil
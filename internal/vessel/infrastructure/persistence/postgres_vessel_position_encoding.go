@@ -0,0 +1,52 @@
+package vesselpersistence
+
+import (
+	"database/sql"
+	"time"
+
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+)
+
+var (
+	ErrScanningVesselPositionRow = errutil.NewError("error scanning vessel position row")
+)
+
+func newPostgresVesselPositionDecoder() postgres.DecodeFunc[vesselpositiondomain.Position] {
+	return func(rows *sql.Rows) (vesselpositiondomain.Position, error) {
+		var (
+			id         string
+			vesselID   string
+			latitude   float64
+			longitude  float64
+			recordedAt time.Time
+		)
+
+		if err := rows.Scan(&id, &vesselID, &latitude, &longitude, &recordedAt); err != nil {
+			return vesselpositiondomain.Position{}, ErrScanningVesselPositionRow.Wrap(err)
+		}
+
+		return vesselpositiondomain.NewPositionFromPrimitives(vesselpositiondomain.PositionPrimitives{
+			ID:         id,
+			VesselID:   vesselID,
+			Latitude:   latitude,
+			Longitude:  longitude,
+			RecordedAt: recordedAt,
+		}), nil
+	}
+}
+
+func newPostgresVesselPositionEncoder(vesselID string) postgres.EncodeFunc[vesselpositiondomain.Position] {
+	return func(position vesselpositiondomain.Position) ([]any, error) {
+		primitives := vesselpositiondomain.NewPositionPrimitives(vesselID, position)
+
+		return []any{
+			primitives.ID,
+			primitives.VesselID,
+			primitives.Latitude,
+			primitives.Longitude,
+			primitives.RecordedAt,
+		}, nil
+	}
+}
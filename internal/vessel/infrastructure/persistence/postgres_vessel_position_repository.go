@@ -0,0 +1,139 @@
+package vesselpersistence
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb"
+)
+
+// trackSamplingFactor is how many positions per requested point are read at most before downsampling.
+const trackSamplingFactor = 4
+
+var (
+	_ vesseldomain.VesselTrackReader = (*postgresVesselPositionRepository)(nil)
+
+	ErrFetchingVesselPositionRows = errutil.NewError("error fetching vessel position rows")
+	ErrRunningVesselPositionQuery = errutil.NewError("error running vessel position query")
+	ErrSavingVesselPositions      = errutil.NewError("error saving vessel positions to the database")
+)
+
+type postgresVesselPositionRepository struct {
+	tableName string
+	pool      sqldb.ConnectionPool
+	fields    []string
+}
+
+// NewPostgresVesselTrackReader returns the reader of the positions stored along the vessels.
+func NewPostgresVesselTrackReader(schema string, pool sqldb.ConnectionPool) vesseldomain.VesselTrackReader {
+	return newPostgresVesselPositionRepository(schema, pool)
+}
+
+func newPostgresVesselPositionRepository(schema string, pool sqldb.ConnectionPool) *postgresVesselPositionRepository {
+	return &postgresVesselPositionRepository{
+		tableName: schema + "." + "vessel_positions",
+		pool:      pool,
+		fields: []string{
+			"id",
+			"vessel_id",
+			"latitude",
+			"longitude",
+			"recorded_at",
+		},
+	}
+}
+
+func (r *postgresVesselPositionRepository) FindTrack(
+	ctx context.Context,
+	id vesseldomain.VesselID,
+	criteria *vesselpositiondomain.TrackCriteria,
+) (vesselpositiondomain.Track, error) {
+	// Backed by the vessel_positions_idx_vessel_id_recorded_at index, which also covers the ordering.
+	positions := sq.Select(r.fields...).
+		From(r.tableName).
+		Where(sq.Eq{"vessel_id": id}).
+		Where(sq.GtOrEq{"recorded_at": *criteria.RecordedAtFrom}).
+		Where(sq.LtOrEq{"recorded_at": *criteria.RecordedAtTo})
+
+	query := positions.OrderBy("recorded_at ASC", "id ASC").Limit(vesselpositiondomain.MaxTrackPoints + 1)
+	if criteria.Points > 0 {
+		query = r.sampledTrackQuery(positions, criteria.Points)
+	}
+
+	rows, err := query.PlaceholderFormat(sq.Dollar).RunWith(r.pool.Reader()).QueryContext(ctx)
+	if err != nil {
+		return nil, ErrRunningVesselPositionQuery.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	decoder := newPostgresVesselPositionDecoder()
+	track := vesselpositiondomain.NewEmptyTrack()
+	for rows.Next() {
+		position, decodeErr := decoder(rows)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		track = append(track, position)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, ErrFetchingVesselPositionRows.Wrap(rowsErr)
+	}
+
+	if criteria.Points == 0 && uint64(len(track)) > vesselpositiondomain.MaxTrackPoints {
+		return nil, vesselpositiondomain.ErrTrackTooLong
+	}
+
+	return track, nil
+}
+
+// sampledTrackQuery keeps every nth position along with the last one, so at most a few times the
+// requested points are read and downsampled afterwards instead of the whole range.
+func (r *postgresVesselPositionRepository) sampledTrackQuery(positions sq.SelectBuilder, points uint64) sq.SelectBuilder {
+	sampled := points * trackSamplingFactor
+	indexed := positions.Columns(
+		"ROW_NUMBER() OVER (ORDER BY recorded_at ASC, id ASC) - 1 AS position_index",
+		"COUNT(*) OVER () AS positions_count",
+	)
+
+	return sq.Select(r.fields...).
+		FromSelect(indexed, "track").
+		Where(sq.Or{
+			sq.Expr("position_index % ((positions_count + ? - 1) / ?) = 0", sampled, sampled),
+			sq.Expr("position_index = positions_count - 1"),
+		}).
+		OrderBy("position_index ASC")
+}
+
+func (r *postgresVesselPositionRepository) save(
+	ctx context.Context,
+	tx *sql.Tx,
+	id vesseldomain.VesselID,
+	track vesselpositiondomain.Track,
+) error {
+	if len(track) == 0 {
+		return nil
+	}
+
+	queryBuilder := sq.Insert(r.tableName).Columns(r.fields...).PlaceholderFormat(sq.Dollar)
+	encodeFunc := newPostgresVesselPositionEncoder(id.String())
+
+	for _, position := range track {
+		values, encodeErr := encodeFunc(position)
+		if encodeErr != nil {
+			return encodeErr
+		}
+		queryBuilder = queryBuilder.Values(values...)
+	}
+
+	if _, err := queryBuilder.RunWith(tx).ExecContext(ctx); err != nil {
+		return ErrSavingVesselPositions.Wrap(err)
+	}
+
+	return nil
+}
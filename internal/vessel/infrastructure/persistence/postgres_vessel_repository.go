@@ -2,6 +2,9 @@ package vesselpersistence
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strconv"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
@@ -12,6 +15,14 @@ import (
 	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
 )
 
+const mmsiUniqueIndex = "vessels_uidx_mmsi"
+
+// haversineDistanceKm is the great-circle distance in kilometers from the stored location to the one
+// given as latitude and longitude arguments, the same vesseldomain.Location DistanceTo computes.
+var haversineDistanceKm = "2 * " + strconv.FormatFloat(vesseldomain.EarthRadiusKm, 'f', -1, 64) + " * ASIN(LEAST(1, SQRT(" +
+	"POWER(SIN(RADIANS(latitude - ?) / 2), 2) + " +
+	"COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2))))"
+
 var (
 	_ vesseldomain.VesselRepository = (*PostgresVesselRepository)(nil)
 
@@ -26,6 +37,7 @@ type PostgresVesselRepository struct {
 	decoder      postgres.DecodeFunc[*vesseldomain.Vessel]
 	errorHandler *postgres.ErrorHandler
 	fields       []string
+	positionRepo *postgresVesselPositionRepository
 }
 
 func NewPostgresVesselRepository(schema string, pool sqldb.ConnectionPool) *PostgresVesselRepository {
@@ -43,23 +55,82 @@ func NewPostgresVesselRepository(schema string, pool sqldb.ConnectionPool) *Post
 			"capacity",
 			"latitude",
 			"longitude",
+			"mmsi",
 			"created_at",
 			"updated_at",
 			"deleted_at",
+			"version",
 		},
 		errorHandler: postgres.NewErrorHandler(errorHandlers),
+		positionRepo: newPostgresVesselPositionRepository(schema, pool),
 	}
 }
 
 func (r *PostgresVesselRepository) Find(ctx context.Context, id vesseldomain.VesselID) (*vesseldomain.Vessel, error) {
-	rows, err := r.vesselSelectBuilder(1, sq.Eq{"id": id}).RunWith(r.pool.Reader()).QueryContext(ctx)
+	return r.findOne(ctx, sq.Eq{"id": id}, vesseldomain.NewVesselNotExistsError(id))
+}
+
+// FindByMMSI is backed by the vessels_uidx_mmsi index.
+func (r *PostgresVesselRepository) FindByMMSI(ctx context.Context, mmsi vesseldomain.MMSI) (*vesseldomain.Vessel, error) {
+	return r.findOne(ctx, sq.Eq{"mmsi": mmsi}, vesseldomain.NewVesselWithMMSINotExistsError(mmsi))
+}
+
+// Search narrows the vessels to the bounding boxes first, backed by the vessels_idx_latitude_longitude
+// index, before computing the exact distances.
+func (r *PostgresVesselRepository) Search(
+	ctx context.Context,
+	criteria *vesseldomain.VesselSearchCriteria,
+) ([]*vesseldomain.Vessel, error) {
+	wheres := make([]sq.Sqlizer, 0)
+	if criteria.Near != nil {
+		center := criteria.Near.Center
+		wheres = append(
+			wheres,
+			boundingBoxCondition(criteria.Near.Bounds()),
+			sq.Expr(haversineDistanceKm+" <= ?", center.Latitude(), center.Latitude(), center.Longitude(), criteria.Near.RadiusKm),
+		)
+	}
+
+	if criteria.BoundingBox != nil {
+		wheres = append(wheres, boundingBoxCondition(*criteria.BoundingBox))
+	}
+
+	origin := criteria.Origin()
+	query := r.vesselSelectBuilder(criteria.PageSize, wheres...).
+		OrderByClause(haversineDistanceKm+" ASC", origin.Latitude(), origin.Latitude(), origin.Longitude()).
+		OrderBy("id ASC")
+
+	rows, err := query.RunWith(r.pool.Reader()).QueryContext(ctx)
+	if err != nil {
+		return nil, ErrRunningQuery.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	vessels := make([]*vesseldomain.Vessel, 0, criteria.PageSize)
+	for rows.Next() {
+		v, decodeErr := r.decoder(rows)
+		if decodeErr != nil {
+			return nil, ErrFetchingVesselRows.Wrap(decodeErr)
+		}
+		vessels = append(vessels, v)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, ErrFetchingVesselRows.Wrap(rowsErr)
+	}
+
+	return vessels, nil
+}
+
+func (r *PostgresVesselRepository) findOne(ctx context.Context, where sq.Eq, notFoundErr error) (*vesseldomain.Vessel, error) {
+	rows, err := r.vesselSelectBuilder(1, where).RunWith(r.pool.Reader()).QueryContext(ctx)
 	if err != nil {
 		return nil, ErrRunningQuery.Wrap(err)
 	}
 	defer func() { _ = rows.Close() }()
 
 	if !rows.Next() {
-		return nil, vesseldomain.NewVesselNotExistsError(id)
+		return nil, notFoundErr
 	}
 
 	if rowsErr := rows.Err(); rowsErr != nil {
@@ -75,9 +146,44 @@ func (r *PostgresVesselRepository) Find(ctx context.Context, id vesseldomain.Ves
 }
 
 func (r *PostgresVesselRepository) Save(ctx context.Context, v *vesseldomain.Vessel) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		return r.save(ctx, tx, v)
+	})
+}
+
+func (r *PostgresVesselRepository) SavePosition(ctx context.Context, v *vesseldomain.Vessel) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		return r.savePosition(ctx, tx, v)
+	})
+}
+
+func (r *PostgresVesselRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, txErr := r.pool.Writer().BeginTx(ctx, nil)
+	if txErr != nil {
+		return ErrSavingVessel.Wrap(txErr)
+	}
+
+	if saveErr := fn(tx); saveErr != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return ErrSavingVessel.Wrap(rbErr)
+		}
+
+		return ErrSavingVessel.Wrap(saveErr)
+	}
+
+	if commitErr := tx.Commit(); commitErr != nil {
+		return ErrSavingVessel.Wrap(commitErr)
+	}
+
+	return nil
+}
+
+func (r *PostgresVesselRepository) save(ctx context.Context, tx *sql.Tx, v *vesseldomain.Vessel) error {
 	primitives := v.Primitives()
 
-	query := sq.Insert(r.tableName).
+	// The stored vessel is only overwritten when it's still on the version the vessel was loaded with,
+	// otherwise nothing is affected and someone else saved it in between.
+	query := sq.Insert(r.tableName+" AS stored").
 		Columns(r.fields...).
 		Values(
 			primitives.ID,
@@ -85,35 +191,112 @@ func (r *PostgresVesselRepository) Save(ctx context.Context, v *vesseldomain.Ves
 			primitives.Capacity,
 			primitives.Latitude,
 			primitives.Longitude,
+			primitives.MMSI,
 			primitives.CreatedAt,
 			primitives.UpdatedAt,
 			primitives.DeletedAt,
+			primitives.Version+1,
 		).Suffix("ON CONFLICT (id) DO UPDATE SET " +
 		"name = EXCLUDED.name, " +
 		"capacity = EXCLUDED.capacity, " +
 		"latitude = EXCLUDED.latitude, " +
 		"longitude = EXCLUDED.longitude, " +
+		"mmsi = EXCLUDED.mmsi, " +
 		"updated_at = EXCLUDED.updated_at, " +
-		"deleted_at = EXCLUDED.deleted_at",
+		"deleted_at = EXCLUDED.deleted_at, " +
+		"version = EXCLUDED.version " +
+		"WHERE stored.version = EXCLUDED.version - 1",
 	).PlaceholderFormat(sq.Dollar)
 
-	_, err := query.RunWith(r.pool.Writer()).ExecContext(ctx)
+	result, err := query.RunWith(tx).ExecContext(ctx)
 	if err != nil {
 		if pgError, match := postgres.IsPostgresError(err); match {
-			return ErrSavingVessel.Wrap(r.errorHandler.Handle(v, pgError))
+			return r.errorHandler.Handle(v, pgError)
 		}
 
-		return ErrSavingVessel.Wrap(err)
+		return err
 	}
 
-	return nil
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		// A vessel never saved before can only clash with an existing one.
+		if v.Version() == 0 {
+			return vesseldomain.NewVesselAlreadyExistsError(v.ID())
+		}
+
+		return vesseldomain.NewVesselVersionConflictError(v.ID(), v.Version())
+	}
+
+	return r.positionRepo.save(ctx, tx, v.ID(), v.Positions())
 }
 
-func (r *PostgresVesselRepository) vesselSelectBuilder(limit uint64, wheres ...sq.Eq) sq.SelectBuilder {
+func (r *PostgresVesselRepository) savePosition(ctx context.Context, tx *sql.Tx, v *vesseldomain.Vessel) error {
+	primitives, positions := v.Primitives(), v.Positions()
+	if len(positions) == 0 {
+		return nil
+	}
+
+	// Positions may be replayed long after they were recorded, so the vessel is only moved when no later
+	// position was stored already. The version and the last update are kept as they are, the location is
+	// the only vessel attribute a position report changes.
+	laterPosition := sq.Select("1").
+		From(r.positionRepo.tableName).
+		Where(sq.Eq{"vessel_id": primitives.ID}).
+		Where(sq.Gt{"recorded_at": positions[len(positions)-1].RecordedAt()}).
+		Prefix("NOT EXISTS (").
+		Suffix(")")
+
+	query := sq.Update(r.tableName).
+		Set("latitude", primitives.Latitude).
+		Set("longitude", primitives.Longitude).
+		Where(sq.Eq{"id": primitives.ID, "deleted_at": nil}).
+		Where(laterPosition).
+		PlaceholderFormat(sq.Dollar)
+
+	result, err := query.RunWith(tx).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		// Nothing moved, either because the position is older than the stored ones or because the vessel is gone.
+		if existsErr := r.ensureExists(ctx, tx, v.ID()); existsErr != nil {
+			return existsErr
+		}
+	}
+
+	return r.positionRepo.save(ctx, tx, v.ID(), positions)
+}
+
+func (r *PostgresVesselRepository) ensureExists(ctx context.Context, tx *sql.Tx, id vesseldomain.VesselID) error {
+	query := sq.Select("1").
+		From(r.tableName).
+		Where(sq.Eq{"id": id.String(), "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	var found int
+	err := query.RunWith(tx).QueryRowContext(ctx).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return vesseldomain.NewVesselNotExistsError(id)
+	}
+
+	return err
+}
+
+func (r *PostgresVesselRepository) vesselSelectBuilder(limit uint64, wheres ...sq.Sqlizer) sq.SelectBuilder {
 	qb := sq.Select(r.fields...).From(r.tableName).Limit(limit).PlaceholderFormat(sq.Dollar)
 
 	if wheres == nil {
-		wheres = make([]sq.Eq, 0)
+		wheres = make([]sq.Sqlizer, 0)
 	}
 
 	wheres = append(wheres, sq.Eq{"deleted_at": nil})
@@ -125,8 +308,26 @@ func (r *PostgresVesselRepository) vesselSelectBuilder(limit uint64, wheres ...s
 	return qb
 }
 
+// boundingBoxCondition matches the locations within the box, wrapping around when it crosses the antimeridian.
+func boundingBoxCondition(box vesseldomain.BoundingBox) sq.Sqlizer {
+	var longitudes sq.Sqlizer = sq.And{sq.GtOrEq{"longitude": box.West()}, sq.LtOrEq{"longitude": box.East()}}
+	if box.CrossesAntimeridian() {
+		longitudes = sq.Or{sq.GtOrEq{"longitude": box.West()}, sq.LtOrEq{"longitude": box.East()}}
+	}
+
+	return sq.And{
+		sq.GtOrEq{"latitude": box.South()},
+		sq.LtOrEq{"latitude": box.North()},
+		longitudes,
+	}
+}
+
 func uniqueViolationPostgresVesselRepoErrorHandler() postgres.ErrorHandlerFunc {
 	return func(resource interface{}, err *pq.Error) error {
+		if err.Constraint == mmsiUniqueIndex {
+			return vesseldomain.ErrMMSIAlreadyAssigned.Wrap(err)
+		}
+
 		switch res := resource.(type) {
 		case *vesseldomain.Vessel:
 			return vesseldomain.NewVesselAlreadyExistsError(res.ID()).Wrap(err)
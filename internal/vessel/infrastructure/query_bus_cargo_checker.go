@@ -0,0 +1,75 @@
+package vesselinfra
+
+import (
+	"context"
+
+	cargoqueries "github.com/soulcodex/deus-cargo-tracker/internal/cargo/application/queries"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	querybus "github.com/soulcodex/deus-cargo-tracker/pkg/bus/query"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+var (
+	_ vesseldomain.VesselCargoChecker = (*QueryBusCargoChecker)(nil)
+
+	ErrCheckingVesselCargoes = errutil.NewError("error checking vessel cargoes")
+)
+
+type QueryBusCargoChecker struct {
+	queryBus querybus.Bus
+}
+
+func NewQueryBusCargoChecker(queryBus querybus.Bus) *QueryBusCargoChecker {
+	return &QueryBusCargoChecker{queryBus: queryBus}
+}
+
+func (q *QueryBusCargoChecker) HasActiveCargoes(ctx context.Context, id vesseldomain.VesselID) (bool, error) {
+	statuses, err := q.activeStatuses(ctx)
+	if err != nil {
+		return false, ErrCheckingVesselCargoes.Wrap(err)
+	}
+
+	// A single cargo is enough to know the vessel still carries some.
+	query := &cargoqueries.SearchCargoes{VesselID: id.String(), Statuses: statuses, PageSize: 1}
+	cargoes, err := bus.DispatchWithResponse[*cargoqueries.SearchCargoes, cargoqueries.CargoesResponse](q.queryBus)(
+		ctx,
+		query,
+	)
+	if err != nil {
+		return false, ErrCheckingVesselCargoes.Wrap(err)
+	}
+
+	return len(cargoes.Items) > 0, nil
+}
+
+// activeStatuses returns the statuses which aren't final in the cargo status transitions.
+func (q *QueryBusCargoChecker) activeStatuses(ctx context.Context) ([]string, error) {
+	response, err := bus.DispatchWithResponse[*cargoqueries.FetchCargoStatuses, cargoqueries.CargoStatusesResponse](
+		q.queryBus,
+	)(ctx, &cargoqueries.FetchCargoStatuses{})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]string, 0, len(response.Items))
+	for _, status := range response.Items {
+		if !status.Final {
+			statuses = append(statuses, status.Status)
+		}
+	}
+
+	return statuses, nil
+}
+
+func (q *QueryBusCargoChecker) ActiveCargoWeight(ctx context.Context, id vesseldomain.VesselID) (uint64, error) {
+	query := &cargoqueries.FetchVesselCargoWeight{VesselID: id.String()}
+	response, err := bus.DispatchWithResponse[*cargoqueries.FetchVesselCargoWeight, cargoqueries.VesselCargoWeightResponse](
+		q.queryBus,
+	)(ctx, query)
+	if err != nil {
+		return 0, ErrCheckingVesselCargoes.Wrap(err)
+	}
+
+	return response.Weight, nil
+}
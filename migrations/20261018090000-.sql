@@ -0,0 +1,4 @@
+-- +migrate Up
+ALTER TABLE cargoes_tracking ADD COLUMN details JSONB DEFAULT NULL;
+-- +migrate Down
+ALTER TABLE cargoes_tracking DROP COLUMN IF EXISTS details;
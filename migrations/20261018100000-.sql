@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE cargoes_tracking ADD COLUMN vessel_latitude DOUBLE PRECISION DEFAULT NULL;
+ALTER TABLE cargoes_tracking ADD COLUMN vessel_longitude DOUBLE PRECISION DEFAULT NULL;
+-- +migrate Down
+ALTER TABLE cargoes_tracking DROP COLUMN IF EXISTS vessel_longitude;
+ALTER TABLE cargoes_tracking DROP COLUMN IF EXISTS vessel_latitude;
@@ -0,0 +1,10 @@
+-- +migrate Up
+ALTER TABLE cargoes ADD COLUMN shipper JSONB DEFAULT NULL;
+ALTER TABLE cargoes ADD COLUMN consignee JSONB DEFAULT NULL;
+CREATE INDEX cargoes_idx_shipper_name ON cargoes (LOWER(shipper ->> 'name'));
+CREATE INDEX cargoes_idx_shipper_email ON cargoes (LOWER(shipper ->> 'email'));
+-- +migrate Down
+DROP INDEX IF EXISTS cargoes_idx_shipper_email;
+DROP INDEX IF EXISTS cargoes_idx_shipper_name;
+ALTER TABLE cargoes DROP COLUMN IF EXISTS consignee;
+ALTER TABLE cargoes DROP COLUMN IF EXISTS shipper;
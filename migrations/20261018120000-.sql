@@ -0,0 +1,6 @@
+-- +migrate Up
+DROP INDEX IF EXISTS cargoes_tracking_idx_cargo_id;
+CREATE INDEX cargoes_tracking_idx_cargo_id ON cargoes_tracking (cargo_id, created_at, id);
+-- +migrate Down
+DROP INDEX IF EXISTS cargoes_tracking_idx_cargo_id;
+CREATE INDEX cargoes_tracking_idx_cargo_id ON cargoes_tracking (cargo_id);
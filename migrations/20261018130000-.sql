@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE cargoes ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
+CREATE INDEX cargoes_idx_metadata ON cargoes USING GIN (metadata jsonb_path_ops);
+-- +migrate Down
+DROP INDEX IF EXISTS cargoes_idx_metadata;
+ALTER TABLE cargoes DROP COLUMN IF EXISTS metadata;
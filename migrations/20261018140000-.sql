@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE cargoes ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE vessels ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +migrate Down
+ALTER TABLE vessels DROP COLUMN IF EXISTS version;
+ALTER TABLE cargoes DROP COLUMN IF EXISTS version;
@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE vessel_positions
+(
+    id          VARCHAR(50)      PRIMARY KEY,
+    vessel_id   VARCHAR(50)      NOT NULL,
+    latitude    DOUBLE PRECISION NOT NULL CHECK (latitude >= -90 AND latitude <= 90),
+    longitude   DOUBLE PRECISION NOT NULL CHECK (longitude >= -180 AND longitude <= 180),
+    recorded_at TIMESTAMPTZ      NOT NULL
+);
+CREATE INDEX vessel_positions_idx_vessel_id_recorded_at ON vessel_positions (vessel_id, recorded_at, id);
+-- +migrate Down
+DROP INDEX IF EXISTS vessel_positions_idx_vessel_id_recorded_at;
+DROP TABLE IF EXISTS vessel_positions;
@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE vessels ADD COLUMN mmsi VARCHAR(9) DEFAULT NULL;
+CREATE UNIQUE INDEX vessels_uidx_mmsi ON vessels (mmsi) WHERE deleted_at IS NULL;
+-- +migrate Down
+DROP INDEX IF EXISTS vessels_uidx_mmsi;
+ALTER TABLE vessels DROP COLUMN IF EXISTS mmsi;
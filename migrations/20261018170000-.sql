@@ -0,0 +1,4 @@
+-- +migrate Up
+CREATE INDEX vessels_idx_latitude_longitude ON vessels (latitude, longitude) WHERE deleted_at IS NULL;
+-- +migrate Down
+DROP INDEX IF EXISTS vessels_idx_latitude_longitude;
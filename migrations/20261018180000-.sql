@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE ports
+(
+    locode    VARCHAR(5)       PRIMARY KEY,
+    name      VARCHAR(100)     NOT NULL,
+    country   CHAR(2)          NOT NULL,
+    latitude  DOUBLE PRECISION NOT NULL CHECK (latitude >= -90 AND latitude <= 90),
+    longitude DOUBLE PRECISION NOT NULL CHECK (longitude >= -180 AND longitude <= 180),
+    time_zone VARCHAR(64)      NOT NULL
+);
+CREATE INDEX ports_idx_country ON ports (country);
+CREATE INDEX ports_idx_lower_name ON ports (LOWER(name) text_pattern_ops);
+-- +migrate Down
+DROP TABLE IF EXISTS ports;
@@ -0,0 +1,76 @@
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedMessage indicates the message type is not one of the decoded ones.
+	ErrUnsupportedMessage = errors.New("unsupported message")
+	// ErrUnexpectedFragment indicates a fragment arrived out of order, the message it belongs to is dropped.
+	ErrUnexpectedFragment = errors.New("unexpected fragment")
+)
+
+// pendingMessage holds the fragments of a multi-sentence message received so far.
+type pendingMessage struct {
+	fragments int
+	received  int
+	payload   strings.Builder
+}
+
+// Decoder decodes the !AIVDM sentences of a single stream, reassembling the messages spread
+// over several fragments. It's not safe for concurrent use, every stream needs its own.
+type Decoder struct {
+	pending map[string]*pendingMessage
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{pending: make(map[string]*pendingMessage)}
+}
+
+// Decode feeds a sentence to the decoder and returns the message it completes, nil when the
+// sentence is a fragment of a message still waiting for the rest.
+func (d *Decoder) Decode(line string) (Message, error) {
+	s, err := parseSentence(line)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.fragments == 1 {
+		return d.decodePayload(s.payload, s.fillBits)
+	}
+
+	// Fragments of the same message share their sequential id and channel.
+	key := s.sequenceID + "/" + s.channel
+	pending, found := d.pending[key]
+
+	if s.fragment == 1 {
+		pending = &pendingMessage{fragments: s.fragments}
+		d.pending[key] = pending
+	} else if !found || pending.fragments != s.fragments || pending.received+1 != s.fragment {
+		delete(d.pending, key)
+		return nil, fmt.Errorf("%w: fragment %d of %d", ErrUnexpectedFragment, s.fragment, s.fragments)
+	}
+
+	pending.received = s.fragment
+	pending.payload.WriteString(s.payload)
+
+	if pending.received < pending.fragments {
+		return nil, nil //nolint:nilnil // the message still waits for its remaining fragments
+	}
+
+	delete(d.pending, key)
+
+	return d.decodePayload(pending.payload.String(), s.fillBits)
+}
+
+func (d *Decoder) decodePayload(payload string, fillBits int) (Message, error) {
+	b, err := dearmour(payload, fillBits)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMessage(b)
+}
@@ -0,0 +1,100 @@
+package ais_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/ais"
+)
+
+func TestDecoder_DecodePositionReport(t *testing.T) {
+	decoder := ais.NewDecoder()
+
+	msg, err := decoder.Decode("!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C")
+	require.NoError(t, err)
+
+	report, ok := msg.(ais.PositionReport)
+	require.True(t, ok, "expected a position report")
+	assert.Equal(t, uint8(1), report.Type)
+	assert.Equal(t, uint32(477553000), report.MMSI())
+	assert.Equal(t, uint8(5), report.NavigationStatus)
+	require.NotNil(t, report.Latitude)
+	require.NotNil(t, report.Longitude)
+	assert.InDelta(t, 47.582833, *report.Latitude, 0.000001)
+	assert.InDelta(t, -122.345833, *report.Longitude, 0.000001)
+	require.NotNil(t, report.SpeedOverGround)
+	assert.InDelta(t, 0.0, *report.SpeedOverGround, 0)
+	require.NotNil(t, report.CourseOverGround)
+	assert.InDelta(t, 51.0, *report.CourseOverGround, 0)
+	require.NotNil(t, report.Heading)
+	assert.Equal(t, uint16(181), *report.Heading)
+}
+
+func TestDecoder_DecodeFragmentedStaticVoyageData(t *testing.T) {
+	decoder := ais.NewDecoder()
+
+	msg, err := decoder.Decode("!AIVDM,2,1,1,A,55?MbV02;H;s<HtKR20EHE:0@T4@Dn2222222216L961O5Gf0NSQEp6ClRp8,0*1C")
+	require.NoError(t, err)
+	assert.Nil(t, msg, "no message expected until every fragment is received")
+
+	msg, err = decoder.Decode("!AIVDM,2,2,1,A,88888888880,2*25")
+	require.NoError(t, err)
+
+	data, ok := msg.(ais.StaticVoyageData)
+	require.True(t, ok, "expected static and voyage related data")
+	assert.Equal(t, uint32(351759000), data.MMSI())
+	assert.Equal(t, uint32(9134270), data.IMO)
+	assert.Equal(t, "3FOF8", data.CallSign)
+	assert.Equal(t, "EVER DIADEM", data.Name)
+	assert.Equal(t, uint8(70), data.ShipType)
+	assert.Equal(t, "NEW YORK", data.Destination)
+}
+
+func TestDecoder_DecodeFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		sentences   []string
+		expectedErr error
+	}{
+		{
+			name:        "should fail on sentences other than AIVDM",
+			sentences:   []string{"$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*47"},
+			expectedErr: ais.ErrUnsupportedSentence,
+		},
+		{
+			name:        "should fail when the checksum doesn't match",
+			sentences:   []string{"!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5D"},
+			expectedErr: ais.ErrChecksumMismatch,
+		},
+		{
+			name:        "should fail when the checksum is missing",
+			sentences:   []string{"!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0"},
+			expectedErr: ais.ErrMalformedSentence,
+		},
+		{
+			name:        "should fail when a fragment arrives without the previous ones",
+			sentences:   []string{"!AIVDM,2,2,1,A,88888888880,2*25"},
+			expectedErr: ais.ErrUnexpectedFragment,
+		},
+		{
+			name:        "should fail on message types not decoded",
+			sentences:   []string{"!AIVDM,1,1,,A,H42O55i18tMET00000000000000,2*6D"},
+			expectedErr: ais.ErrUnsupportedMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoder := ais.NewDecoder()
+
+			var err error
+			for _, s := range tt.sentences {
+				_, err = decoder.Decode(s)
+			}
+
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
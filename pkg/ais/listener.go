@@ -0,0 +1,179 @@
+package ais
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/logger"
+)
+
+// maxDatagramSize is the largest UDP payload, a datagram may carry several sentences.
+const maxDatagramSize = 65535
+
+// MessageHandler processes a decoded message. Its failures are logged and don't stop the stream.
+// recordedAt is the instant a recorded feed received the message at, nil when it's received live.
+type MessageHandler func(ctx context.Context, msg Message, recordedAt *time.Time) error
+
+// recordedSentence is a line of an NDJSON feed, which holds the raw sentence in its nmea field
+// and, optionally, the RFC 3339 instant it was received at in its timestamp field.
+type recordedSentence struct {
+	NMEA      string     `json:"nmea"`
+	Timestamp *time.Time `json:"timestamp"`
+}
+
+// Listener decodes the sentences received from the network or replayed from a recorded feed
+// and hands the messages over to the handler. Lines that can't be decoded are logged and skipped.
+type Listener struct {
+	handler MessageHandler
+	logger  logger.ZerologLogger
+}
+
+func NewListener(handler MessageHandler, logger logger.ZerologLogger) *Listener {
+	return &Listener{
+		handler: handler,
+		logger:  logger,
+	}
+}
+
+// Consume decodes the stream read line by line until it's exhausted or the context is done.
+// Lines are either raw sentences or NDJSON objects holding them, so recorded feeds are replayed
+// the same way live ones are consumed.
+func (l *Listener) Consume(ctx context.Context, r io.Reader) error {
+	decoder := NewDecoder()
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		l.process(ctx, decoder, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading ais stream: %w", err)
+	}
+
+	return nil
+}
+
+// ListenTCP consumes every connection accepted on the given address until the context is done,
+// each of them with its own decoder.
+func (l *Listener) ListenTCP(ctx context.Context, address string) error {
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("error listening ais on tcp %s: %w", address, err)
+	}
+
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	stop := context.AfterFunc(ctx, func() { _ = listener.Close() })
+	defer stop()
+
+	for {
+		conn, acceptErr := listener.Accept()
+		if acceptErr != nil {
+			if ctx.Err() != nil || errors.Is(acceptErr, net.ErrClosed) {
+				return nil
+			}
+
+			return fmt.Errorf("error accepting ais connection: %w", acceptErr)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			closeConn := context.AfterFunc(ctx, func() { _ = conn.Close() })
+			defer func() {
+				closeConn()
+				_ = conn.Close()
+			}()
+
+			if consumeErr := l.Consume(ctx, conn); consumeErr != nil && ctx.Err() == nil {
+				l.logger.Warn().Err(consumeErr).Str("ais.remote", conn.RemoteAddr().String()).Msg("ais connection closed")
+			}
+		}()
+	}
+}
+
+// ListenUDP consumes the datagrams received on the given address until the context is done,
+// each sender with its own decoder so fragments sharing a sequential id aren't mixed up.
+func (l *Listener) ListenUDP(ctx context.Context, address string) error {
+	conn, err := (&net.ListenConfig{}).ListenPacket(ctx, "udp", address)
+	if err != nil {
+		return fmt.Errorf("error listening ais on udp %s: %w", address, err)
+	}
+
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer func() {
+		stop()
+		_ = conn.Close()
+	}()
+
+	decoders := make(map[string]*Decoder)
+	buffer := make([]byte, maxDatagramSize)
+
+	for {
+		n, addr, readErr := conn.ReadFrom(buffer)
+		if readErr != nil {
+			if ctx.Err() != nil || errors.Is(readErr, net.ErrClosed) {
+				return nil
+			}
+
+			return fmt.Errorf("error reading ais datagram: %w", readErr)
+		}
+
+		decoder, found := decoders[addr.String()]
+		if !found {
+			decoder = NewDecoder()
+			decoders[addr.String()] = decoder
+		}
+
+		for _, line := range bytes.Split(buffer[:n], []byte("\n")) {
+			l.process(ctx, decoder, string(line))
+		}
+	}
+}
+
+func (l *Listener) process(ctx context.Context, decoder *Decoder, line string) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+
+	var recordedAt *time.Time
+	if strings.HasPrefix(line, "{") {
+		var recorded recordedSentence
+		if err := json.Unmarshal([]byte(line), &recorded); err != nil {
+			l.logger.Warn().Ctx(ctx).Err(err).Str("ais.line", line).Msg("skipping malformed ais record")
+			return
+		}
+
+		line, recordedAt = recorded.NMEA, recorded.Timestamp
+	}
+
+	msg, err := decoder.Decode(line)
+	if err != nil {
+		l.logger.Debug().Ctx(ctx).Err(err).Str("ais.sentence", line).Msg("skipping ais sentence")
+		return
+	}
+
+	if msg == nil {
+		return
+	}
+
+	if handleErr := l.handler(ctx, msg, recordedAt); handleErr != nil {
+		l.logger.Error().Ctx(ctx).Err(handleErr).Uint32("ais.mmsi", msg.MMSI()).Msg("error handling ais message")
+	}
+}
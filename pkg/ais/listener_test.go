@@ -0,0 +1,130 @@
+package ais_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/ais"
+)
+
+func TestListener_Consume(t *testing.T) {
+	feed := strings.Join([]string{
+		"!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C",
+		"",
+		"not a sentence",
+		`{"nmea": "!AIVDM,2,1,1,A,55?MbV02;H;s<HtKR20EHE:0@T4@Dn2222222216L961O5Gf0NSQEp6ClRp8,0*1C"}`,
+		`{"nmea": "!AIVDM,2,2,1,A,88888888880,2*25"}`,
+		"!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C",
+	}, "\n")
+
+	received := make([]ais.Message, 0)
+	handler := func(_ context.Context, msg ais.Message, _ *time.Time) error {
+		received = append(received, msg)
+		return errors.New("handler failures don't stop the stream")
+	}
+
+	listener := ais.NewListener(handler, zerolog.Nop())
+	err := listener.Consume(t.Context(), strings.NewReader(feed))
+	require.NoError(t, err)
+
+	require.Len(t, received, 3)
+	assert.IsType(t, ais.PositionReport{}, received[0])
+	assert.IsType(t, ais.StaticVoyageData{}, received[1])
+	assert.IsType(t, ais.PositionReport{}, received[2])
+}
+
+func TestListener_ConsumeRecordedTimestamps(t *testing.T) {
+	feed := strings.Join([]string{
+		`{"nmea": "!AIVDM,1,1,,B,177KQJ5000G?tO` + "`" + `K>RA1wUbN0TKH,0*5C", "timestamp": "2024-03-01T10:15:00Z"}`,
+		`{"nmea": "!AIVDM,1,1,,B,177KQJ5000G?tO` + "`" + `K>RA1wUbN0TKH,0*5C"}`,
+		"!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C",
+	}, "\n")
+
+	recordedAts := make([]*time.Time, 0)
+	handler := func(_ context.Context, _ ais.Message, recordedAt *time.Time) error {
+		recordedAts = append(recordedAts, recordedAt)
+		return nil
+	}
+
+	listener := ais.NewListener(handler, zerolog.Nop())
+	err := listener.Consume(t.Context(), strings.NewReader(feed))
+	require.NoError(t, err)
+
+	require.Len(t, recordedAts, 3)
+	require.NotNil(t, recordedAts[0])
+	assert.True(t, recordedAts[0].Equal(time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)))
+	assert.Nil(t, recordedAts[1], "records without timestamp are handled as live ones")
+	assert.Nil(t, recordedAts[2], "raw sentences are received live")
+}
+
+func TestListener_ListenUDPDecodesEachSenderApart(t *testing.T) {
+	probe, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := probe.LocalAddr().String()
+	require.NoError(t, probe.Close())
+
+	received := make(chan ais.Message, 16)
+	handler := func(_ context.Context, msg ais.Message, _ *time.Time) error {
+		received <- msg
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	listened := make(chan error, 1)
+	go func() { listened <- ais.NewListener(handler, zerolog.Nop()).ListenUDP(ctx, address) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-listened)
+	})
+
+	senders := make([]net.Conn, 2)
+	for i := range senders {
+		senders[i], err = net.Dial("udp", address)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = senders[i].Close() })
+	}
+
+	// Wait for the listener to be up before interleaving the fragments.
+	require.Eventually(t, func() bool {
+		_, _ = senders[0].Write([]byte("!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C"))
+		select {
+		case <-received:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// Both stations send a message with the same sequential id and channel at the same time.
+	fragments := []string{
+		"!AIVDM,2,1,1,A,55?MbV02;H;s<HtKR20EHE:0@T4@Dn2222222216L961O5Gf0NSQEp6ClRp8,0*1C",
+		"!AIVDM,2,2,1,A,88888888880,2*25",
+	}
+	for _, fragment := range fragments {
+		for _, sender := range senders {
+			_, err = sender.Write([]byte(fragment))
+			require.NoError(t, err)
+		}
+	}
+
+	decoded := 0
+	timeout := time.After(2 * time.Second)
+	for decoded < len(senders) {
+		select {
+		case msg := <-received:
+			if _, match := msg.(ais.StaticVoyageData); match {
+				decoded++
+			}
+		case <-timeout:
+			require.Fail(t, "expected the message of every sender to be decoded", "decoded %d", decoded)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package ais
+
+import (
+	"fmt"
+)
+
+const (
+	positionReportBits   = 168
+	staticVoyageDataBits = 424
+
+	// Coordinates are given in 1/10000 minutes, 181 and 91 degrees meaning not available.
+	coordinateScale       = 600000.0
+	longitudeNotAvailable = 181 * 600000
+	latitudeNotAvailable  = 91 * 600000
+
+	// Speed and course are given in tenths of knot and degree.
+	tenthsScale         = 10.0
+	speedNotAvailable   = 1023
+	courseNotAvailable  = 3600
+	headingNotAvailable = 511
+)
+
+// Fields shared by every message.
+var (
+	messageTypeField = field{start: 0, length: 6}
+	userIDField      = field{start: 8, length: 30}
+)
+
+// Position report fields, message types 1, 2 and 3.
+var (
+	navigationStatusField = field{start: 38, length: 4}
+	speedField            = field{start: 50, length: 10}
+	longitudeField        = field{start: 61, length: 28}
+	latitudeField         = field{start: 89, length: 27}
+	courseField           = field{start: 116, length: 12}
+	headingField          = field{start: 128, length: 9}
+)
+
+// Static and voyage related data fields, message type 5.
+var (
+	imoField         = field{start: 40, length: 30}
+	callSignField    = field{start: 70, length: 42}
+	nameField        = field{start: 112, length: 120}
+	shipTypeField    = field{start: 232, length: 8}
+	destinationField = field{start: 302, length: 120}
+)
+
+// Message is a decoded AIS message.
+type Message interface {
+	MMSI() uint32
+}
+
+// PositionReport is a class A position report, message types 1, 2 and 3.
+type PositionReport struct {
+	Type             uint8
+	UserID           uint32
+	NavigationStatus uint8
+	// Latitude and Longitude are in degrees, nil when the vessel doesn't know its position.
+	Latitude  *float64
+	Longitude *float64
+	// SpeedOverGround is in knots, nil when not available.
+	SpeedOverGround *float64
+	// CourseOverGround is in degrees, nil when not available.
+	CourseOverGround *float64
+	// Heading is the true heading in degrees, nil when not available.
+	Heading *uint16
+}
+
+func (r PositionReport) MMSI() uint32 {
+	return r.UserID
+}
+
+// StaticVoyageData describes the vessel and its voyage, message type 5.
+type StaticVoyageData struct {
+	UserID      uint32
+	IMO         uint32
+	CallSign    string
+	Name        string
+	ShipType    uint8
+	Destination string
+}
+
+func (d StaticVoyageData) MMSI() uint32 {
+	return d.UserID
+}
+
+func decodeMessage(b bits) (Message, error) {
+	if len(b) < messageTypeField.length {
+		return nil, fmt.Errorf("%w: empty message", ErrInvalidPayload)
+	}
+
+	switch messageType := b.uint(messageTypeField); messageType {
+	case 1, 2, 3:
+		return decodePositionReport(b)
+	case 5: //nolint:mnd // static and voyage related data
+		return decodeStaticVoyageData(b)
+	default:
+		return nil, fmt.Errorf("%w: message type %d", ErrUnsupportedMessage, messageType)
+	}
+}
+
+func decodePositionReport(b bits) (PositionReport, error) {
+	if len(b) < positionReportBits {
+		return PositionReport{}, fmt.Errorf("%w: %d bits found, %d expected", ErrInvalidPayload, len(b), positionReportBits)
+	}
+
+	report := PositionReport{
+		Type:             uint8(b.uint(messageTypeField)),
+		UserID:           uint32(b.uint(userIDField)),
+		NavigationStatus: uint8(b.uint(navigationStatusField)),
+	}
+
+	longitude, latitude := b.int(longitudeField), b.int(latitudeField)
+	if longitude != longitudeNotAvailable && latitude != latitudeNotAvailable {
+		lon, lat := float64(longitude)/coordinateScale, float64(latitude)/coordinateScale
+		report.Longitude, report.Latitude = &lon, &lat
+	}
+
+	if speed := b.uint(speedField); speed != speedNotAvailable {
+		knots := float64(speed) / tenthsScale
+		report.SpeedOverGround = &knots
+	}
+
+	if course := b.uint(courseField); course != courseNotAvailable {
+		degrees := float64(course) / tenthsScale
+		report.CourseOverGround = &degrees
+	}
+
+	if heading := b.uint(headingField); heading != headingNotAvailable {
+		degrees := uint16(heading)
+		report.Heading = &degrees
+	}
+
+	return report, nil
+}
+
+func decodeStaticVoyageData(b bits) (StaticVoyageData, error) {
+	// The trailing spare bit is often left out by transmitters.
+	if len(b) < staticVoyageDataBits-1 {
+		return StaticVoyageData{}, fmt.Errorf("%w: %d bits found, %d expected", ErrInvalidPayload, len(b), staticVoyageDataBits)
+	}
+
+	return StaticVoyageData{
+		UserID:      uint32(b.uint(userIDField)),
+		IMO:         uint32(b.uint(imoField)),
+		CallSign:    b.text(callSignField),
+		Name:        b.text(nameField),
+		ShipType:    uint8(b.uint(shipTypeField)),
+		Destination: b.text(destinationField),
+	}, nil
+}
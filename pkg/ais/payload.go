@@ -0,0 +1,92 @@
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	bitsPerCharacter = 6
+	// The armoured alphabet skips the 8 characters between W and `.
+	armourGapStart = 40
+	armourGapSize  = 8
+	// 6-bit text characters below 32 map to the @ to _ range.
+	textUpperStart  = 32
+	textUpperOffset = 64
+)
+
+// ErrInvalidPayload indicates the armoured payload holds characters out of the 6-bit alphabet
+// or it's too short for the message it carries.
+var ErrInvalidPayload = errors.New("invalid payload")
+
+// field locates a value within the message bits.
+type field struct {
+	start  int
+	length int
+}
+
+// bits holds a dearmoured payload, one bit per byte to keep field extraction simple.
+type bits []byte
+
+// dearmour turns the 6-bit ASCII armoured payload into its bits, dropping the fill bits.
+func dearmour(payload string, fillBits int) (bits, error) {
+	decoded := make(bits, 0, len(payload)*bitsPerCharacter)
+	for i := range len(payload) {
+		c := payload[i]
+		if c < '0' || c > 'w' || (c > 'W' && c < '`') {
+			return nil, fmt.Errorf("%w: unexpected character %q", ErrInvalidPayload, c)
+		}
+
+		value := c - '0'
+		if value > armourGapStart {
+			value -= armourGapSize
+		}
+
+		for shift := bitsPerCharacter - 1; shift >= 0; shift-- {
+			decoded = append(decoded, (value>>shift)&1)
+		}
+	}
+
+	if fillBits > len(decoded) {
+		return nil, fmt.Errorf("%w: more fill bits than payload bits", ErrInvalidPayload)
+	}
+
+	return decoded[:len(decoded)-fillBits], nil
+}
+
+func (b bits) uint(f field) uint64 {
+	var value uint64
+	for _, bit := range b[f.start : f.start+f.length] {
+		value = value<<1 | uint64(bit)
+	}
+
+	return value
+}
+
+// int reads a two's complement signed field.
+func (b bits) int(f field) int64 {
+	value := b.uint(f)
+	if b[f.start] == 1 {
+		return int64(value) - int64(1)<<f.length
+	}
+
+	return int64(value)
+}
+
+// text reads a field of 6-bit characters, trimming the @ padding and trailing spaces.
+func (b bits) text(f field) string {
+	var builder strings.Builder
+	for offset := 0; offset+bitsPerCharacter <= f.length; offset += bitsPerCharacter {
+		c := byte(b.uint(field{start: f.start + offset, length: bitsPerCharacter}))
+		if c < textUpperStart {
+			c += textUpperOffset
+		}
+
+		builder.WriteByte(c)
+	}
+
+	text, _, _ := strings.Cut(builder.String(), "@")
+
+	return strings.TrimRight(text, " ")
+}
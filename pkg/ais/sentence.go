@@ -0,0 +1,92 @@
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	sentencePrefix = "!AIVDM"
+	sentenceFields = 7
+	checksumDigits = 2
+	maxFillBits    = 5
+)
+
+var (
+	// ErrUnsupportedSentence indicates the line is not an !AIVDM sentence.
+	ErrUnsupportedSentence = errors.New("unsupported sentence")
+	// ErrMalformedSentence indicates the sentence fields can't be parsed.
+	ErrMalformedSentence = errors.New("malformed sentence")
+	// ErrChecksumMismatch indicates the sentence checksum doesn't match its content.
+	ErrChecksumMismatch = errors.New("sentence checksum mismatch")
+)
+
+// sentence is a single !AIVDM line, one fragment of an encapsulated message.
+type sentence struct {
+	fragments  int
+	fragment   int
+	sequenceID string
+	channel    string
+	payload    string
+	fillBits   int
+}
+
+// parseSentence validates the checksum and splits an !AIVDM line into its fields, e.g.
+// !AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C
+func parseSentence(line string) (sentence, error) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, sentencePrefix+",") {
+		return sentence{}, ErrUnsupportedSentence
+	}
+
+	body, rawChecksum, found := strings.Cut(line[1:], "*")
+	if !found || len(rawChecksum) != checksumDigits {
+		return sentence{}, fmt.Errorf("%w: missing checksum", ErrMalformedSentence)
+	}
+
+	expected, err := strconv.ParseUint(rawChecksum, 16, 8) //nolint:mnd // a hexadecimal byte
+	if err != nil {
+		return sentence{}, fmt.Errorf("%w: invalid checksum %q", ErrMalformedSentence, rawChecksum)
+	}
+
+	if checksum(body) != byte(expected) {
+		return sentence{}, ErrChecksumMismatch
+	}
+
+	fields := strings.Split(body, ",")
+	if len(fields) != sentenceFields {
+		return sentence{}, fmt.Errorf("%w: %d fields found, %d expected", ErrMalformedSentence, len(fields), sentenceFields)
+	}
+
+	fragments, fragmentsErr := strconv.Atoi(fields[1])
+	fragment, fragmentErr := strconv.Atoi(fields[2])
+	fillBits, fillBitsErr := strconv.Atoi(fields[6])
+	if err = errors.Join(fragmentsErr, fragmentErr, fillBitsErr); err != nil {
+		return sentence{}, fmt.Errorf("%w: %w", ErrMalformedSentence, err)
+	}
+
+	if fragments < 1 || fragment < 1 || fragment > fragments || fillBits < 0 || fillBits > maxFillBits {
+		return sentence{}, fmt.Errorf("%w: fragment %d of %d with %d fill bits", ErrMalformedSentence, fragment, fragments, fillBits)
+	}
+
+	return sentence{
+		fragments:  fragments,
+		fragment:   fragment,
+		sequenceID: fields[3],
+		channel:    fields[4],
+		payload:    fields[5],
+		fillBits:   fillBits,
+	}, nil
+}
+
+// checksum is the XOR of every character between the leading ! and the *.
+func checksum(body string) byte {
+	var sum byte
+	for i := range len(body) {
+		sum ^= body[i]
+	}
+
+	return sum
+}
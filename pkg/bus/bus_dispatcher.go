@@ -2,6 +2,7 @@ package bus
 
 import (
 	"context"
+	"slices"
 
 	dsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
@@ -9,6 +10,7 @@ import (
 
 type DispatchWithOutputFunc[Input Dto, Output any] func(context.Context, Input) (Output, error)
 type DispatchBlockingFunc func(context.Context, BlockingDto) error
+type DispatchMultiBlockingFunc func(context.Context, MultiBlockingDto) error
 type DispatchFunc func(context.Context, Dto) error
 
 func DispatchWithResponse[Input Dto, Output any](bus Bus) DispatchWithOutputFunc[Input, Output] {
@@ -54,6 +56,35 @@ func DispatchBlocking(bus Bus, mutex dsync.MutexService) DispatchBlockingFunc {
 	}
 }
 
+// DispatchMultiBlocking holds every input blocking key while it's handled. Keys are
+// deduplicated and always acquired in the same order so overlapping inputs can't deadlock.
+func DispatchMultiBlocking(bus Bus, mutex dsync.MutexService) DispatchMultiBlockingFunc {
+	return func(ctx context.Context, input MultiBlockingDto) error {
+		handler, err := bus.GetHandler(input)
+		if err != nil {
+			return ErrNoHandlerForInput(input, err)
+		}
+
+		operation := func() (interface{}, error) {
+			return handler.Handle(ctx, input)
+		}
+
+		keys := slices.Compact(slices.Sorted(slices.Values(input.BlockingKeys())))
+		for _, key := range slices.Backward(keys) {
+			locked := operation
+			operation = func() (interface{}, error) {
+				return mutex.Mutex(ctx, key, locked)
+			}
+		}
+
+		if _, blockingErr := operation(); blockingErr != nil {
+			return ErrUnprocessableHandler(input, blockingErr)
+		}
+
+		return nil
+	}
+}
+
 func Dispatch(bus Bus) DispatchFunc {
 	return func(ctx context.Context, input Dto) error {
 		handler, err := bus.GetHandler(input)
@@ -8,3 +8,9 @@ type BlockingDto interface {
 	Dto
 	BlockingKey() string
 }
+
+// MultiBlockingDto is meant for inputs touching several resources at once, e.g. batches.
+type MultiBlockingDto interface {
+	Dto
+	BlockingKeys() []string
+}
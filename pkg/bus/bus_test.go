@@ -32,6 +32,10 @@ func (fkd *FakeDto) BlockingKey() string {
 	return fkd.FakeID
 }
 
+func (fkd *FakeDto) BlockingKeys() []string {
+	return []string{fkd.FakeID, "another_fake_dto_id", fkd.FakeID}
+}
+
 func newFakeDto() *FakeDto {
 	return &FakeDto{FakeID: "fake_dto_id"}
 }
@@ -46,6 +50,10 @@ func (fu *FakeUnregisteredDto) BlockingKey() string {
 	return "fake_unregistered_dto_id"
 }
 
+func (fu *FakeUnregisteredDto) BlockingKeys() []string {
+	return []string{fu.BlockingKey()}
+}
+
 func newFakeUnregisteredDto() *FakeUnregisteredDto {
 	return &FakeUnregisteredDto{}
 }
@@ -209,6 +217,34 @@ func Test_SyncBus_DispatchBlocking(t *testing.T) {
 	}
 }
 
+func Test_SyncBus_DispatchMultiBlocking(t *testing.T) {
+	for _, scenario := range dispatchScenarios()[1:] {
+		t.Run(scenario.name, func(t *testing.T) {
+			syncBus, dto := scenario.bus(t), scenario.input()
+			mutex := &distributedsyncmock.MutexServiceMock{}
+			mutex.MutexFunc = func(_ context.Context, _ string, fn dsync.MutexCallback) (interface{}, error) {
+				return fn()
+			}
+
+			err := bus.DispatchMultiBlocking(syncBus, mutex)(
+				context.Background(),
+				dto.(bus.MultiBlockingDto),
+			)
+
+			if scenarioErr := scenario.err(dto, err); scenarioErr != nil {
+				require.Error(t, err)
+				require.IsType(t, scenarioErr, err)
+				assert.Empty(t, mutex.MutexCalls())
+			} else {
+				require.NoError(t, err)
+				require.Len(t, mutex.MutexCalls(), 2)
+				assert.Equal(t, "another_fake_dto_id", mutex.MutexCalls()[0].Key)
+				assert.Equal(t, "fake_dto_id", mutex.MutexCalls()[1].Key)
+			}
+		})
+	}
+}
+
 func Test_SyncBus_Dispatch(t *testing.T) {
 	for _, scenario := range dispatchScenarios()[1:] {
 		t.Run(scenario.name, func(t *testing.T) {
@@ -23,6 +23,9 @@ var _ distributedsync.MutexService = &MutexServiceMock{}
 //			MutexFunc: func(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error) {
 //				panic("mock out the Mutex method")
 //			},
+//			TryMutexFunc: func(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error) {
+//				panic("mock out the TryMutex method")
+//			},
 //		}
 //
 //		// use mockedMutexService in code that requires distributedsync.MutexService
@@ -33,6 +36,9 @@ type MutexServiceMock struct {
 	// MutexFunc mocks the Mutex method.
 	MutexFunc func(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error)
 
+	// TryMutexFunc mocks the TryMutex method.
+	TryMutexFunc func(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Mutex holds details about calls to the Mutex method.
@@ -44,8 +50,18 @@ type MutexServiceMock struct {
 			// Fn is the fn argument value.
 			Fn distributedsync.MutexCallback
 		}
+		// TryMutex holds details about calls to the TryMutex method.
+		TryMutex []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Fn is the fn argument value.
+			Fn distributedsync.MutexCallback
+		}
 	}
-	lockMutex sync.RWMutex
+	lockMutex    sync.RWMutex
+	lockTryMutex sync.RWMutex
 }
 
 // Mutex calls MutexFunc.
@@ -87,3 +103,43 @@ func (mock *MutexServiceMock) MutexCalls() []struct {
 	mock.lockMutex.RUnlock()
 	return calls
 }
+
+// TryMutex calls TryMutexFunc.
+func (mock *MutexServiceMock) TryMutex(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error) {
+	if mock.TryMutexFunc == nil {
+		panic("MutexServiceMock.TryMutexFunc: method is nil but MutexService.TryMutex was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+		Fn  distributedsync.MutexCallback
+	}{
+		Ctx: ctx,
+		Key: key,
+		Fn:  fn,
+	}
+	mock.lockTryMutex.Lock()
+	mock.calls.TryMutex = append(mock.calls.TryMutex, callInfo)
+	mock.lockTryMutex.Unlock()
+	return mock.TryMutexFunc(ctx, key, fn)
+}
+
+// TryMutexCalls gets all the calls that were made to TryMutex.
+// Check the length with:
+//
+//	len(mockedMutexService.TryMutexCalls())
+func (mock *MutexServiceMock) TryMutexCalls() []struct {
+	Ctx context.Context
+	Key string
+	Fn  distributedsync.MutexCallback
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+		Fn  distributedsync.MutexCallback
+	}
+	mock.lockTryMutex.RLock()
+	calls = mock.calls.TryMutex
+	mock.lockTryMutex.RUnlock()
+	return calls
+}
@@ -1,6 +1,10 @@
 package distributedsync
 
 import (
+	"errors"
+
+	"github.com/go-redsync/redsync/v4"
+
 	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
 )
 
@@ -34,3 +38,10 @@ func NewMutexUnlockingError(key string) *MutexUnlockingError {
 		),
 	}
 }
+
+// IsMutexHeldError reports whether a lock wasn't acquired because another process holds it,
+// rather than because of a failure reaching the lock store.
+func IsMutexHeldError(err error) bool {
+	var taken *redsync.ErrTaken
+	return errors.As(err, &taken) || errors.Is(err, redsync.ErrFailed)
+}
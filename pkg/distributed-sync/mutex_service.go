@@ -18,7 +18,12 @@ import (
 const mutexName = "distributed-sync-mutex"
 
 type MutexService interface {
+	// Mutex runs fn once the lock is acquired, waiting for it to be released when another process
+	// holds it. The lock is extended while fn runs.
 	Mutex(ctx context.Context, key string, fn MutexCallback) (interface{}, error)
+	// TryMutex runs fn only when the lock is acquired at the first attempt, failing with an error
+	// IsMutexHeldError tells apart when another process holds it. The lock is extended while fn runs.
+	TryMutex(ctx context.Context, key string, fn MutexCallback) (interface{}, error)
 }
 
 type MutexCallback func() (interface{}, error)
@@ -33,6 +38,8 @@ const (
 	redsyncDefaultExpiry        = 30 * time.Second
 	redsyncDefaultRetryDelay    = 250 * time.Millisecond
 	redsyncDefaultTimeoutFactor = 0.05
+	// redsyncExtensionsPerExpiry is how many times a held lock is extended within its expiry.
+	redsyncExtensionsPerExpiry = 3
 )
 
 func NewRedisMutexService(redisClient *redis.Client, logger logger.ZerologLogger, options ...MutexServiceOptFunc) *RedisMutexService {
@@ -60,7 +67,9 @@ func (rm *RedisMutexService) Mutex(ctx context.Context, key string, fn MutexCall
 		return nil, NewMutexLockingError(key).Wrap(lockingErr)
 	}
 
+	stopExtending := rm.extendWhileRunning(ctx, mutex)
 	result, err := fn()
+	stopExtending()
 
 	if _, unlockingErr := retry.Do(func() (interface{}, error) {
 		return nil, rm.releaseLock(ctx, mutex)
@@ -76,6 +85,63 @@ func (rm *RedisMutexService) Mutex(ctx context.Context, key string, fn MutexCall
 	return result, err
 }
 
+func (rm *RedisMutexService) TryMutex(ctx context.Context, key string, fn MutexCallback) (interface{}, error) {
+	mutex := rm.sync.NewMutex(
+		rm.lockingKey(key),
+		redsync.WithExpiry(redsyncDefaultExpiry),
+		redsync.WithTries(1),
+		redsync.WithTimeoutFactor(redsyncDefaultTimeoutFactor),
+	)
+
+	if lockingErr := mutex.LockContext(ctx); lockingErr != nil {
+		return nil, NewMutexLockingError(key).Wrap(lockingErr)
+	}
+
+	stopExtending := rm.extendWhileRunning(ctx, mutex)
+	result, err := fn()
+	stopExtending()
+
+	if unlockingErr := rm.releaseLock(ctx, mutex); unlockingErr != nil {
+		return nil, NewMutexUnlockingError(key).Wrap(unlockingErr)
+	}
+
+	return result, err
+}
+
+// extendWhileRunning keeps extending the lock before it expires until the returned func is called,
+// so a callback running longer than the expiry doesn't let another process acquire it meanwhile.
+func (rm *RedisMutexService) extendWhileRunning(ctx context.Context, mutex *redsync.Mutex) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(redsyncDefaultExpiry / redsyncExtensionsPerExpiry)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if ok, err := mutex.ExtendContext(ctx); !ok || err != nil {
+					rm.logger.Warn().
+						Ctx(ctx).
+						Err(err).
+						Str("mutex_key", mutex.Name()).
+						Msg("error extending mutex")
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
 func (rm *RedisMutexService) lockingKey(key string) string {
 	if rm.options.ServicePrefix != nil {
 		return mutexName + ":" + *rm.options.ServicePrefix + ":" + key
@@ -0,0 +1,117 @@
+package domainweight
+
+import (
+	"math"
+	"strings"
+
+	domainvalidation "github.com/soulcodex/deus-cargo-tracker/pkg/domain/validation"
+)
+
+const (
+	UnitGram     Unit = "g"
+	UnitKilogram Unit = "kg"
+	UnitTonne    Unit = "t"
+	UnitPound    Unit = "lb"
+
+	// displayPrecision is the number of decimals kept when a weight is expressed in a unit.
+	displayPrecision = 3
+)
+
+var (
+	gramsPerUnit = map[Unit]float64{
+		UnitGram:     1,
+		UnitKilogram: 1_000,
+		UnitTonne:    1_000_000,
+		UnitPound:    453.59237,
+	}
+
+	ErrInvalidWeightUnitProvided = domainvalidation.NewError("invalid weight unit provided")
+	ErrInvalidWeightProvided     = domainvalidation.NewError("invalid weight provided")
+)
+
+// Unit is a unit of measure for weights.
+type Unit string
+
+func NewUnit(raw string) (Unit, error) {
+	unit := Unit(strings.ToLower(strings.TrimSpace(raw)))
+
+	validator := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[Unit](),
+		func(u Unit) *domainvalidation.Error {
+			if _, ok := gramsPerUnit[u]; ok {
+				return nil
+			}
+
+			return domainvalidation.NewError("weight unit is not supported").
+				WithRuleName("weight_unit").
+				WithRuleValue(u.String()).
+				WithRuleExpectedValue([]string{"g", "kg", "t", "lb"})
+		},
+	)
+
+	if err := validator.Validate(unit); err != nil {
+		return "", ErrInvalidWeightUnitProvided.Wrap(err)
+	}
+
+	return unit, nil
+}
+
+// NewUnitOrDefault parses the given unit falling back to the default one when it's empty.
+func NewUnitOrDefault(raw string, fallback Unit) (Unit, error) {
+	if strings.TrimSpace(raw) == "" {
+		return fallback, nil
+	}
+
+	return NewUnit(raw)
+}
+
+func (u Unit) String() string {
+	return string(u)
+}
+
+// Weight is a mass normalised to grams, whatever the unit it was expressed in.
+type Weight struct {
+	grams uint64
+}
+
+// NewWeight normalises the given value expressed in the given unit, rounding to the closest gram.
+func NewWeight(value float64, rawUnit string) (Weight, error) {
+	unit, err := NewUnitOrDefault(rawUnit, UnitGram)
+	if err != nil {
+		return Weight{}, ErrInvalidWeightProvided.Wrap(err)
+	}
+
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return Weight{}, ErrInvalidWeightProvided.Wrap(
+			domainvalidation.NewError("weight value must be a positive number").
+				WithRuleName("weight_value").
+				WithRuleValue(value),
+		)
+	}
+
+	return Weight{grams: uint64(math.Round(value * gramsPerUnit[unit]))}, nil
+}
+
+func FromGrams(grams uint64) Weight {
+	return Weight{grams: grams}
+}
+
+func FromKilograms(kilograms uint64) Weight {
+	return Weight{grams: kilograms * uint64(gramsPerUnit[UnitKilogram])}
+}
+
+func (w Weight) Grams() uint64 {
+	return w.grams
+}
+
+// In expresses the weight in the given unit, rounded to three decimals.
+func (w Weight) In(unit Unit) float64 {
+	factor, ok := gramsPerUnit[unit]
+	if !ok {
+		factor = gramsPerUnit[UnitGram]
+	}
+
+	precision := math.Pow10(displayPrecision)
+
+	return math.Round(float64(w.grams)/factor*precision) / precision
+}
@@ -0,0 +1,52 @@
+package domainweight_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainweight "github.com/soulcodex/deus-cargo-tracker/pkg/domain/weight"
+)
+
+func TestNewWeight(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         float64
+		unit          string
+		expectedGrams uint64
+		expectedError bool
+	}{
+		{name: "should default to grams when unit is empty", value: 1500, unit: "", expectedGrams: 1500},
+		{name: "should normalise kilograms", value: 12.5, unit: "kg", expectedGrams: 12500},
+		{name: "should normalise tonnes", value: 0.25, unit: "t", expectedGrams: 250000},
+		{name: "should normalise pounds rounding to the closest gram", value: 10, unit: "lb", expectedGrams: 4536},
+		{name: "should accept units regardless of their case", value: 2, unit: " KG ", expectedGrams: 2000},
+		{name: "should fail when unit is unknown", value: 1, unit: "oz", expectedError: true},
+		{name: "should fail when value is negative", value: -1, unit: "kg", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weight, err := domainweight.NewWeight(tt.value, tt.unit)
+
+			if tt.expectedError {
+				require.ErrorIs(t, err, domainweight.ErrInvalidWeightProvided)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedGrams, weight.Grams())
+		})
+	}
+}
+
+func TestWeight_In(t *testing.T) {
+	weight := domainweight.FromGrams(3500)
+
+	assert.InDelta(t, 3500.0, weight.In(domainweight.UnitGram), 0)
+	assert.InDelta(t, 3.5, weight.In(domainweight.UnitKilogram), 0)
+	assert.InDelta(t, 0.004, weight.In(domainweight.UnitTonne), 0)
+	assert.InDelta(t, 7.716, weight.In(domainweight.UnitPound), 0)
+	assert.Equal(t, uint64(5_000_000), domainweight.FromKilograms(5000).Grams())
+}
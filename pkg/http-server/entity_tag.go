@@ -0,0 +1,46 @@
+package httpserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+
+	anyEntityTag = "*"
+)
+
+var (
+	ErrInvalidIfMatch = errutil.NewError("invalid if-match header provided")
+)
+
+// VersionETag is the entity tag of a resource on the given version.
+func VersionETag(version uint64) string {
+	return strconv.Quote(strconv.FormatUint(version, 10))
+}
+
+// FetchIfMatchVersion returns the resource version required by the If-Match header, nil when
+// the header is missing or matches any version. Only a single strong entity tag is supported.
+func FetchIfMatchVersion(r *http.Request) (*uint64, error) {
+	value := strings.TrimSpace(r.Header.Get(HeaderIfMatch))
+	if value == "" || value == anyEntityTag {
+		return nil, nil //nolint:nilnil // no version is required
+	}
+
+	tag, err := strconv.Unquote(value)
+	if err != nil {
+		return nil, ErrInvalidIfMatch.Wrap(err)
+	}
+
+	version, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidIfMatch.Wrap(err)
+	}
+
+	return &version, nil
+}
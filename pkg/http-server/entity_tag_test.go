@@ -0,0 +1,52 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+)
+
+func TestVersionETag(t *testing.T) {
+	assert.Equal(t, `"7"`, httpserver.VersionETag(7))
+}
+
+func TestFetchIfMatchVersion(t *testing.T) {
+	version := uint64(7)
+
+	tests := []struct {
+		name            string
+		ifMatch         string
+		expectedVersion *uint64
+		expectedErr     error
+	}{
+		{name: "should require no version when header is missing", ifMatch: ""},
+		{name: "should require no version when any version matches", ifMatch: "*"},
+		{name: "should require the tagged version", ifMatch: httpserver.VersionETag(version), expectedVersion: &version},
+		{name: "should fail when tag is not quoted", ifMatch: "7", expectedErr: httpserver.ErrInvalidIfMatch},
+		{name: "should fail when tag is weak", ifMatch: `W/"7"`, expectedErr: httpserver.ErrInvalidIfMatch},
+		{name: "should fail when tag is not a version", ifMatch: `"abc"`, expectedErr: httpserver.ErrInvalidIfMatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPatch, "/cargoes/1", nil)
+			if tt.ifMatch != "" {
+				r.Header.Set(httpserver.HeaderIfMatch, tt.ifMatch)
+			}
+
+			got, err := httpserver.FetchIfMatchVersion(r)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVersion, got)
+		})
+	}
+}
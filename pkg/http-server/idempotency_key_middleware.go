@@ -0,0 +1,219 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	distributedsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/errutil"
+	jsonapiresponse "github.com/soulcodex/deus-cargo-tracker/pkg/json-api/response"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotencyReplayed = "Idempotency-Replayed"
+
+	maxIdempotencyKeyLen = 255
+)
+
+var (
+	ErrInvalidIdempotencyKey = errutil.NewError("invalid idempotency key provided")
+	ErrIdempotencyKeyReused  = errutil.NewError("idempotency key already used by a different request")
+)
+
+// IdempotentResponse is a response recorded for an idempotency key along with the
+// fingerprint of the request which produced it.
+type IdempotentResponse struct {
+	Fingerprint string      `json:"fingerprint"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header"`
+	Body        []byte      `json:"body"`
+}
+
+// IdempotencyStore keeps the recorded responses by idempotency key until they expire.
+type IdempotencyStore interface {
+	Find(ctx context.Context, key string) (IdempotentResponse, bool, error)
+	Save(ctx context.Context, key string, response IdempotentResponse, ttl time.Duration) error
+}
+
+// IdempotencyKeyMiddleware makes the unsafe requests carrying an Idempotency-Key header safe to
+// retry. The first response, unless it's a server error, is recorded and replayed to every retry
+// with the same key and request, while reusing the key for a different request is rejected.
+// Concurrent duplicates are serialized so the request is only handled once, the lock on the key is
+// held, and extended, for as long as the first request is being handled.
+type IdempotencyKeyMiddleware struct {
+	store      IdempotencyStore
+	mutex      distributedsync.MutexService
+	ttl        time.Duration
+	middleware *JSONAPIResponseMiddleware
+}
+
+func NewIdempotencyKeyMiddleware(
+	store IdempotencyStore,
+	mutex distributedsync.MutexService,
+	ttl time.Duration,
+	middleware *JSONAPIResponseMiddleware,
+) *IdempotencyKeyMiddleware {
+	return &IdempotencyKeyMiddleware{
+		store:      store,
+		mutex:      mutex,
+		ttl:        ttl,
+		middleware: middleware,
+	}
+}
+
+func (ikm *IdempotencyKeyMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, provided := r.Header[http.CanonicalHeaderKey(HeaderIdempotencyKey)]
+		if !provided || isSafeMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) != 1 || key[0] == "" || len(key[0]) > maxIdempotencyKeyLen {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid idempotency key provided"), http.StatusBadRequest
+			ikm.middleware.WriteErrorResponse(r.Context(), w, res, statusCode, ErrInvalidIdempotencyKey)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			res, statusCode := jsonapiresponse.NewBadRequest("invalid received request"), http.StatusBadRequest
+			ikm.middleware.WriteErrorResponse(r.Context(), w, res, statusCode, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// The callback outcome is kept apart, a lock held by another request means a duplicate is
+		// still being handled while an unlock failure comes once the response is already written.
+		var served bool
+		var serveErr error
+		_, lockErr := ikm.mutex.Mutex(r.Context(), "idempotency_key:"+key[0], func() (interface{}, error) {
+			served, serveErr = true, ikm.serve(next, w, r, key[0], requestFingerprint(r, body))
+			return struct{}{}, serveErr
+		})
+
+		switch {
+		case !served && distributedsync.IsMutexHeldError(lockErr) && r.Context().Err() == nil:
+			res, statusCode := jsonapiresponse.NewConflict(
+				"a request with the same idempotency key is being processed",
+			), http.StatusConflict
+			ikm.middleware.WriteErrorResponse(r.Context(), w, res, statusCode, lockErr)
+		case !served:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			ikm.middleware.WriteErrorResponse(r.Context(), w, res, statusCode, lockErr)
+		case serveErr == nil:
+		case errors.Is(serveErr, ErrIdempotencyKeyReused):
+			res, statusCode := jsonapiresponse.NewUnprocessableEntity(
+				"idempotency key already used by a different request",
+			), http.StatusUnprocessableEntity
+			ikm.middleware.WriteErrorResponse(r.Context(), w, res, statusCode, serveErr)
+		default:
+			res, statusCode := jsonapiresponse.NewInternalServerError(), http.StatusInternalServerError
+			ikm.middleware.WriteErrorResponse(r.Context(), w, res, statusCode, serveErr)
+		}
+	})
+}
+
+// serve replays the response recorded for the key or handles the request recording its response,
+// an error is only returned when nothing was written yet.
+func (ikm *IdempotencyKeyMiddleware) serve(
+	next http.Handler,
+	w http.ResponseWriter,
+	r *http.Request,
+	key string,
+	fingerprint string,
+) error {
+	recorded, found, err := ikm.store.Find(r.Context(), key)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		if recorded.Fingerprint != fingerprint {
+			return ErrIdempotencyKeyReused
+		}
+
+		replay(w, recorded)
+		return nil
+	}
+
+	recorder := newResponseRecorder(w)
+	next.ServeHTTP(recorder, r)
+
+	// Server errors aren't recorded so the request can be retried once the failure is gone.
+	if recorder.Status() >= http.StatusInternalServerError {
+		return nil
+	}
+
+	response := IdempotentResponse{
+		Fingerprint: fingerprint,
+		StatusCode:  recorder.Status(),
+		Header:      recorder.Header().Clone(),
+		Body:        recorder.body.Bytes(),
+	}
+	response.Header.Del(HeaderRequestID)
+
+	if saveErr := ikm.store.Save(r.Context(), key, response, ikm.ttl); saveErr != nil {
+		ikm.middleware.logger.Error().
+			Ctx(r.Context()).
+			Err(saveErr).
+			Str("idempotency_key", key).
+			Msg("error saving idempotent response")
+	}
+
+	return nil
+}
+
+func replay(w http.ResponseWriter, recorded IdempotentResponse) {
+	for name, values := range recorded.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(HeaderIdempotencyReplayed, "true")
+	w.WriteHeader(recorded.StatusCode)
+	_, _ = w.Write(recorded.Body)
+}
+
+// requestFingerprint identifies a request by its method, target and body so a key reused
+// for a different request can be told apart from a retry.
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// responseRecorder writes the response through while keeping a copy of its body.
+type responseRecorder struct {
+	*StatusRecorder
+	body bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{StatusRecorder: NewStatusRecorder(w)}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.StatusRecorder.Write(b)
+}
+
+// Status returns the recorded status code, a handler writing nothing responds 200 OK.
+func (r *responseRecorder) Status() int {
+	if r.StatusCode == 0 {
+		return http.StatusOK
+	}
+
+	return r.StatusCode
+}
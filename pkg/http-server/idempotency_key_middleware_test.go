@@ -0,0 +1,243 @@
+package httpserver_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redsync/redsync/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dsync "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync"
+	distributedsyncmock "github.com/soulcodex/deus-cargo-tracker/pkg/distributed-sync/mock"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/logger"
+)
+
+type inMemoryIdempotencyStore struct {
+	responses map[string]httpserver.IdempotentResponse
+}
+
+func (s *inMemoryIdempotencyStore) Find(_ context.Context, key string) (httpserver.IdempotentResponse, bool, error) {
+	response, found := s.responses[key]
+	return response, found, nil
+}
+
+func (s *inMemoryIdempotencyStore) Save(
+	_ context.Context,
+	key string,
+	response httpserver.IdempotentResponse,
+	_ time.Duration,
+) error {
+	s.responses[key] = response
+	return nil
+}
+
+func TestIdempotencyKeyMiddleware(t *testing.T) {
+	type request struct {
+		method string
+		key    string
+		body   string
+	}
+
+	tests := []struct {
+		name             string
+		requests         []request
+		handlerStatus    int
+		expectedStatuses []int
+		expectedHandled  int
+	}{
+		{
+			name: "should replay the recorded response to a retry",
+			requests: []request{
+				{method: http.MethodPost, key: "key-1", body: `{"id":"1"}`},
+				{method: http.MethodPost, key: "key-1", body: `{"id":"1"}`},
+			},
+			handlerStatus:    http.StatusCreated,
+			expectedStatuses: []int{http.StatusCreated, http.StatusCreated},
+			expectedHandled:  1,
+		},
+		{
+			name: "should reject a key reused with a different body",
+			requests: []request{
+				{method: http.MethodPost, key: "key-1", body: `{"id":"1"}`},
+				{method: http.MethodPost, key: "key-1", body: `{"id":"2"}`},
+			},
+			handlerStatus:    http.StatusCreated,
+			expectedStatuses: []int{http.StatusCreated, http.StatusUnprocessableEntity},
+			expectedHandled:  1,
+		},
+		{
+			name: "should handle every request with a different key",
+			requests: []request{
+				{method: http.MethodPost, key: "key-1", body: `{"id":"1"}`},
+				{method: http.MethodPost, key: "key-2", body: `{"id":"1"}`},
+			},
+			handlerStatus:    http.StatusCreated,
+			expectedStatuses: []int{http.StatusCreated, http.StatusCreated},
+			expectedHandled:  2,
+		},
+		{
+			name: "should not record server errors",
+			requests: []request{
+				{method: http.MethodPatch, key: "key-1", body: `{}`},
+				{method: http.MethodPatch, key: "key-1", body: `{}`},
+			},
+			handlerStatus:    http.StatusInternalServerError,
+			expectedStatuses: []int{http.StatusInternalServerError, http.StatusInternalServerError},
+			expectedHandled:  2,
+		},
+		{
+			name: "should ignore the key on safe methods",
+			requests: []request{
+				{method: http.MethodGet, key: "key-1"},
+				{method: http.MethodGet, key: "key-1"},
+			},
+			handlerStatus:    http.StatusOK,
+			expectedStatuses: []int{http.StatusOK, http.StatusOK},
+			expectedHandled:  2,
+		},
+		{
+			name: "should reject an empty key",
+			requests: []request{
+				{method: http.MethodPost, key: "", body: `{}`},
+			},
+			handlerStatus:    http.StatusCreated,
+			expectedStatuses: []int{http.StatusBadRequest},
+			expectedHandled:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutex := &distributedsyncmock.MutexServiceMock{}
+			mutex.MutexFunc = func(_ context.Context, _ string, fn dsync.MutexCallback) (interface{}, error) {
+				return fn()
+			}
+			store := &inMemoryIdempotencyStore{responses: make(map[string]httpserver.IdempotentResponse)}
+			responses := httpserver.NewJSONAPIResponseMiddleware(logger.NewZerologLogger(context.Background(), "test"))
+
+			handled := 0
+			handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				handled++
+				w.Header().Set("Content-Type", "application/vnd.api+json")
+				w.WriteHeader(tt.handlerStatus)
+				_, _ = w.Write([]byte(`{"data":null}`))
+			})
+			middleware := httpserver.NewIdempotencyKeyMiddleware(store, mutex, time.Hour, responses).Middleware(handler)
+
+			for i, req := range tt.requests {
+				r := httptest.NewRequest(req.method, "/cargoes", strings.NewReader(req.body))
+				r.Header.Set(httpserver.HeaderIdempotencyKey, req.key)
+				w := httptest.NewRecorder()
+
+				middleware.ServeHTTP(w, r)
+
+				assert.Equal(t, tt.expectedStatuses[i], w.Code, "unexpected status on request %d", i)
+			}
+
+			assert.Equal(t, tt.expectedHandled, handled)
+		})
+	}
+}
+
+func TestIdempotencyKeyMiddleware_ReplaysRecordedResponse(t *testing.T) {
+	mutex := &distributedsyncmock.MutexServiceMock{}
+	mutex.MutexFunc = func(_ context.Context, _ string, fn dsync.MutexCallback) (interface{}, error) {
+		return fn()
+	}
+	store := &inMemoryIdempotencyStore{responses: make(map[string]httpserver.IdempotentResponse)}
+	responses := httpserver.NewJSONAPIResponseMiddleware(logger.NewZerologLogger(context.Background(), "test"))
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"errors":[{"status":"409"}]}`))
+	})
+	middleware := httpserver.NewIdempotencyKeyMiddleware(store, mutex, time.Hour, responses).Middleware(handler)
+
+	recorders := make([]*httptest.ResponseRecorder, 2)
+	for i := range recorders {
+		r := httptest.NewRequest(http.MethodPost, "/cargoes", strings.NewReader(`{}`))
+		r.Header.Set(httpserver.HeaderIdempotencyKey, "key-1")
+		recorders[i] = httptest.NewRecorder()
+		middleware.ServeHTTP(recorders[i], r)
+	}
+
+	require.Len(t, mutex.MutexCalls(), 2)
+	assert.Equal(t, "idempotency_key:key-1", mutex.MutexCalls()[0].Key)
+	assert.Empty(t, recorders[0].Header().Get(httpserver.HeaderIdempotencyReplayed))
+	assert.Equal(t, "true", recorders[1].Header().Get(httpserver.HeaderIdempotencyReplayed))
+	assert.Equal(t, recorders[0].Code, recorders[1].Code)
+	assert.Equal(t, recorders[0].Body.String(), recorders[1].Body.String())
+	assert.Equal(t, "application/vnd.api+json", recorders[1].Header().Get("Content-Type"))
+}
+
+func TestIdempotencyKeyMiddleware_LockFailures(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		lockErr        error
+		expectedStatus int
+	}{
+		{
+			name:           "should conflict while a duplicate holds the key",
+			ctx:            context.Background(),
+			lockErr:        &redsync.ErrTaken{Nodes: []int{0}},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "should conflict when the key wasn't released while waiting for it",
+			ctx:            context.Background(),
+			lockErr:        redsync.ErrFailed,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "should fail when the lock store is unreachable",
+			ctx:            context.Background(),
+			lockErr:        &redsync.RedisError{Node: 0, Err: errors.New("connection refused")},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "should fail when the request is cancelled while waiting for the key",
+			ctx:            cancelled,
+			lockErr:        redsync.ErrFailed,
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutex := &distributedsyncmock.MutexServiceMock{}
+			mutex.MutexFunc = func(_ context.Context, key string, _ dsync.MutexCallback) (interface{}, error) {
+				return nil, dsync.NewMutexLockingError(key).Wrap(fmt.Errorf("error while locking mutex: %w", tt.lockErr))
+			}
+			store := &inMemoryIdempotencyStore{responses: make(map[string]httpserver.IdempotentResponse)}
+			responses := httpserver.NewJSONAPIResponseMiddleware(logger.NewZerologLogger(context.Background(), "test"))
+
+			handled := false
+			handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				handled = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+			middleware := httpserver.NewIdempotencyKeyMiddleware(store, mutex, time.Hour, responses).Middleware(handler)
+
+			r := httptest.NewRequest(http.MethodPost, "/cargoes", strings.NewReader(`{}`)).WithContext(tt.ctx)
+			r.Header.Set(httpserver.HeaderIdempotencyKey, "key-1")
+			w := httptest.NewRecorder()
+			middleware.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.False(t, handled)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/google/jsonapi"
@@ -60,6 +61,40 @@ func (jrm *JSONAPIResponseMiddleware) WriteResponse(
 	}
 }
 
+// WriteCollectionResponse writes a JSON:API collection document, the links
+// provided (e.g. pagination links) are attached at top level.
+func (jrm *JSONAPIResponseMiddleware) WriteCollectionResponse(
+	ctx context.Context,
+	writer http.ResponseWriter,
+	payload interface{},
+	links jsonapi.Links,
+	statusCode int,
+) {
+	document, err := jsonapi.Marshal(payload)
+	if err != nil {
+		jrm.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Msg("unexpected error marshalling json api collection response")
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if many, ok := document.(*jsonapi.ManyPayload); ok && len(links) > 0 {
+		many.Links = &links
+	}
+
+	writer.Header().Set("Content-Type", jsonapi.MediaType)
+	writer.WriteHeader(statusCode)
+
+	if err = json.NewEncoder(writer).Encode(document); err != nil {
+		jrm.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Msg("unexpected error encoding json api collection response")
+	}
+}
+
 func (jrm *JSONAPIResponseMiddleware) logError(ctx context.Context, err error, statusCode int) {
 	if err == nil {
 		return
@@ -0,0 +1,34 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/google/jsonapi"
+)
+
+// NewCursorPaginationLinks builds the JSON:API top level links for a cursor paginated
+// collection keeping the rest of the query params of the current request untouched.
+func NewCursorPaginationLinks(r *http.Request, cursorParam string, nextCursor string) jsonapi.Links {
+	links := jsonapi.Links{
+		"self":               paginationLink(r.URL, cursorParam, r.URL.Query().Get(cursorParam)),
+		jsonapi.KeyFirstPage: paginationLink(r.URL, cursorParam, ""),
+	}
+
+	if nextCursor != "" {
+		links[jsonapi.KeyNextPage] = paginationLink(r.URL, cursorParam, nextCursor)
+	}
+
+	return links
+}
+
+func paginationLink(current *url.URL, cursorParam string, cursor string) string {
+	query := current.Query()
+	query.Del(cursorParam)
+	if cursor != "" {
+		query.Set(cursorParam, cursor)
+	}
+
+	link := url.URL{Path: current.Path, RawQuery: query.Encode()}
+	return link.String()
+}
@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const idempotencyStoreKeyPrefix = "idempotent-response:"
+
+var _ IdempotencyStore = (*RedisIdempotencyStore)(nil)
+
+// RedisIdempotencyStore keeps the recorded responses as JSON documents expiring on their own.
+type RedisIdempotencyStore struct {
+	client *redis.Client
+}
+
+func NewRedisIdempotencyStore(client *redis.Client) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{client: client}
+}
+
+func (s *RedisIdempotencyStore) Find(ctx context.Context, key string) (IdempotentResponse, bool, error) {
+	raw, err := s.client.Get(ctx, idempotencyStoreKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return IdempotentResponse{}, false, nil
+	}
+
+	if err != nil {
+		return IdempotentResponse{}, false, fmt.Errorf("error finding idempotent response: %w", err)
+	}
+
+	var response IdempotentResponse
+	if unmarshalErr := json.Unmarshal(raw, &response); unmarshalErr != nil {
+		return IdempotentResponse{}, false, fmt.Errorf("error decoding idempotent response: %w", unmarshalErr)
+	}
+
+	return response, true, nil
+}
+
+func (s *RedisIdempotencyStore) Save(ctx context.Context, key string, response IdempotentResponse, ttl time.Duration) error {
+	raw, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("error encoding idempotent response: %w", err)
+	}
+
+	if setErr := s.client.Set(ctx, idempotencyStoreKeyPrefix+key, raw, ttl).Err(); setErr != nil {
+		return fmt.Errorf("error saving idempotent response: %w", setErr)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const reverseProxyForwardedByHeader = "X-Forwarded-For"
@@ -22,6 +23,51 @@ func FetchBoolQueryParamValue(values url.Values, param string, defaultVal bool)
 	return defaultVal
 }
 
+func FetchStringQueryParamValue(values url.Values, param string, defaultVal string) string {
+	if queryParamVal := strings.TrimSpace(values.Get(param)); queryParamVal != "" {
+		return queryParamVal
+	}
+	return defaultVal
+}
+
+// FetchCSVQueryParamValue splits comma separated values like JSON:API filters (e.g. filter[status]=a,b).
+func FetchCSVQueryParamValue(values url.Values, param string) []string {
+	queryParamVal := values.Get(param)
+	if queryParamVal == "" {
+		return make([]string, 0)
+	}
+
+	parsed := make([]string, 0)
+	for _, val := range strings.Split(queryParamVal, ",") {
+		if trimmed := strings.TrimSpace(val); trimmed != "" {
+			parsed = append(parsed, trimmed)
+		}
+	}
+	return parsed
+}
+
+func FetchUintQueryParamValue(values url.Values, param string, defaultVal uint64) (uint64, error) {
+	queryParamVal := values.Get(param)
+	if queryParamVal == "" {
+		return defaultVal, nil
+	}
+
+	return strconv.ParseUint(queryParamVal, 10, 64)
+}
+
+func FetchTimeQueryParamValue(values url.Values, param string) (*time.Time, error) {
+	queryParamVal := values.Get(param)
+	if queryParamVal == "" {
+		return nil, nil //nolint:nilnil // an absent time query param is not an error
+	}
+
+	parsed, err := time.Parse(time.RFC3339, queryParamVal)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
 func ClientIP(req *http.Request) string {
 	ipAddress := req.RemoteAddr
 	fwdAddress := req.Header.Get(reverseProxyForwardedByHeader)
@@ -0,0 +1,38 @@
+package jsonapiresponse
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/jsonapi"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+const (
+	preconditionFailedDefaultTitle = "Precondition Failed"
+	preconditionFailedDefaultCode  = "precondition_failed"
+)
+
+func NewPreconditionFailed(detail string) []*jsonapi.ErrorObject {
+	return []*jsonapi.ErrorObject{{
+		ID:     utils.NewULID().String(),
+		Code:   preconditionFailedDefaultCode,
+		Title:  preconditionFailedDefaultTitle,
+		Detail: detail,
+		Status: strconv.Itoa(http.StatusPreconditionFailed),
+	}}
+}
+
+func NewPreconditionFailedWithDetails(detail string, items ...MetadataItem) []*jsonapi.ErrorObject {
+	metadata := NewMetadata(items...).MetadataMap()
+
+	return []*jsonapi.ErrorObject{{
+		ID:     utils.NewULID().String(),
+		Code:   preconditionFailedDefaultCode,
+		Title:  preconditionFailedDefaultTitle,
+		Detail: detail,
+		Status: strconv.Itoa(http.StatusPreconditionFailed),
+		Meta:   &metadata,
+	}}
+}
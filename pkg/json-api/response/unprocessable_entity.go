@@ -0,0 +1,38 @@
+package jsonapiresponse
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/jsonapi"
+
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+)
+
+const (
+	unprocessableEntityDefaultTitle = "Unprocessable Entity"
+	unprocessableEntityDefaultCode  = "unprocessable_entity"
+)
+
+func NewUnprocessableEntity(detail string) []*jsonapi.ErrorObject {
+	return []*jsonapi.ErrorObject{{
+		ID:     utils.NewULID().String(),
+		Code:   unprocessableEntityDefaultCode,
+		Title:  unprocessableEntityDefaultTitle,
+		Detail: detail,
+		Status: strconv.Itoa(http.StatusUnprocessableEntity),
+	}}
+}
+
+func NewUnprocessableEntityWithDetails(detail string, items ...MetadataItem) []*jsonapi.ErrorObject {
+	metadata := NewMetadata(items...).MetadataMap()
+
+	return []*jsonapi.ErrorObject{{
+		ID:     utils.NewULID().String(),
+		Code:   unprocessableEntityDefaultCode,
+		Title:  unprocessableEntityDefaultTitle,
+		Detail: detail,
+		Status: strconv.Itoa(http.StatusUnprocessableEntity),
+		Meta:   &metadata,
+	}}
+}
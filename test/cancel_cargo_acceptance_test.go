@@ -0,0 +1,198 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type CancelCargoAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+	cargoID  cargodomain.CargoID
+}
+
+func TestCancelCargo(t *testing.T) {
+	suite.Run(t, new(CancelCargoAcceptanceTestSuite))
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	cargo := cargotest.NewCargoMother(cargotest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+	suite.cargoID = cargo.ID()
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) TestCancelCargo_Success() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"reason": "customer_request"
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/cancel", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err)
+	suite.Equal(cargodomain.StatusCancelled.String(), cargo.Primitives().Status)
+
+	tracking := cargo.Primitives().Tracking
+	suite.Require().Len(tracking, 1)
+	suite.Equal("cargo.cancelled", tracking[0].EntryType)
+	suite.Equal("customer_request", tracking[0].Details["reason"])
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) TestCancelCargo_SuccessHonouringIfMatch() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"reason": "customer_request"
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/cancel", suite.cargoID.String())
+	headers := map[string]string{httpserver.HeaderIfMatch: httpserver.VersionETag(1)}
+	response := testutils.ExecuteJSONRequestWithHeaders(suite.T(), suite.common.Router, http.MethodPatch, route, body, headers)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID)
+	suite.Require().NoError(err)
+	suite.Equal(cargodomain.StatusCancelled.String(), cargo.Primitives().Status)
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) TestCancelCargo_FailIfVersionDoesNotMatchIfMatch() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"reason": "customer_request"
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/cancel", suite.cargoID.String())
+	headers := map[string]string{httpserver.HeaderIfMatch: httpserver.VersionETag(7)}
+	response := testutils.ExecuteJSONRequestWithHeaders(suite.T(), suite.common.Router, http.MethodPatch, route, body, headers)
+	suite.Equal(http.StatusPreconditionFailed, response.Code, "Expected status code 412 Precondition Failed")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID)
+	suite.Require().NoError(err)
+	suite.Equal(cargodomain.StatusPending.String(), cargo.Primitives().Status)
+	suite.Equal(uint64(1), cargo.Version())
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) TestCancelCargo_FailIfInvalidReasonProvided() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"reason": "bored"
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/cancel", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) TestCancelCargo_FailIfCargoIsDelivered() {
+	cargoID := suite.common.ULIDProvider.New().String()
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(cargoID),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusDelivered.String()),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for test setup")
+
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"reason": "damaged"
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/cancel", cargoID)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *CancelCargoAcceptanceTestSuite) TestCancelCargo_FailIfCancelledThroughStatusUpdate() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"new_status": "cancelled"
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/update-status", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
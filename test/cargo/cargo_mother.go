@@ -28,6 +28,40 @@ func WithVesselID(id string) CargoMotherOpt {
 	}
 }
 
+func WithItems(items ...cargodomain.ItemsPrimitives) CargoMotherOpt {
+	return func(m *CargoMother) {
+		m.primitives.Items = items
+		m.primitives.Weight = 0
+		for _, item := range items {
+			m.primitives.Weight += item.Weight
+		}
+	}
+}
+
+func WithShipper(name, email, address string) CargoMotherOpt {
+	return func(m *CargoMother) {
+		m.primitives.Shipper = &cargodomain.PartyPrimitives{Name: name, Email: email, Address: address}
+	}
+}
+
+func WithConsignee(name, email, address string) CargoMotherOpt {
+	return func(m *CargoMother) {
+		m.primitives.Consignee = &cargodomain.PartyPrimitives{Name: name, Email: email, Address: address}
+	}
+}
+
+func WithMetadata(metadata map[string]string) CargoMotherOpt {
+	return func(m *CargoMother) {
+		m.primitives.Metadata = metadata
+	}
+}
+
+func WithStatus(status string) CargoMotherOpt {
+	return func(m *CargoMother) {
+		m.primitives.Status = status
+	}
+}
+
 func WithTimestamps(createdAt, updatedAt time.Time) CargoMotherOpt {
 	return func(m *CargoMother) {
 		m.primitives.CreatedAt = createdAt
@@ -41,6 +75,12 @@ func WithSoftDeletion(at time.Time) CargoMotherOpt {
 	}
 }
 
+func WithVersion(version uint64) CargoMotherOpt {
+	return func(m *CargoMother) {
+		m.primitives.Version = version
+	}
+}
+
 type CargoMother struct {
 	primitives cargodomain.CargoPrimitives
 }
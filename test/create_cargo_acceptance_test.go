@@ -10,6 +10,7 @@ import (
 	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
 	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
 	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
@@ -24,7 +25,8 @@ type CreateCargoAcceptanceTestSuite struct {
 	vesselModule *di.VesselModule
 	cargoModule  *di.CargoModule
 
-	dbArranger *testarrangers.PostgresSQLArranger
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
 
 	vesselID vesseldomain.VesselID
 	cargoID  cargodomain.CargoID
@@ -49,10 +51,14 @@ func (suite *CreateCargoAcceptanceTestSuite) SetupSuite() {
 	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
 
 	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
 }
 
 func (suite *CreateCargoAcceptanceTestSuite) SetupTest() {
 	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
 
 	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
 	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
@@ -74,16 +80,222 @@ func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_Success() {
 				"attributes": {
 					"vessel_id": "%s",
 					"items": [
-						{"name": "Item 1", "weight": 1000},
+						{"name": "Item 1", "weight": {"value": 1, "unit": "kg"}},
 						{"name": "Item 2", "weight": 2000},
-						{"name": "Item 3", "weight": 3000}
+						{"name": "Item 3", "weight": {"value": 6.6, "unit": "lb"}}
+					]
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), "01K4BBCBY7MQCC5CVGKMRHBBTM")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(5994), cargo.Primitives().Weight, "weights must be normalised to grams")
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_SuccessReplayingRetryWithIdempotencyKey() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [{"name": "Item 1", "weight": {"value": 1, "unit": "kg"}}]
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	headers := map[string]string{httpserver.HeaderIdempotencyKey: suite.common.ULIDProvider.New().String()}
+
+	for _, replayed := range []string{"", "true"} {
+		response := testutils.ExecuteJSONRequestWithHeaders(
+			suite.T(),
+			suite.common.Router,
+			http.MethodPost,
+			"/cargoes",
+			body,
+			headers,
+		)
+		suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+		suite.Equal(replayed, response.Header().Get(httpserver.HeaderIdempotencyReplayed))
+	}
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfIdempotencyKeyIsReusedWithAnotherBody() {
+	headers := map[string]string{httpserver.HeaderIdempotencyKey: suite.common.ULIDProvider.New().String()}
+	expectedStatuses := []int{http.StatusNoContent, http.StatusUnprocessableEntity}
+
+	for i, cargoID := range []string{"01K4BBCBY7MQCC5CVGKMRHBBTM", "01K4BBD0AJ4X6P7V5C8Y1T2N3M"} {
+		body := []byte(fmt.Sprintf(`
+			{
+				"data": {
+					"id": "%s",
+					"type": "cargo",
+					"attributes": {
+						"vessel_id": "%s",
+						"items": [{"name": "Item 1", "weight": {"value": 1, "unit": "kg"}}]
+					}
+				}
+			}
+		`, cargoID, suite.vesselID.String()))
+		response := testutils.ExecuteJSONRequestWithHeaders(
+			suite.T(),
+			suite.common.Router,
+			http.MethodPost,
+			"/cargoes",
+			body,
+			headers,
+		)
+		suite.Equal(expectedStatuses[i], response.Code)
+	}
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_SuccessWithPlainWeightInGrams() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Item 1", "weight": {"value": 1, "unit": "kg"}},
+						{"name": "Item 2", "weight": 1000}
+					]
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), "01K4BBCBY7MQCC5CVGKMRHBBTM")
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2000), cargo.Primitives().Weight, "plain weights must be taken as grams")
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfWeightIsMalformed() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Item 1", "weight": "1000"}
+					]
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfWeightUnitIsUnknown() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Item 1", "weight": {"value": 1, "unit": "stone"}}
 					]
 				}
 			}
 		}
 	`, suite.vesselID.String()))
 	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_SuccessWithParties() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Item 1", "weight": 1000}
+					],
+					"shipper": {
+						"name": "Acme Exports",
+						"email": "Shipping@Acme.example",
+						"address": "1 Harbour Road, Rotterdam"
+					},
+					"consignee": {
+						"name": "Globex Imports",
+						"email": "receiving@globex.example",
+						"address": "42 Dock Street, Valencia"
+					}
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
 	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), "01K4BBCBY7MQCC5CVGKMRHBBTM")
+	suite.Require().NoError(err)
+
+	primitives := cargo.Primitives()
+	suite.Require().NotNil(primitives.Shipper)
+	suite.Equal("shipping@acme.example", primitives.Shipper.Email)
+	suite.Require().NotNil(primitives.Consignee)
+	suite.Equal("Globex Imports", primitives.Consignee.Name)
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfShipperIsInvalid() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Item 1", "weight": 1000}
+					],
+					"shipper": {
+						"name": "Acme Exports",
+						"email": "not-an-email",
+						"address": "1 Harbour Road, Rotterdam"
+					}
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfDangerousGoodsMustBeSegregated() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "01K4BBCBY7MQCC5CVGKMRHBBTM",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Fireworks", "weight": 1000, "un_number": "UN0336", "hazard_class": "1.4"},
+						{"name": "Paint", "weight": 1000, "un_number": "UN1263", "hazard_class": "3"}
+					]
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
 }
 
 func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfAlreadyExists() {
@@ -156,3 +368,41 @@ func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfCargoItemsExc
 	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
 	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
 }
+
+func (suite *CreateCargoAcceptanceTestSuite) TestCreateCargo_FailIfVesselCapacityIsExceeded() {
+	const vesselCapacityInKilograms = 5
+
+	vesselID := suite.common.ULIDProvider.New().String()
+	vessel := vesseltest.NewVesselMother(
+		vesseltest.WithVesselID(vesselID),
+		vesseltest.WithCapacity(vesselCapacityInKilograms),
+	).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for test setup")
+
+	// 3500 grams are already loaded on the vessel.
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(suite.common.ULIDProvider.New().String()),
+		cargotest.WithVesselID(vesselID),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for test setup")
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "%s",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s",
+					"items": [
+						{"name": "Item 1", "weight": 1000},
+						{"name": "Item 2", "weight": 1000}
+					]
+				}
+			}
+		}
+	`, suite.common.ULIDProvider.New().String(), vesselID))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes", body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
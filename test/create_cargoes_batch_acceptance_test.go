@@ -0,0 +1,181 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+const (
+	batchCargoOneID   = "01K4BBCBY7MQCC5CVGKMRHBBTA"
+	batchCargoTwoID   = "01K4BBCBY7MQCC5CVGKMRHBBTB"
+	batchCargoThreeID = "01K4BBCBY7MQCC5CVGKMRHBBTC"
+	unknownVesselID   = "01K4BBCBY7MQCC5CVGKMRHBBTZ"
+)
+
+type CreateCargoesBatchAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+}
+
+func TestCreateCargoesBatch(t *testing.T) {
+	suite.Run(t, new(CreateCargoesBatchAcceptanceTestSuite))
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+
+	// One kilogram of capacity so two 600 grams cargoes can't be loaded together.
+	vessel := vesseltest.NewVesselMother(
+		vesseltest.WithVesselID(suite.vesselID.String()),
+		vesseltest.WithCapacity(1),
+	).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) batchBody(cargoes ...[2]string) []byte {
+	data := ""
+	for i, cargo := range cargoes {
+		if i > 0 {
+			data += ","
+		}
+
+		data += fmt.Sprintf(`{
+			"id": "%s",
+			"type": "cargo",
+			"attributes": {
+				"vessel_id": "%s",
+				"items": [{"name": "Item", "weight": 600}]
+			}
+		}`, cargo[0], cargo[1])
+	}
+
+	return []byte(`{"data": [` + data + `]}`)
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) assertCargoExists(id string, exists bool) {
+	_, err := suite.cargoModule.Repository.Find(suite.T().Context(), cargodomain.CargoID(id))
+	if exists {
+		suite.Require().NoError(err)
+		return
+	}
+
+	suite.True(cargodomain.IsCargoNotExistsError(err), "cargo %s must not be created", id)
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) TestCreateCargoesBatch_ReportsEveryCargo() {
+	body := suite.batchBody(
+		[2]string{batchCargoOneID, suite.vesselID.String()},
+		[2]string{batchCargoTwoID, suite.vesselID.String()},
+		[2]string{batchCargoThreeID, unknownVesselID},
+	)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes:batch", body)
+
+	expected := fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "cargo_batch_result", "attributes": {"position": 0, "status": "created"}},
+				{"id": "%s", "type": "cargo_batch_result", "attributes": {"position": 1, "status": "failed", "error": "<<PRESENCE>>"}},
+				{"id": "%s", "type": "cargo_batch_result", "attributes": {"position": 2, "status": "failed", "error": "<<PRESENCE>>"}}
+			]
+		}
+	`, batchCargoOneID, batchCargoTwoID, batchCargoThreeID)
+	testutils.CheckResponse(suite.T(), http.StatusOK, expected, response)
+
+	suite.assertCargoExists(batchCargoOneID, true)
+	suite.assertCargoExists(batchCargoTwoID, false)
+	suite.assertCargoExists(batchCargoThreeID, false)
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) TestCreateCargoesBatch_AtomicSuccess() {
+	body := suite.batchBody([2]string{batchCargoOneID, suite.vesselID.String()})
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes:batch?atomic=true", body)
+
+	expected := fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "cargo_batch_result", "attributes": {"position": 0, "status": "created"}}
+			]
+		}
+	`, batchCargoOneID)
+	testutils.CheckResponse(suite.T(), http.StatusOK, expected, response)
+
+	suite.assertCargoExists(batchCargoOneID, true)
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) TestCreateCargoesBatch_AtomicRejectsTheWholeBatch() {
+	body := suite.batchBody(
+		[2]string{batchCargoOneID, suite.vesselID.String()},
+		[2]string{batchCargoTwoID, suite.vesselID.String()},
+	)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes:batch?atomic=true", body)
+
+	expected := fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "cargo_batch_result", "attributes": {"position": 0, "status": "skipped"}},
+				{"id": "%s", "type": "cargo_batch_result", "attributes": {"position": 1, "status": "failed", "error": "<<PRESENCE>>"}}
+			]
+		}
+	`, batchCargoOneID, batchCargoTwoID)
+	testutils.CheckResponse(suite.T(), http.StatusUnprocessableEntity, expected, response)
+
+	suite.assertCargoExists(batchCargoOneID, false)
+	suite.assertCargoExists(batchCargoTwoID, false)
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) TestCreateCargoesBatch_FailIfEmpty() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes:batch", []byte(`{"data": []}`))
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CreateCargoesBatchAcceptanceTestSuite) TestCreateCargoesBatch_FailIfTooLarge() {
+	cargoes := make([][2]string, 501)
+	for i := range cargoes {
+		cargoes[i] = [2]string{suite.common.ULIDProvider.New().String(), suite.vesselID.String()}
+	}
+
+	body := suite.batchBody(cargoes...)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/cargoes:batch", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
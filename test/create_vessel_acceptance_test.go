@@ -0,0 +1,109 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type CreateVesselAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+}
+
+func TestCreateVessel(t *testing.T) {
+	suite.Run(t, new(CreateVesselAcceptanceTestSuite))
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) TestCreateVessel_Success() {
+	vesselID := suite.common.ULIDProvider.New().String()
+	body := suite.createVesselBody(vesselID, `{"value": 12.5, "unit": "t"}`)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/vessels", body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	vessel, err := suite.vesselModule.Repository.Find(suite.T().Context(), vesseldomain.VesselID(vesselID))
+	suite.Require().NoError(err)
+
+	primitives := vessel.Primitives()
+	suite.Equal("Dragon", primitives.Name)
+	suite.Equal(uint64(12500), primitives.Capacity)
+	suite.InDelta(51.5072, primitives.Latitude, 0.0001)
+	suite.InDelta(-0.1276, primitives.Longitude, 0.0001)
+	suite.Equal(uint64(1), vessel.Version())
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) TestCreateVessel_FailIfVesselAlreadyExists() {
+	vesselID := suite.common.ULIDProvider.New().String()
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(vesselID)).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for test setup")
+
+	body := suite.createVesselBody(vesselID, `{"value": 5000}`)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/vessels", body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) TestCreateVessel_FailIfCapacityIsInvalid() {
+	vesselID := suite.common.ULIDProvider.New().String()
+	body := suite.createVesselBody(vesselID, `{"value": 0, "unit": "kg"}`)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/vessels", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) TestCreateVessel_FailIfVesselIDIsInvalid() {
+	body := suite.createVesselBody("1", `{"value": 5000, "unit": "kg"}`)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/vessels", body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *CreateVesselAcceptanceTestSuite) createVesselBody(vesselID, capacity string) []byte {
+	return []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "vessel",
+				"id": "%s",
+				"attributes": {
+					"name": "Dragon",
+					"capacity": %s,
+					"latitude": 51.5072,
+					"longitude": -0.1276
+				}
+			}
+		}
+	`, vesselID, capacity))
+}
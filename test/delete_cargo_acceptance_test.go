@@ -0,0 +1,111 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type DeleteCargoAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+	cargoID  cargodomain.CargoID
+}
+
+func TestDeleteCargo(t *testing.T) {
+	suite.Run(t, new(DeleteCargoAcceptanceTestSuite))
+}
+
+func (suite *DeleteCargoAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *DeleteCargoAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	cargo := cargotest.NewCargoMother(cargotest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+	suite.cargoID = cargo.ID()
+}
+
+func (suite *DeleteCargoAcceptanceTestSuite) TestDeleteCargo_Success() {
+	route := "/cargoes/" + suite.cargoID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	_, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID)
+	suite.True(cargodomain.IsCargoNotExistsError(err), "expected deleted cargo to not be found")
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *DeleteCargoAcceptanceTestSuite) TestDeleteCargo_SuccessIfCargoIsDelivered() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusDelivered.String()),
+	).Build(suite.T())
+	suite.Require().NoError(suite.cargoModule.Repository.Save(suite.T().Context(), cargo))
+
+	route := "/cargoes/" + cargo.ID().String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+}
+
+func (suite *DeleteCargoAcceptanceTestSuite) TestDeleteCargo_FailIfCargoIsInTransit() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+	).Build(suite.T())
+	suite.Require().NoError(suite.cargoModule.Repository.Save(suite.T().Context(), cargo))
+
+	route := "/cargoes/" + cargo.ID().String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+
+	stored, err := suite.cargoModule.Repository.Find(suite.T().Context(), cargo.ID())
+	suite.Require().NoError(err, "an in transit cargo must not be deleted")
+	suite.Equal(cargodomain.StatusInTransit.String(), stored.Primitives().Status)
+}
+
+func (suite *DeleteCargoAcceptanceTestSuite) TestDeleteCargo_FailIfInvalidCargoIDIsProvided() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, "/cargoes/1", nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
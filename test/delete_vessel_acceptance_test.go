@@ -0,0 +1,99 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type DeleteVesselAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+}
+
+func TestDeleteVessel(t *testing.T) {
+	suite.Run(t, new(DeleteVesselAcceptanceTestSuite))
+}
+
+func (suite *DeleteVesselAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *DeleteVesselAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+}
+
+func (suite *DeleteVesselAcceptanceTestSuite) TestDeleteVessel_Success() {
+	route := "/vessels/" + suite.vesselID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *DeleteVesselAcceptanceTestSuite) TestDeleteVessel_SuccessWithDeliveredCargoes() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusDelivered.String()),
+	).Build(suite.T())
+	err := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(err, "failed to save cargo for test setup")
+
+	route := "/vessels/" + suite.vesselID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+}
+
+func (suite *DeleteVesselAcceptanceTestSuite) TestDeleteVessel_FailIfVesselCarriesActiveCargoes() {
+	cargo := cargotest.NewCargoMother(cargotest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	err := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(err, "failed to save cargo for test setup")
+
+	route := "/vessels/" + suite.vesselID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+
+	_, err = suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.NoError(err, "vessel should not be deleted")
+}
+
+func (suite *DeleteVesselAcceptanceTestSuite) TestDeleteVessel_FailIfVesselNotFound() {
+	route := "/vessels/" + suite.common.ULIDProvider.New().String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodDelete, route, nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
@@ -0,0 +1,167 @@
+package test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type DetectCargoDelayAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+}
+
+func TestDetectCargoDelay(t *testing.T) {
+	suite.Run(t, new(DetectCargoDelayAcceptanceTestSuite))
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+}
+
+// saveCargoInTransitSince saves an in transit cargo which entered that status at the given instant.
+func (suite *DetectCargoDelayAcceptanceTestSuite) saveCargoInTransitSince(since time.Time) cargodomain.CargoID {
+	cargoID := utils.NewULID().String()
+	created := cargotrackingdomain.NewTrackingOnCargoCreated(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()), cargodomain.StatusPending.String(), since.Add(-time.Hour),
+	)
+	inTransit := cargotrackingdomain.NewTrackingOnCargoStatusChanged(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()),
+		since,
+		cargodomain.StatusPending.String(),
+		cargodomain.StatusInTransit.String(),
+	)
+
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(cargoID),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, created)),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, inTransit)),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+
+	return cargo.ID()
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) delayEntries(cargoID cargodomain.CargoID) []cargotrackingdomain.TrackingItemPrimitives {
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err, "failed to find cargo")
+
+	entries := make([]cargotrackingdomain.TrackingItemPrimitives, 0)
+	for _, entry := range cargo.Primitives().Tracking {
+		if entry.EntryType == cargotrackingdomain.TrackingEntryTypeDelayDetected.String() {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) TestDetectCargoDelay_Success() {
+	threshold := suite.common.Config.CargoDelayThreshold
+	stalledSince := time.Now().UTC().Truncate(time.Second).Add(-threshold - time.Hour)
+	stalledID := suite.saveCargoInTransitSince(stalledSince)
+	onTimeID := suite.saveCargoInTransitSince(time.Now().UTC().Add(-threshold / 2))
+
+	suite.Require().NoError(suite.cargoModule.DelayDetector.Detect(suite.T().Context()))
+
+	delays := suite.delayEntries(stalledID)
+	suite.Require().Len(delays, 1, "expected the stalled cargo delay to be detected")
+	suite.Equal(cargodomain.StatusInTransit.String(), *delays[0].StatusAfter)
+	suite.Equal(stalledSince.Format(time.RFC3339), delays[0].Details["status_since"])
+	suite.Empty(suite.delayEntries(onTimeID), "expected no delay on a cargo within the threshold")
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) TestDetectCargoDelay_OncePerThresholdCrossing() {
+	threshold := suite.common.Config.CargoDelayThreshold
+	stalledID := suite.saveCargoInTransitSince(time.Now().UTC().Add(-threshold - time.Hour))
+
+	suite.Require().NoError(suite.cargoModule.DelayDetector.Detect(suite.T().Context()))
+	suite.Require().NoError(suite.cargoModule.DelayDetector.Detect(suite.T().Context()))
+
+	suite.Len(suite.delayEntries(stalledID), 1, "expected the delay to be detected only once")
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) TestDetectCargoDelay_OnceWhenRunConcurrently() {
+	threshold := suite.common.Config.CargoDelayThreshold
+	stalledID := suite.saveCargoInTransitSince(time.Now().UTC().Add(-threshold - time.Hour))
+
+	const runs = 3
+	var wg sync.WaitGroup
+	errs := make(chan error, runs)
+	for range runs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- suite.cargoModule.DelayDetector.Detect(suite.T().Context())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		suite.Require().NoError(err)
+	}
+	suite.Len(suite.delayEntries(stalledID), 1, "expected the delay to be detected only once")
+}
+
+func (suite *DetectCargoDelayAcceptanceTestSuite) TestDetectCargoDelay_SkippedWhileAnotherInstanceRuns() {
+	threshold := suite.common.Config.CargoDelayThreshold
+	stalledID := suite.saveCargoInTransitSince(time.Now().UTC().Add(-threshold - time.Hour))
+
+	// Another instance holding the detection lock.
+	_, err := suite.common.Mutex.TryMutex(suite.T().Context(), "cargo_delay_detection", func() (interface{}, error) {
+		return nil, suite.cargoModule.DelayDetector.Detect(suite.T().Context())
+	})
+	suite.Require().NoError(err)
+
+	suite.Empty(suite.delayEntries(stalledID), "expected the run to be skipped while the lock is held")
+}
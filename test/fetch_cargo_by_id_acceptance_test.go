@@ -1,8 +1,10 @@
 package test
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
 	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
 	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
 	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
 	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
@@ -84,6 +87,9 @@ func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_Success() {
 				"attributes": {
 					"vessel_id": "%s",
 					"weight": 3500,
+					"weight_unit": "g",
+					"volume": 0,
+					"chargeable_weight": 3500,
 					"status": "pending",
 					"items": [
 						{"name": "Electronics", "weight": 1500},
@@ -105,6 +111,138 @@ func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_Success() {
 	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
 }
 
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_SuccessWithDangerousGoods() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(suite.common.ULIDProvider.New().String()),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithItems(
+			cargodomain.ItemsPrimitives{Name: "Paint", Weight: 1500, UNNumber: "UN1263", HazardClass: "3"},
+			cargodomain.ItemsPrimitives{Name: "Clothing", Weight: 2000},
+		),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "%s",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "<<PRESENCE>>",
+					"weight": 3500,
+					"weight_unit": "g",
+					"volume": 0,
+					"chargeable_weight": 3500,
+					"status": "pending",
+					"items": [
+						{"name": "Paint", "weight": 1500, "un_number": "UN1263", "hazard_class": "3"},
+						{"name": "Clothing", "weight": 2000}
+					],
+					"created_at": "<<PRESENCE>>",
+					"updated_at": "<<PRESENCE>>"
+				}
+			}
+		}
+	`, cargo.ID().String()))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+cargo.ID().String(),
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_SuccessWithVolumetricWeight() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(suite.common.ULIDProvider.New().String()),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithItems(
+			cargodomain.ItemsPrimitives{Name: "Pillows", Weight: 1500, Length: 40, Width: 30, Height: 20},
+			cargodomain.ItemsPrimitives{Name: "Clothing", Weight: 2000},
+		),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "%s",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "<<PRESENCE>>",
+					"weight": 3500,
+					"weight_unit": "g",
+					"volume": 24000,
+					"chargeable_weight": 24000,
+					"status": "pending",
+					"items": [
+						{"name": "Pillows", "weight": 1500, "length": 40, "width": 30, "height": 20},
+						{"name": "Clothing", "weight": 2000}
+					],
+					"created_at": "<<PRESENCE>>",
+					"updated_at": "<<PRESENCE>>"
+				}
+			}
+		}
+	`, cargo.ID().String()))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+cargo.ID().String(),
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_SuccessWithUnits() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"id": "%s",
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "<<PRESENCE>>",
+					"weight": 3.5,
+					"weight_unit": "kg",
+					"volume": 0,
+					"chargeable_weight": 3.5,
+					"status": "pending",
+					"items": [
+						{"name": "Electronics", "weight": 1.5},
+						{"name": "Clothing", "weight": 2}
+					],
+					"created_at": "<<PRESENCE>>",
+					"updated_at": "<<PRESENCE>>"
+				}
+			}
+		}
+	`, suite.cargoID.String()))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+suite.cargoID.String()+"?units=kg",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_FailIfUnitsAreInvalid() {
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+suite.cargoID.String()+"?units=stone",
+		nil,
+	)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
 func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_SuccessWithTracking() {
 	trackingItem := cargotrackingdomain.NewTrackingOnCargoCreated(
 		cargotrackingdomain.TrackingID(suite.common.ULIDProvider.New().String()),
@@ -138,6 +276,9 @@ func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_SuccessWithTr
 				"attributes": {
 					"vessel_id": "%s",
 					"weight": 3500,
+					"weight_unit": "g",
+					"volume": 0,
+					"chargeable_weight": 3500,
 					"status": "pending",
 					"items": [
 						{"name": "Electronics", "weight": 1500},
@@ -222,3 +363,93 @@ func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_FailIfCargoNo
 	)
 	testutils.CheckResponse(suite.T(), http.StatusNotFound, string(body), response)
 }
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) saveCargoWithTimeline(createdAt time.Time) (string, cargotrackingdomain.TrackingItemPrimitives) {
+	cargoID := utils.NewULID().String()
+	created := cargotrackingdomain.NewTrackingOnCargoCreated(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()), cargodomain.StatusPending.String(), createdAt,
+	)
+	inTransit := cargotrackingdomain.NewTrackingOnCargoStatusChanged(
+		cargotrackingdomain.TrackingID(utils.NewULID().String()),
+		createdAt.Add(time.Hour),
+		cargodomain.StatusPending.String(),
+		cargodomain.StatusInTransit.String(),
+	)
+
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(cargoID),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, created)),
+		cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(cargoID, inTransit)),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+
+	return cargoID, cargotrackingdomain.NewTrackingItemPrimitives(cargoID, created)
+}
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_SuccessAsOf() {
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-2 * time.Hour)
+	cargoID, created := suite.saveCargoWithTimeline(createdAt)
+
+	asOf := url.QueryEscape(createdAt.Add(30 * time.Minute).Format(time.RFC3339))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+cargoID+"?as_of="+asOf,
+		nil,
+	)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	var document struct {
+		Data struct {
+			Attributes struct {
+				Status string `json:"status"`
+				AsOf   struct {
+					Status   string `json:"status"`
+					Tracking struct {
+						ID        string `json:"id"`
+						EntryType string `json:"entry_type"`
+					} `json:"tracking"`
+				} `json:"as_of"`
+			} `json:"attributes"`
+			Relationships map[string]any `json:"relationships"`
+		} `json:"data"`
+	}
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &document))
+
+	attributes := document.Data.Attributes
+	suite.Equal(cargodomain.StatusInTransit.String(), attributes.Status, "current status must be kept")
+	suite.Equal(cargodomain.StatusPending.String(), attributes.AsOf.Status)
+	suite.Equal(created.ID, attributes.AsOf.Tracking.ID)
+	suite.Equal(created.EntryType, attributes.AsOf.Tracking.EntryType)
+	suite.Empty(document.Data.Relationships, "tracking must only be included when requested")
+}
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_FailIfAsOfIsBeforeCargoCreation() {
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-2 * time.Hour)
+	cargoID, _ := suite.saveCargoWithTimeline(createdAt)
+
+	asOf := url.QueryEscape(createdAt.Add(-time.Minute).Format(time.RFC3339))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+cargoID+"?as_of="+asOf,
+		nil,
+	)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *FetchCargoByIDAcceptanceTestSuite) TestFetchCargoByID_FailIfAsOfIsInvalid() {
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+suite.cargoID.String()+"?as_of=yesterday",
+		nil,
+	)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+)
+
+type FetchCargoStatusesAcceptanceTestSuite struct {
+	suite.Suite
+
+	common *di.CommonServices
+}
+
+func TestFetchCargoStatuses(t *testing.T) {
+	suite.Run(t, new(FetchCargoStatusesAcceptanceTestSuite))
+}
+
+func (suite *FetchCargoStatusesAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	_ = di.NewCargoModule(suite.T().Context(), suite.common)
+}
+
+func (suite *FetchCargoStatusesAcceptanceTestSuite) TestFetchCargoStatuses_Success() {
+	body := []byte(`
+		{
+			"data": [
+				{
+					"id": "pending",
+					"type": "cargo-status",
+					"attributes": {"transitions": ["loaded", "in_transit", "cancelled"], "initial": true, "final": false}
+				},
+				{
+					"id": "loaded",
+					"type": "cargo-status",
+					"attributes": {"transitions": ["in_transit", "cancelled"], "initial": false, "final": false}
+				},
+				{
+					"id": "in_transit",
+					"type": "cargo-status",
+					"attributes": {"transitions": ["at_port", "delivered", "lost", "cancelled"], "initial": false, "final": false}
+				},
+				{
+					"id": "at_port",
+					"type": "cargo-status",
+					"attributes": {"transitions": ["customs_hold", "in_transit", "delivered", "lost"], "initial": false, "final": false}
+				},
+				{
+					"id": "customs_hold",
+					"type": "cargo-status",
+					"attributes": {"transitions": ["at_port", "returned", "cancelled"], "initial": false, "final": false}
+				},
+				{
+					"id": "delivered",
+					"type": "cargo-status",
+					"attributes": {"transitions": [], "initial": false, "final": true}
+				},
+				{
+					"id": "returned",
+					"type": "cargo-status",
+					"attributes": {"transitions": [], "initial": false, "final": true}
+				},
+				{
+					"id": "lost",
+					"type": "cargo-status",
+					"attributes": {"transitions": [], "initial": false, "final": true}
+				},
+				{
+					"id": "cancelled",
+					"type": "cargo-status",
+					"attributes": {"transitions": [], "initial": false, "final": true}
+				}
+			]
+		}
+	`)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/cargo-statuses", nil)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
@@ -83,6 +83,28 @@ func (suite *FetchVesselByIDAcceptanceTestSuite) TestFetchVesselByID_Success() {
 	suite.assertVessel(responseBody)
 }
 
+func (suite *FetchVesselByIDAcceptanceTestSuite) TestFetchVesselByID_SuccessWithUnits() {
+	vesselID := suite.common.ULIDProvider.New().String()
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(vesselID))
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel.Build(suite.T()))
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	url := "/vessels/" + vesselID + "?units=t"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, url, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	responseBody := suite.parseResponseBody(response.Body)
+	suite.InDelta(5.0, responseBody.Capacity, 0, "vessel capacity mismatch")
+	suite.Equal("t", responseBody.CapacityUnit, "vessel capacity unit mismatch")
+}
+
+func (suite *FetchVesselByIDAcceptanceTestSuite) TestFetchVesselByID_FailIfUnitsAreInvalid() {
+	vesselID := suite.common.ULIDProvider.New().String()
+	url := "/vessels/" + vesselID + "?units=stone"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, url, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
 func (suite *FetchVesselByIDAcceptanceTestSuite) TestFetchVesselByID_SoftDeletedSuccess() {
 	vesselID := suite.vesselID.String()
 	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(vesselID), vesseltest.WithSoftDeletion(time.Now()))
@@ -97,7 +119,8 @@ func (suite *FetchVesselByIDAcceptanceTestSuite) assertVessel(response *vesselen
 	suite.T().Helper()
 
 	suite.Equal("Falcon 9", response.Name, "vessel name mismatch")
-	suite.Equal(uint64(5000), response.Capacity, "vessel capacity mismatch")
+	suite.InDelta(5000.0, response.Capacity, 0, "vessel capacity mismatch")
+	suite.Equal("kg", response.CapacityUnit, "vessel capacity unit mismatch")
 	suite.Equal(37.7749, response.Latitude, "vessel latitude mismatch")
 	suite.Equal(-122.4194, response.Longitude, "vessel longitude mismatch")
 }
@@ -0,0 +1,183 @@
+package test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/jsonapi"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	vesselentrypoint "github.com/soulcodex/deus-cargo-tracker/internal/vessel/infrastructure/entrypoint"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type FetchVesselTrackAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+}
+
+func TestFetchVesselTrack(t *testing.T) {
+	suite.Run(t, new(FetchVesselTrackAcceptanceTestSuite))
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_Success() {
+	suite.reportPositions([2]float64{51.9, 4.4}, [2]float64{52.5, 3.1}, [2]float64{53.4, 1.2})
+
+	route := "/vessels/" + suite.vesselID.String() + "/track"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	track := suite.parseResponseBody(response.Body)
+	suite.Require().Len(track, 3)
+	suite.InDelta(51.9, track[0].Latitude, 0.0001)
+	suite.InDelta(53.4, track[2].Latitude, 0.0001)
+	suite.False(track[1].RecordedAt.Before(track[0].RecordedAt), "positions must be in chronological order")
+
+	vessel, err := suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.Require().NoError(err)
+	suite.InDelta(53.4, vessel.Primitives().Latitude, 0.0001, "vessel must be on its last reported position")
+	suite.InDelta(1.2, vessel.Primitives().Longitude, 0.0001, "vessel must be on its last reported position")
+	suite.Equal(uint64(1), vessel.Version(), "position reports must not outdate the vessel version")
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_SuccessDownsampled() {
+	suite.reportPositions([2]float64{51.9, 4.4}, [2]float64{52.5, 3.1}, [2]float64{53.4, 1.2})
+
+	route := "/vessels/" + suite.vesselID.String() + "/track?points=2"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	track := suite.parseResponseBody(response.Body)
+	suite.Require().Len(track, 2)
+	suite.InDelta(51.9, track[0].Latitude, 0.0001)
+	suite.InDelta(53.4, track[1].Latitude, 0.0001)
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_SuccessWithinRange() {
+	suite.reportPositions([2]float64{51.9, 4.4})
+
+	from := url.QueryEscape(time.Now().Add(time.Hour).Format(time.RFC3339))
+	route := "/vessels/" + suite.vesselID.String() + "/track?from=" + from
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	suite.Empty(suite.parseResponseBody(response.Body))
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_SuccessDownsampledWithinRange() {
+	suite.reportPositions([2]float64{51.9, 4.4}, [2]float64{52.5, 3.1}, [2]float64{53.4, 1.2}, [2]float64{54.1, 0.5})
+
+	from := url.QueryEscape(time.Now().Add(-time.Hour).Format(time.RFC3339))
+	route := "/vessels/" + suite.vesselID.String() + "/track?points=3&from=" + from
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	track := suite.parseResponseBody(response.Body)
+	suite.Require().Len(track, 3)
+	suite.InDelta(51.9, track[0].Latitude, 0.0001)
+	suite.InDelta(54.1, track[2].Latitude, 0.0001)
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_SuccessOutsideDefaultWindow() {
+	suite.reportPositions([2]float64{51.9, 4.4})
+
+	to := url.QueryEscape(time.Now().Add(-2 * vesselpositiondomain.DefaultTrackWindow).Format(time.RFC3339))
+	route := "/vessels/" + suite.vesselID.String() + "/track?to=" + to
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	suite.Empty(suite.parseResponseBody(response.Body), "the default window ends at the given end")
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_FailIfPointsAreInvalid() {
+	route := "/vessels/" + suite.vesselID.String() + "/track?points=1"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestFetchVesselTrack_FailIfVesselNotFound() {
+	route := "/vessels/" + suite.common.ULIDProvider.New().String() + "/track"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, route, nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) TestReportVesselPosition_FailIfLocationIsInvalid() {
+	body := []byte(`{"data": {"type": "vessel_position", "attributes": {"latitude": 95, "longitude": 4.4}}}`)
+	route := "/vessels/" + suite.vesselID.String() + "/positions"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) reportPositions(positions ...[2]float64) {
+	suite.T().Helper()
+
+	route := "/vessels/" + suite.vesselID.String() + "/positions"
+	for _, position := range positions {
+		body := []byte(fmt.Sprintf(`
+			{
+				"data": {
+					"type": "vessel_position",
+					"attributes": {
+						"latitude": %f,
+						"longitude": %f
+					}
+				}
+			}
+		`, position[0], position[1]))
+		response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+		suite.Require().Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+	}
+}
+
+func (suite *FetchVesselTrackAcceptanceTestSuite) parseResponseBody(body io.Reader) []*vesselentrypoint.VesselPosition {
+	suite.T().Helper()
+
+	items, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(vesselentrypoint.VesselPosition)))
+	suite.Require().NoError(err, "failed to unmarshal vessel track response")
+
+	track := make([]*vesselentrypoint.VesselPosition, len(items))
+	for i, item := range items {
+		track[i] = item.(*vesselentrypoint.VesselPosition)
+	}
+
+	return track
+}
@@ -0,0 +1,170 @@
+package test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	vesselpositiondomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain/position"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/ais"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+const (
+	aisVesselMMSI          = "477553000"
+	aisPositionSentence    = "!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C"
+	aisStaticDataFragment1 = "!AIVDM,2,1,1,A,55?MbV02;H;s<HtKR20EHE:0@T4@Dn2222222216L961O5Gf0NSQEp6ClRp8,0*1C"
+	aisStaticDataFragment2 = "!AIVDM,2,2,1,A,88888888880,2*25"
+)
+
+type IngestAISPositionsAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+	listener *ais.Listener
+}
+
+func TestIngestAISPositions(t *testing.T) {
+	suite.Run(t, new(IngestAISPositionsAcceptanceTestSuite))
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+	suite.listener = ais.NewListener(suite.vesselModule.AISIngestor.Ingest, suite.common.Logger)
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vessel := vesseltest.NewVesselMother(
+		vesseltest.WithVesselID(suite.vesselID.String()),
+		vesseltest.WithMMSI(aisVesselMMSI),
+	).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) TestIngestAISPositions_Success() {
+	feed := strings.Join([]string{aisStaticDataFragment1, aisStaticDataFragment2, aisPositionSentence}, "\n")
+	suite.Require().NoError(suite.listener.Consume(suite.T().Context(), strings.NewReader(feed)))
+
+	vessel, err := suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.Require().NoError(err)
+	suite.InDelta(47.582833, vessel.Primitives().Latitude, 0.000001)
+	suite.InDelta(-122.345833, vessel.Primitives().Longitude, 0.000001)
+
+	suite.Len(suite.findTrack(), 1, "only the position report is expected in the track")
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) TestIngestAISPositions_SuccessReplayingNDJSON() {
+	feed := `{"nmea": "` + aisPositionSentence + `"}` + "\n" + `{"nmea": "` + aisPositionSentence + `"}`
+	suite.Require().NoError(suite.listener.Consume(suite.T().Context(), strings.NewReader(feed)))
+
+	suite.Len(suite.findTrack(), 2)
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) TestIngestAISPositions_SuccessReplayingNDJSONTimestamps() {
+	recordedAt := time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)
+	feed := `{"nmea": "` + aisPositionSentence + `", "timestamp": "` + recordedAt.Format(time.RFC3339) + `"}`
+	suite.Require().NoError(suite.listener.Consume(suite.T().Context(), strings.NewReader(feed)))
+
+	track := suite.findTrack()
+	suite.Require().Len(track, 1)
+	suite.True(track[0].RecordedAt().Equal(recordedAt), "the position must be recorded at the replayed timestamp")
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) TestIngestAISPositions_ReplayingOlderPositionsKeepsLocation() {
+	vessel, err := suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.Require().NoError(err)
+	updatedAt := vessel.Primitives().UpdatedAt
+
+	latest := vesseldomain.WithReportedPosition(
+		suite.common.ULIDProvider.New().String(), 51.5072, -0.1276, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+	)
+	suite.Require().NoError(vessel.Update(latest))
+	suite.Require().NoError(suite.vesselModule.Repository.SavePosition(suite.T().Context(), vessel))
+
+	recordedAt := time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)
+	feed := `{"nmea": "` + aisPositionSentence + `", "timestamp": "` + recordedAt.Format(time.RFC3339) + `"}`
+	suite.Require().NoError(suite.listener.Consume(suite.T().Context(), strings.NewReader(feed)))
+
+	stored, err := suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.Require().NoError(err)
+	suite.InDelta(51.5072, stored.Primitives().Latitude, 0.000001, "an older position must not move the vessel")
+	suite.InDelta(-0.1276, stored.Primitives().Longitude, 0.000001, "an older position must not move the vessel")
+	suite.True(stored.Primitives().UpdatedAt.Equal(updatedAt), "a position report must not change the last update")
+	suite.Len(suite.findTrack(), 2, "every position is expected in the track")
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) TestIngestAISPositions_IgnoresUnknownVessels() {
+	route := "/vessels/" + suite.vesselID.String()
+	body := []byte(`{"data": {"type": "vessel", "attributes": {"mmsi": ""}}}`)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Require().Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	suite.Require().NoError(suite.listener.Consume(suite.T().Context(), strings.NewReader(aisPositionSentence)))
+
+	suite.Empty(suite.findTrack())
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) TestCreateVessel_FailIfMMSIIsAlreadyAssigned() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "vessel",
+				"id": "` + suite.common.ULIDProvider.New().String() + `",
+				"attributes": {
+					"name": "Dragon",
+					"capacity": {"value": 5000},
+					"latitude": 51.5072,
+					"longitude": -0.1276,
+					"mmsi": "` + aisVesselMMSI + `"
+				}
+			}
+		}
+	`)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/vessels", body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *IngestAISPositionsAcceptanceTestSuite) findTrack() vesselpositiondomain.Track {
+	suite.T().Helper()
+
+	// Replayed positions are recorded back in 2024, far before the default window.
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	criteria, err := vesselpositiondomain.NewTrackCriteria(
+		vesselpositiondomain.WithRecordedAtRange(&since, nil, time.Now()),
+	)
+	suite.Require().NoError(err)
+
+	reader := suite.vesselModule.TrackReader
+	track, err := reader.FindTrack(suite.T().Context(), suite.vesselID, criteria)
+	suite.Require().NoError(err)
+
+	return track
+}
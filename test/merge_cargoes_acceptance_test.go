@@ -0,0 +1,165 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type MergeCargoesAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+	cargoIDs []cargodomain.CargoID
+}
+
+func TestMergeCargoes(t *testing.T) {
+	suite.Run(t, new(MergeCargoesAcceptanceTestSuite))
+}
+
+func (suite *MergeCargoesAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *MergeCargoesAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	suite.cargoIDs = make([]cargodomain.CargoID, 0, 2)
+	for range 2 {
+		cargo := cargotest.NewCargoMother(
+			cargotest.WithID(suite.common.ULIDProvider.New().String()),
+			cargotest.WithVesselID(suite.vesselID.String()),
+		).Build(suite.T())
+		saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+		suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+		suite.cargoIDs = append(suite.cargoIDs, cargo.ID())
+	}
+}
+
+func (suite *MergeCargoesAcceptanceTestSuite) TestMergeCargoes_Success() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"source_cargo_ids": ["%s"]
+				}
+			}
+		}
+	`, suite.cargoIDs[1].String()))
+	route := fmt.Sprintf("/cargoes/%s/merge", suite.cargoIDs[0].String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	target, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoIDs[0], cargodomain.WithTracking())
+	suite.Require().NoError(err)
+	suite.Equal(uint64(7000), target.Primitives().Weight)
+	suite.Require().Len(target.Primitives().Tracking, 1)
+	suite.Equal("cargo.merged_into", target.Primitives().Tracking[0].EntryType)
+
+	source, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoIDs[1], cargodomain.WithTracking())
+	suite.Require().NoError(err)
+	suite.NotNil(source.Primitives().DeletedAt)
+	suite.Require().Len(source.Primitives().Tracking, 1)
+	suite.Equal("cargo.merged_into", source.Primitives().Tracking[0].EntryType)
+}
+
+func (suite *MergeCargoesAcceptanceTestSuite) TestMergeCargoes_FailIfMergedIntoItself() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"source_cargo_ids": ["%s"]
+				}
+			}
+		}
+	`, suite.cargoIDs[0].String()))
+	route := fmt.Sprintf("/cargoes/%s/merge", suite.cargoIDs[0].String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *MergeCargoesAcceptanceTestSuite) TestMergeCargoes_FailIfSourceCargoNotExists() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"source_cargo_ids": ["%s"]
+				}
+			}
+		}
+	`, suite.common.ULIDProvider.New().String()))
+	route := fmt.Sprintf("/cargoes/%s/merge", suite.cargoIDs[0].String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *MergeCargoesAcceptanceTestSuite) TestMergeCargoes_FailIfShippersDiffer() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(suite.common.ULIDProvider.New().String()),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithShipper("Acme Exports", "shipping@acme.example", "1 Harbour Road, Rotterdam"),
+	).Build(suite.T())
+	suite.Require().NoError(suite.cargoModule.Repository.Save(suite.T().Context(), cargo))
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"source_cargo_ids": ["%s"]
+				}
+			}
+		}
+	`, cargo.ID().String()))
+	route := fmt.Sprintf("/cargoes/%s/merge", suite.cargoIDs[0].String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+
+	source, err := suite.cargoModule.Repository.Find(suite.T().Context(), cargo.ID())
+	suite.Require().NoError(err)
+	suite.Nil(source.Primitives().DeletedAt, "expected the source cargo to be kept")
+}
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	cargotrackingdomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain/tracking"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/utils"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type SearchCargoTrackingAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+	cargoID  cargodomain.CargoID
+	tracking cargotrackingdomain.TrackingPrimitives
+}
+
+func TestSearchCargoTracking(t *testing.T) {
+	suite.Run(t, new(SearchCargoTrackingAcceptanceTestSuite))
+}
+
+func (suite *SearchCargoTrackingAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *SearchCargoTrackingAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	suite.cargoID = cargodomain.CargoID(utils.NewULID().String())
+	createdAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+	pending, inTransit, delivered := "pending", "in_transit", "delivered"
+
+	// Saved out of chronological order on purpose, the timeline must be sorted anyway.
+	trackingItems := cargotrackingdomain.Tracking{
+		cargotrackingdomain.NewTrackingOnCargoStatusChanged(
+			cargotrackingdomain.TrackingID(utils.NewULID().String()), createdAt.Add(2*time.Minute), inTransit, delivered,
+		),
+		cargotrackingdomain.NewTrackingOnCargoCreated(
+			cargotrackingdomain.TrackingID(utils.NewULID().String()), pending, createdAt,
+		),
+		cargotrackingdomain.NewTrackingOnCargoStatusChanged(
+			cargotrackingdomain.TrackingID(utils.NewULID().String()), createdAt.Add(time.Minute), pending, inTransit,
+		),
+	}
+
+	opts := []cargotest.CargoMotherOpt{cargotest.WithID(suite.cargoID.String()), cargotest.WithVesselID(suite.vesselID.String())}
+	for _, item := range trackingItems {
+		opts = append(opts, cargotest.WithTracking(cargotrackingdomain.NewTrackingItemPrimitives(suite.cargoID.String(), item)))
+	}
+
+	cargo := cargotest.NewCargoMother(opts...).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+
+	primitives := cargo.Primitives().Tracking
+	suite.tracking = cargotrackingdomain.TrackingPrimitives{primitives[1], primitives[2], primitives[0]}
+}
+
+func (suite *SearchCargoTrackingAcceptanceTestSuite) TestSearchCargoTracking_SuccessInChronologicalOrder() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "tracking", "attributes": "<<PRESENCE>>"},
+				{"id": "%s", "type": "tracking", "attributes": "<<PRESENCE>>"}
+			],
+			"links": {
+				"self": "/cargoes/%s/tracking?page%%5Bsize%%5D=2",
+				"first": "/cargoes/%s/tracking?page%%5Bsize%%5D=2",
+				"next": "<<PRESENCE>>"
+			}
+		}
+	`, suite.tracking[0].ID, suite.tracking[1].ID, suite.cargoID, suite.cargoID))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes/"+suite.cargoID.String()+"/tracking?page[size]=2",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *SearchCargoTrackingAcceptanceTestSuite) TestSearchCargoTracking_SuccessFilteringByEntryTypeAndTime() {
+	from := url.QueryEscape(suite.tracking[2].CreatedAt.Format(time.RFC3339))
+	path := fmt.Sprintf(
+		"/cargoes/%s/tracking?filter[entry_type]=cargo.status_changed&filter[created_at][gte]=%s",
+		suite.cargoID,
+		from,
+	)
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "tracking", "attributes": "<<PRESENCE>>"}
+			],
+			"links": "<<PRESENCE>>"
+		}
+	`, suite.tracking[2].ID))
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *SearchCargoTrackingAcceptanceTestSuite) TestSearchCargoTracking_FailIfEntryTypeIsUnknown() {
+	path := "/cargoes/" + suite.cargoID.String() + "/tracking?filter[entry_type]=cargo.teleported"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchCargoTrackingAcceptanceTestSuite) TestSearchCargoTracking_FailIfCargoNotFound() {
+	path := "/cargoes/" + utils.NewULID().String() + "/tracking"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
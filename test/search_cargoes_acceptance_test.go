@@ -0,0 +1,329 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type SearchCargoesAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+	cargoes  []*cargodomain.Cargo
+}
+
+func TestSearchCargoes(t *testing.T) {
+	suite.Run(t, new(SearchCargoesAcceptanceTestSuite))
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	statuses := []cargodomain.Status{cargodomain.StatusPending, cargodomain.StatusInTransit, cargodomain.StatusPending}
+
+	suite.cargoes = make([]*cargodomain.Cargo, 0, len(statuses))
+	for i, status := range statuses {
+		at := now.Add(time.Duration(i-len(statuses)) * time.Hour)
+		opts := []cargotest.CargoMotherOpt{
+			cargotest.WithID(suite.common.ULIDProvider.New().String()),
+			cargotest.WithVesselID(suite.vesselID.String()),
+			cargotest.WithStatus(status.String()),
+			cargotest.WithTimestamps(at, at),
+		}
+		if i == 1 {
+			opts = append(opts, cargotest.WithShipper("Acme Exports", "shipping@acme.example", "1 Harbour Road, Rotterdam"))
+		}
+
+		cargo := cargotest.NewCargoMother(opts...).Build(suite.T())
+
+		saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+		suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+		suite.cargoes = append(suite.cargoes, cargo)
+	}
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_SuccessPaginatingByCursor() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "cargo", "attributes": "<<PRESENCE>>"},
+				{"id": "%s", "type": "cargo", "attributes": "<<PRESENCE>>"}
+			],
+			"links": {
+				"self": "/cargoes?page%%5Bsize%%5D=2",
+				"first": "/cargoes?page%%5Bsize%%5D=2",
+				"next": "<<PRESENCE>>"
+			}
+		}
+	`, suite.cargoes[0].ID().String(), suite.cargoes[1].ID().String()))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?page[size]=2",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+
+	var firstPage struct {
+		Links map[string]string `json:"links"`
+	}
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &firstPage))
+
+	body = []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "cargo", "attributes": "<<PRESENCE>>"}
+			],
+			"links": {
+				"self": "%s",
+				"first": "/cargoes?page%%5Bsize%%5D=2"
+			}
+		}
+	`, suite.cargoes[2].ID().String(), firstPage.Links["next"]))
+	response = testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		firstPage.Links["next"],
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_SuccessFilteringAndSorting() {
+	params := []any{
+		suite.cargoes[2].ID().String(),
+		suite.vesselID.String(),
+		suite.cargoes[2].Primitives().CreatedAt.Format(time.RFC3339),
+		suite.cargoes[2].Primitives().UpdatedAt.Format(time.RFC3339),
+		suite.cargoes[0].ID().String(),
+	}
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{
+					"id": "%s",
+					"type": "cargo",
+					"attributes": {
+						"vessel_id": "%s",
+						"weight": 3500,
+						"weight_unit": "g",
+						"volume": 0,
+						"chargeable_weight": 3500,
+						"status": "pending",
+						"items": [
+							{"name": "Electronics", "weight": 1500},
+							{"name": "Clothing", "weight": 2000}
+						],
+						"created_at": "%s",
+						"updated_at": "%s"
+					}
+				},
+				{"id": "%s", "type": "cargo", "attributes": "<<PRESENCE>>"}
+			],
+			"links": "<<PRESENCE>>"
+		}
+	`, params...))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?filter[status]=pending&filter[vessel_id]="+suite.vesselID.String()+"&sort=-created_at",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_SuccessFilteringByCreatedAtRange() {
+	from := suite.cargoes[1].Primitives().CreatedAt.Format(time.RFC3339)
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{"id": "%s", "type": "cargo", "attributes": "<<PRESENCE>>"}
+			],
+			"links": "<<PRESENCE>>"
+		}
+	`, suite.cargoes[1].ID().String()))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?filter[created_at][gte]="+from+"&filter[created_at][lte]="+from,
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_SuccessFilteringByShipper() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{
+					"id": "%s",
+					"type": "cargo",
+					"attributes": {
+						"vessel_id": "<<PRESENCE>>",
+						"weight": 3500,
+						"weight_unit": "g",
+						"volume": 0,
+						"chargeable_weight": 3500,
+						"status": "in_transit",
+						"items": "<<PRESENCE>>",
+						"shipper": {
+							"name": "Acme Exports",
+							"email": "shipping@acme.example",
+							"address": "1 Harbour Road, Rotterdam"
+						},
+						"created_at": "<<PRESENCE>>",
+						"updated_at": "<<PRESENCE>>"
+					}
+				}
+			],
+			"links": "<<PRESENCE>>"
+		}
+	`, suite.cargoes[1].ID().String()))
+
+	for _, shipper := range []string{"ACME%20Exports", "Shipping@Acme.example"} {
+		response := testutils.ExecuteJSONRequest(
+			suite.T(),
+			suite.common.Router,
+			http.MethodGet,
+			"/cargoes?filter[shipper]="+shipper,
+			nil,
+		)
+		testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+	}
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_SuccessWithUnits() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": [
+				{
+					"id": "%s",
+					"type": "cargo",
+					"attributes": {
+						"vessel_id": "<<PRESENCE>>",
+						"weight": 3.5,
+						"weight_unit": "kg",
+						"volume": 0,
+						"chargeable_weight": 3.5,
+						"status": "in_transit",
+						"items": "<<PRESENCE>>",
+						"shipper": "<<PRESENCE>>",
+						"created_at": "<<PRESENCE>>",
+						"updated_at": "<<PRESENCE>>"
+					}
+				}
+			],
+			"links": "<<PRESENCE>>"
+		}
+	`, suite.cargoes[1].ID().String()))
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?filter[status]=in_transit&units=kg",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusOK, string(body), response)
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_FailIfUnitsAreInvalid() {
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?units=stone",
+		nil,
+	)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_FailIfInvalidCriteriaIsProvided() {
+	body := []byte(`
+		{
+		  "errors" : [ {
+			"id" : "<<PRESENCE>>",
+			"title" : "Bad Request",
+			"detail" : "invalid cargo search criteria provided",
+			"status" : "400",
+			"code" : "bad_request"
+		  } ]
+		}
+	`)
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?sort=-weight",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusBadRequest, string(body), response)
+}
+
+func (suite *SearchCargoesAcceptanceTestSuite) TestSearchCargoes_FailIfInvalidDateFilterIsProvided() {
+	body := []byte(`
+		{
+		  "errors" : [ {
+			"id" : "<<PRESENCE>>",
+			"title" : "Bad Request",
+			"detail" : "invalid created at from filter provided, RFC3339 expected",
+			"status" : "400",
+			"code" : "bad_request"
+		  } ]
+		}
+	`)
+	response := testutils.ExecuteJSONRequest(
+		suite.T(),
+		suite.common.Router,
+		http.MethodGet,
+		"/cargoes?filter[created_at][gte]=yesterday",
+		nil,
+	)
+	testutils.CheckResponse(suite.T(), http.StatusBadRequest, string(body), response)
+}
@@ -0,0 +1,179 @@
+package test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/google/jsonapi"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	portcommands "github.com/soulcodex/deus-cargo-tracker/internal/port/application/commands"
+	portdomain "github.com/soulcodex/deus-cargo-tracker/internal/port/domain"
+	portentrypoint "github.com/soulcodex/deus-cargo-tracker/internal/port/infrastructure/entrypoint"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/bus"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+)
+
+type SearchPortsAcceptanceTestSuite struct {
+	suite.Suite
+
+	common     *di.CommonServices
+	portModule *di.PortModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+}
+
+func TestSearchPorts(t *testing.T) {
+	suite.Run(t, new(SearchPortsAcceptanceTestSuite))
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.portModule = di.NewPortModule(suite.T().Context(), suite.common)
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	err := bus.DispatchBlocking(suite.common.CommandBus, suite.common.Mutex)(
+		suite.T().Context(),
+		&portcommands.ImportPortsCommand{},
+	)
+	suite.Require().NoError(err, "failed to import ports for suite setup")
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestFetchPortByLOCODE_Success() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/ports/nlrtm", nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	port := new(portentrypoint.FetchPortByLOCODEResponse)
+	suite.Require().NoError(jsonapi.UnmarshalPayload(response.Body, port))
+	suite.Equal("NLRTM", port.LOCODE)
+	suite.Equal("Rotterdam", port.Name)
+	suite.Equal("NL", port.Country)
+	suite.InDelta(51.9167, port.Latitude, 0.0001)
+	suite.InDelta(4.5, port.Longitude, 0.0001)
+	suite.Equal("Europe/Amsterdam", port.TimeZone)
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestImportPorts_SuccessDeletingPortsNoLongerInRegistry() {
+	port, err := portdomain.NewPort(portdomain.PortInput{
+		LOCODE:    "NLXXX",
+		Name:      "Withdrawn",
+		Latitude:  52.3,
+		Longitude: 4.9,
+		TimeZone:  "Europe/Amsterdam",
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.portModule.Repository.ReplaceAll(suite.T().Context(), port))
+
+	err = bus.DispatchBlocking(suite.common.CommandBus, suite.common.Mutex)(
+		suite.T().Context(),
+		&portcommands.ImportPortsCommand{},
+	)
+	suite.Require().NoError(err)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/ports/nlxxx", nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/ports/nlrtm", nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestFetchPortByLOCODE_FailIfPortNotFound() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/ports/NLXXX", nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestFetchPortByLOCODE_FailIfLOCODEIsInvalid() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/ports/NL-RTM", nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestSearchPorts_SuccessByName() {
+	response := suite.search(url.Values{"filter[search]": {"rotter"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	ports := suite.parseResponseBody(response.Body)
+	suite.Require().Len(ports, 1)
+	suite.Equal("NLRTM", ports[0].LOCODE)
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestSearchPorts_SuccessByLOCODE() {
+	response := suite.search(url.Values{"filter[search]": {"uslgb"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	ports := suite.parseResponseBody(response.Body)
+	suite.Require().Len(ports, 1)
+	suite.Equal("Long Beach", ports[0].Name)
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestSearchPorts_SuccessPaginatedByCountry() {
+	response := suite.search(url.Values{"filter[country]": {"us"}, "page[size]": {"4"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	var firstPage struct {
+		Links map[string]string `json:"links"`
+	}
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &firstPage))
+	suite.Require().Contains(firstPage.Links, "next")
+
+	ports := suite.parseResponseBody(response.Body)
+	suite.Equal([]string{"USHOU", "USLAX", "USLGB", "USNYC"}, locodes(ports))
+
+	next := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, firstPage.Links["next"], nil)
+	suite.Equal(http.StatusOK, next.Code, "Expected status code 200 OK")
+	suite.Equal([]string{"USOAK", "USSAV", "USSEA"}, locodes(suite.parseResponseBody(next.Body)))
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) TestSearchPorts_FailIfCountryIsInvalid() {
+	response := suite.search(url.Values{"filter[country]": {"USA"}})
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) search(values url.Values) *httptest.ResponseRecorder {
+	suite.T().Helper()
+
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/ports?"+values.Encode(), nil)
+}
+
+func (suite *SearchPortsAcceptanceTestSuite) parseResponseBody(body io.Reader) []*portentrypoint.FetchPortByLOCODEResponse {
+	suite.T().Helper()
+
+	items, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(portentrypoint.FetchPortByLOCODEResponse)))
+	suite.Require().NoError(err, "failed to unmarshal ports response")
+
+	ports := make([]*portentrypoint.FetchPortByLOCODEResponse, len(items))
+	for i, item := range items {
+		ports[i] = item.(*portentrypoint.FetchPortByLOCODEResponse)
+	}
+
+	return ports
+}
+
+func locodes(ports []*portentrypoint.FetchPortByLOCODEResponse) []string {
+	values := make([]string, len(ports))
+	for i, port := range ports {
+		values[i] = port.LOCODE
+	}
+
+	return values
+}
@@ -0,0 +1,162 @@
+package test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/jsonapi"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	vesselentrypoint "github.com/soulcodex/deus-cargo-tracker/internal/vessel/infrastructure/entrypoint"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type SearchVesselsAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	antwerpVesselID   string
+	rotterdamVesselID string
+	hamburgVesselID   string
+	suvaVesselID      string
+	fijiVesselID      string
+}
+
+func TestSearchVessels(t *testing.T) {
+	suite.Run(t, new(SearchVesselsAcceptanceTestSuite))
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+
+	suite.antwerpVesselID = suite.common.ULIDProvider.New().String()
+	suite.rotterdamVesselID = suite.common.ULIDProvider.New().String()
+	suite.hamburgVesselID = suite.common.ULIDProvider.New().String()
+	suite.suvaVesselID = suite.common.ULIDProvider.New().String()
+	suite.fijiVesselID = suite.common.ULIDProvider.New().String()
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vessels := []vesseltest.VesselMotherOpt{
+		vesseltest.WithLocation(51.2194, 4.4025),
+		vesseltest.WithLocation(51.9225, 4.47917),
+		vesseltest.WithLocation(53.5511, 9.9937),
+		vesseltest.WithLocation(-18.1416, 178.4419),
+		vesseltest.WithLocation(-17.5, -179.9),
+	}
+	ids := []string{
+		suite.antwerpVesselID,
+		suite.rotterdamVesselID,
+		suite.hamburgVesselID,
+		suite.suvaVesselID,
+		suite.fijiVesselID,
+	}
+
+	for i, location := range vessels {
+		vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(ids[i]), location).Build(suite.T())
+		err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+		suite.Require().NoError(err, "failed to save vessel for suite setup")
+	}
+
+	deleted := vesseltest.NewVesselMother(
+		vesseltest.WithVesselID(suite.common.ULIDProvider.New().String()),
+		vesseltest.WithLocation(51.95, 4.1),
+		vesseltest.WithSoftDeletion(time.Now()),
+	).Build(suite.T())
+	suite.Require().NoError(suite.vesselModule.Repository.Save(suite.T().Context(), deleted))
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) TestSearchVessels_SuccessNear() {
+	// Within 50 nautical miles (92.6 km) of Rotterdam.
+	response := suite.search(url.Values{"filter[near]": {"51.9225,4.47917,92.6"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	vessels := suite.parseResponseBody(response.Body)
+	suite.Require().Len(vessels, 2)
+	suite.Equal(suite.rotterdamVesselID, vessels[0].ID, "closest vessel must come first")
+	suite.InDelta(0, vessels[0].DistanceKm, 0.001)
+	suite.Equal(suite.antwerpVesselID, vessels[1].ID)
+	suite.InDelta(78.3, vessels[1].DistanceKm, 0.5)
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) TestSearchVessels_SuccessNearAcrossAntimeridian() {
+	response := suite.search(url.Values{"filter[near]": {"-18.1416,178.4419,500"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	vessels := suite.parseResponseBody(response.Body)
+	suite.Require().Len(vessels, 2)
+	suite.Equal(suite.suvaVesselID, vessels[0].ID)
+	suite.Equal(suite.fijiVesselID, vessels[1].ID)
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) TestSearchVessels_SuccessBoundingBox() {
+	response := suite.search(url.Values{"filter[bbox]": {"50,2,54,11"}, "page[size]": {"2"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	vessels := suite.parseResponseBody(response.Body)
+	suite.Require().Len(vessels, 2, "page size must limit the results")
+	suite.Equal(suite.rotterdamVesselID, vessels[0].ID, "vessel closest to the box center must come first")
+	suite.Equal(suite.antwerpVesselID, vessels[1].ID)
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) TestSearchVessels_SuccessBoundingBoxAcrossAntimeridian() {
+	response := suite.search(url.Values{"filter[bbox]": {"-20,178,-17,-179"}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	suite.Len(suite.parseResponseBody(response.Body), 2)
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) TestSearchVessels_FailIfNoFilterIsProvided() {
+	response := suite.search(url.Values{})
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) TestSearchVessels_FailIfNearFilterIsInvalid() {
+	response := suite.search(url.Values{"filter[near]": {"51.9225,4.47917"}})
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) search(values url.Values) *httptest.ResponseRecorder {
+	suite.T().Helper()
+
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/vessels?"+values.Encode(), nil)
+}
+
+func (suite *SearchVesselsAcceptanceTestSuite) parseResponseBody(body io.Reader) []*vesselentrypoint.SearchVesselResponse {
+	suite.T().Helper()
+
+	items, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(vesselentrypoint.SearchVesselResponse)))
+	suite.Require().NoError(err, "failed to unmarshal vessels response")
+
+	vessels := make([]*vesselentrypoint.SearchVesselResponse, len(items))
+	for i, item := range items {
+		vessels[i] = item.(*vesselentrypoint.SearchVesselResponse)
+	}
+
+	return vessels
+}
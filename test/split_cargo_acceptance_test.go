@@ -0,0 +1,136 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type SplitCargoAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+	cargoID  cargodomain.CargoID
+}
+
+func TestSplitCargo(t *testing.T) {
+	suite.Run(t, new(SplitCargoAcceptanceTestSuite))
+}
+
+func (suite *SplitCargoAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *SplitCargoAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	cargo := cargotest.NewCargoMother(cargotest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+	suite.cargoID = cargo.ID()
+}
+
+func (suite *SplitCargoAcceptanceTestSuite) TestSplitCargo_Success() {
+	splitID := suite.common.ULIDProvider.New().String()
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"split_cargo_id": "%s",
+					"items": ["Clothing"]
+				}
+			}
+		}
+	`, splitID))
+	route := fmt.Sprintf("/cargoes/%s/split", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1500), cargo.Primitives().Weight)
+	suite.Require().Len(cargo.Primitives().Tracking, 1)
+	suite.Equal("cargo.split_from", cargo.Primitives().Tracking[0].EntryType)
+
+	split, err := suite.cargoModule.Repository.Find(suite.T().Context(), cargodomain.CargoID(splitID), cargodomain.WithTracking())
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2000), split.Primitives().Weight)
+	suite.Equal(suite.vesselID, split.VesselID())
+	suite.Len(split.Primitives().Tracking, 2)
+}
+
+func (suite *SplitCargoAcceptanceTestSuite) TestSplitCargo_FailIfEveryItemIsSplit() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"split_cargo_id": "%s",
+					"items": ["Electronics", "Clothing"]
+				}
+			}
+		}
+	`, suite.common.ULIDProvider.New().String()))
+	route := fmt.Sprintf("/cargoes/%s/split", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SplitCargoAcceptanceTestSuite) TestSplitCargo_FailIfItemNotExists() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"split_cargo_id": "%s",
+					"items": ["Furniture"]
+				}
+			}
+		}
+	`, suite.common.ULIDProvider.New().String()))
+	route := fmt.Sprintf("/cargoes/%s/split", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
@@ -0,0 +1,219 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type UpdateCargoItemsAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+	cargoID  cargodomain.CargoID
+}
+
+func TestUpdateCargoItems(t *testing.T) {
+	suite.Run(t, new(UpdateCargoItemsAcceptanceTestSuite))
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	cargo := cargotest.NewCargoMother(cargotest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+	suite.cargoID = cargo.ID()
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_SuccessAddingItems() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "add",
+					"items": [{"name": "Furniture", "weight": 2500}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err)
+
+	primitives := cargo.Primitives()
+	suite.Len(primitives.Items, 3)
+	suite.Equal(uint64(6000), primitives.Weight)
+
+	suite.Require().Len(primitives.Tracking, 1)
+	suite.Equal("cargo.items_changed", primitives.Tracking[0].EntryType)
+	suite.InDelta(3500, primitives.Tracking[0].Details["weight_before"], 0)
+	suite.InDelta(6000, primitives.Tracking[0].Details["weight_after"], 0)
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_SuccessAddingItemsWithWeightUnit() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "add",
+					"items": [{"name": "Furniture", "weight": {"value": 2.5, "unit": "kg"}}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(6000), cargo.Primitives().Weight, "weights must be normalised to grams")
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_FailIfWeightUnitIsUnknown() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "add",
+					"items": [{"name": "Furniture", "weight": {"value": 1, "unit": "stone"}}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_SuccessRemovingItems() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "remove",
+					"items": [{"name": "Clothing"}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1500), cargo.Primitives().Weight)
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_FailIfItemNotFound() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "remove",
+					"items": [{"name": "Furniture"}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_FailIfResultingItemsAreInvalid() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "replace",
+					"items": [{"name": "Feather", "weight": 1}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *UpdateCargoItemsAcceptanceTestSuite) TestUpdateCargoItems_FailIfCargoIsNotPending() {
+	cargoID := suite.common.ULIDProvider.New().String()
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(cargoID),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for test setup")
+
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"operation": "add",
+					"items": [{"name": "Furniture", "weight": 2500}]
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/items", cargoID)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
@@ -0,0 +1,140 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type UpdateCargoMetadataAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID vesseldomain.VesselID
+	cargoID  cargodomain.CargoID
+}
+
+func TestUpdateCargoMetadata(t *testing.T) {
+	suite.Run(t, new(UpdateCargoMetadataAcceptanceTestSuite))
+}
+
+func (suite *UpdateCargoMetadataAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *UpdateCargoMetadataAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vesselID := vesseltest.WithVesselID(suite.vesselID.String())
+	vessel := vesseltest.NewVesselMother(vesselID).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithMetadata(map[string]string{"campaign": "summer", "po_number": "PO-1"}),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+	suite.cargoID = cargo.ID()
+}
+
+func (suite *UpdateCargoMetadataAcceptanceTestSuite) TestUpdateCargoMetadata_Success() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"metadata": {
+						"po_number": "PO-2",
+						"campaign": null,
+						"region": "emea"
+					}
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/metadata", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err)
+
+	suite.Equal(map[string]string{"po_number": "PO-2", "region": "emea"}, cargo.Primitives().Metadata)
+
+	tracking := cargo.Primitives().Tracking
+	suite.Require().Len(tracking, 1)
+	suite.Equal("cargo.metadata_changed", tracking[0].EntryType)
+}
+
+func (suite *UpdateCargoMetadataAcceptanceTestSuite) TestUpdateCargoMetadata_FailIfInvalidMetadataProvided() {
+	for _, metadata := range []string{`{"po_number": 42}`, `{"PO Number": "PO-2"}`, `{}`} {
+		body := []byte(fmt.Sprintf(`
+			{
+				"data": {
+					"type": "cargo",
+					"attributes": {
+						"metadata": %s
+					}
+				}
+			}
+		`, metadata))
+		route := fmt.Sprintf("/cargoes/%s/metadata", suite.cargoID.String())
+		response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+		suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+	}
+}
+
+func (suite *UpdateCargoMetadataAcceptanceTestSuite) TestUpdateCargoMetadata_FailIfCargoNotExists() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"metadata": {
+						"po_number": "PO-2"
+					}
+				}
+			}
+		}
+	`)
+	route := fmt.Sprintf("/cargoes/%s/metadata", suite.common.ULIDProvider.New().String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
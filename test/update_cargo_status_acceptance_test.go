@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
 	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
 	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
 	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
 	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
 	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
@@ -87,6 +89,98 @@ func (suite *UpdateCargoStatusAcceptanceTestSuite) TestUpdateCargoStatus_Success
 	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 200 OK")
 }
 
+func (suite *UpdateCargoStatusAcceptanceTestSuite) TestUpdateCargoStatus_SuccessHonouringIfMatch() {
+	cargoRoute := "/cargoes/" + suite.cargoID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, cargoRoute, nil)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	etag := response.Header().Get(httpserver.HeaderETag)
+	suite.Equal(httpserver.VersionETag(1), etag)
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"new_status": "%s"
+				}
+			}
+		}
+	`, cargodomain.StatusInTransit))
+	route := fmt.Sprintf("/cargoes/%s/update-status", suite.cargoID.String())
+	headers := map[string]string{httpserver.HeaderIfMatch: etag}
+	response = testutils.ExecuteJSONRequestWithHeaders(suite.T(), suite.common.Router, http.MethodPatch, route, body, headers)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, cargoRoute, nil)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	suite.Equal(httpserver.VersionETag(2), response.Header().Get(httpserver.HeaderETag))
+}
+
+func (suite *UpdateCargoStatusAcceptanceTestSuite) TestUpdateCargoStatus_FailIfVersionDoesNotMatchIfMatch() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"new_status": "%s"
+				}
+			}
+		}
+	`, cargodomain.StatusInTransit))
+	route := fmt.Sprintf("/cargoes/%s/update-status", suite.cargoID.String())
+	headers := map[string]string{httpserver.HeaderIfMatch: httpserver.VersionETag(7)}
+	response := testutils.ExecuteJSONRequestWithHeaders(suite.T(), suite.common.Router, http.MethodPatch, route, body, headers)
+	suite.Equal(http.StatusPreconditionFailed, response.Code, "Expected status code 412 Precondition Failed")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID)
+	suite.Require().NoError(err)
+	suite.Equal(cargodomain.StatusPending.String(), cargo.Primitives().Status)
+	suite.Equal(uint64(1), cargo.Version())
+}
+
+func (suite *UpdateCargoStatusAcceptanceTestSuite) TestUpdateCargoStatus_FailSavingStaleCargo() {
+	ctx := suite.T().Context()
+	current, err := suite.cargoModule.Repository.Find(ctx, suite.cargoID)
+	suite.Require().NoError(err)
+	stale, err := suite.cargoModule.Repository.Find(ctx, suite.cargoID)
+	suite.Require().NoError(err)
+
+	for _, cargo := range []*cargodomain.Cargo{current, stale} {
+		trackingID := suite.common.ULIDProvider.New().String()
+		suite.Require().NoError(cargo.Update(ctx, cargodomain.WithStatus(cargodomain.DefaultStatusTransitions(), trackingID, "in_transit", time.Now())))
+	}
+
+	suite.Require().NoError(suite.cargoModule.Repository.Save(ctx, current))
+	err = suite.cargoModule.Repository.Save(ctx, stale)
+	suite.True(cargodomain.IsCargoVersionConflictError(err), "expected a version conflict saving a stale cargo")
+}
+
+func (suite *UpdateCargoStatusAcceptanceTestSuite) TestUpdateCargoStatus_SuccessTrackingVesselPosition() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"new_status": "%s"
+				}
+			}
+		}
+	`, cargodomain.StatusLoaded))
+	route := fmt.Sprintf("/cargoes/%s/update-status", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err)
+
+	tracking := cargo.Primitives().Tracking
+	suite.Require().Len(tracking, 1)
+	suite.Require().NotNil(tracking[0].VesselLatitude)
+	suite.Require().NotNil(tracking[0].VesselLongitude)
+	suite.InDelta(37.7749, *tracking[0].VesselLatitude, 0.0001)
+	suite.InDelta(-122.4194, *tracking[0].VesselLongitude, 0.0001)
+}
+
 func (suite *UpdateCargoStatusAcceptanceTestSuite) TestUpdateCargoStatus_SuccessWithSameStatus() {
 	body := []byte(fmt.Sprintf(`
 		{
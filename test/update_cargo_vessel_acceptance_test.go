@@ -0,0 +1,174 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type UpdateCargoVesselAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger     *testarrangers.PostgresSQLArranger
+	rabbitArranger *testarrangers.RabbitMQArranger
+
+	vesselID    vesseldomain.VesselID
+	newVesselID vesseldomain.VesselID
+	cargoID     cargodomain.CargoID
+}
+
+func TestUpdateCargoVessel(t *testing.T) {
+	suite.Run(t, new(UpdateCargoVesselAcceptanceTestSuite))
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+	suite.newVesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+
+	topic := suite.common.Config.RabbitMQTopic
+	suite.rabbitArranger = testarrangers.NewRabbitMQArranger(topic, suite.common.RabbitMQConnection)
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.rabbitArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	for _, id := range []vesseldomain.VesselID{suite.vesselID, suite.newVesselID} {
+		vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(id.String())).Build(suite.T())
+		err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+		suite.Require().NoError(err, "failed to save vessel for suite setup")
+	}
+
+	cargo := cargotest.NewCargoMother(cargotest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for suite setup")
+	suite.cargoID = cargo.ID()
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) TestUpdateCargoVessel_Success() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s"
+				}
+			}
+		}
+	`, suite.newVesselID.String()))
+	route := fmt.Sprintf("/cargoes/%s/vessel", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	cargo, err := suite.cargoModule.Repository.Find(suite.T().Context(), suite.cargoID, cargodomain.WithTracking())
+	suite.Require().NoError(err)
+	suite.Equal(suite.newVesselID.String(), cargo.VesselID().String())
+
+	tracking := cargo.Primitives().Tracking
+	suite.Require().Len(tracking, 1)
+	suite.Equal("cargo.vessel_changed", tracking[0].EntryType)
+	suite.Equal(suite.vesselID.String(), tracking[0].Details["vessel_before"])
+	suite.Equal(suite.newVesselID.String(), tracking[0].Details["vessel_after"])
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) TestUpdateCargoVessel_SuccessWithSameVessel() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s"
+				}
+			}
+		}
+	`, suite.vesselID.String()))
+	route := fmt.Sprintf("/cargoes/%s/vessel", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) TestUpdateCargoVessel_FailIfCargoIsNotPending() {
+	cargoID := suite.common.ULIDProvider.New().String()
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithID(cargoID),
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithStatus(cargodomain.StatusInTransit.String()),
+	).Build(suite.T())
+	saveCargoErr := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(saveCargoErr, "failed to save cargo for test setup")
+
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s"
+				}
+			}
+		}
+	`, suite.newVesselID.String()))
+	route := fmt.Sprintf("/cargoes/%s/vessel", cargoID)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) TestUpdateCargoVessel_FailIfVesselNotFound() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s"
+				}
+			}
+		}
+	`, suite.common.ULIDProvider.New().String()))
+	route := fmt.Sprintf("/cargoes/%s/vessel", suite.cargoID.String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *UpdateCargoVesselAcceptanceTestSuite) TestUpdateCargoVessel_FailIfCargoNotExists() {
+	body := []byte(fmt.Sprintf(`
+		{
+			"data": {
+				"type": "cargo",
+				"attributes": {
+					"vessel_id": "%s"
+				}
+			}
+		}
+	`, suite.newVesselID.String()))
+	route := fmt.Sprintf("/cargoes/%s/vessel", suite.common.ULIDProvider.New().String())
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
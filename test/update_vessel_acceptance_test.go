@@ -0,0 +1,146 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/deus-cargo-tracker/cmd/di"
+	cargodomain "github.com/soulcodex/deus-cargo-tracker/internal/cargo/domain"
+	vesseldomain "github.com/soulcodex/deus-cargo-tracker/internal/vessel/domain"
+	httpserver "github.com/soulcodex/deus-cargo-tracker/pkg/http-server"
+	"github.com/soulcodex/deus-cargo-tracker/pkg/sqldb/postgres"
+	testarrangers "github.com/soulcodex/deus-cargo-tracker/test/arrangers"
+	cargotest "github.com/soulcodex/deus-cargo-tracker/test/cargo"
+	testutils "github.com/soulcodex/deus-cargo-tracker/test/utils"
+	vesseltest "github.com/soulcodex/deus-cargo-tracker/test/vessel"
+)
+
+type UpdateVesselAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	vesselModule *di.VesselModule
+	cargoModule  *di.CargoModule
+
+	dbArranger *testarrangers.PostgresSQLArranger
+
+	vesselID vesseldomain.VesselID
+}
+
+func TestUpdateVessel(t *testing.T) {
+	suite.Run(t, new(UpdateVesselAcceptanceTestSuite))
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.vesselModule = di.NewVesselModule(suite.T().Context(), suite.common)
+	suite.cargoModule = di.NewCargoModule(suite.T().Context(), suite.common)
+	suite.vesselID = vesseldomain.VesselID(suite.common.ULIDProvider.New().String())
+
+	dbPool, match := suite.common.DBPool.(*postgres.ConnectionPool)
+	suite.Require().True(match, "expected *postgres.ConnectionPool, got different type")
+
+	suite.dbArranger = testarrangers.NewPostgresSQLArranger(suite.common.Config.PostgresSchema, dbPool)
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) SetupTest() {
+	suite.dbArranger.MustArrange(suite.T().Context())
+	suite.common.RedisClient.FlushAll(suite.T().Context())
+
+	vessel := vesseltest.NewVesselMother(vesseltest.WithVesselID(suite.vesselID.String())).Build(suite.T())
+	err := suite.vesselModule.Repository.Save(suite.T().Context(), vessel)
+	suite.Require().NoError(err, "failed to save vessel for suite setup")
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) TestUpdateVessel_Success() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "vessel",
+				"attributes": {
+					"name": "Falcon Heavy",
+					"capacity": {"value": 8, "unit": "t"}
+				}
+			}
+		}
+	`)
+	route := "/vessels/" + suite.vesselID.String()
+	headers := map[string]string{httpserver.HeaderIfMatch: httpserver.VersionETag(1)}
+	response := testutils.ExecuteJSONRequestWithHeaders(suite.T(), suite.common.Router, http.MethodPatch, route, body, headers)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	vessel, err := suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.Require().NoError(err)
+
+	primitives := vessel.Primitives()
+	suite.Equal("Falcon Heavy", primitives.Name)
+	suite.Equal(uint64(8000), primitives.Capacity)
+	suite.InDelta(37.7749, primitives.Latitude, 0.0001)
+	suite.InDelta(-122.4194, primitives.Longitude, 0.0001)
+	suite.Equal(uint64(2), vessel.Version())
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) TestUpdateVessel_FailIfCapacityIsBelowCargoWeight() {
+	cargo := cargotest.NewCargoMother(
+		cargotest.WithVesselID(suite.vesselID.String()),
+		cargotest.WithItems(
+			cargodomain.ItemsPrimitives{Name: "Engine", Weight: 10000},
+			cargodomain.ItemsPrimitives{Name: "Gearbox", Weight: 5000},
+		),
+	).Build(suite.T())
+	err := suite.cargoModule.Repository.Save(suite.T().Context(), cargo)
+	suite.Require().NoError(err, "failed to save cargo for test setup")
+
+	body := []byte(`{"data": {"type": "vessel", "attributes": {"capacity": {"value": 14, "unit": "kg"}}}}`)
+	route := "/vessels/" + suite.vesselID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+
+	vessel, err := suite.vesselModule.Repository.Find(suite.T().Context(), suite.vesselID)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(5000), vessel.Primitives().Capacity, "capacity should not be lowered")
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) TestUpdateVessel_FailIfOnlyOneCoordinateProvided() {
+	body := []byte(`
+		{
+			"data": {
+				"type": "vessel",
+				"attributes": {
+					"latitude": 40.4168
+				}
+			}
+		}
+	`)
+	route := "/vessels/" + suite.vesselID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) TestUpdateVessel_FailIfNoChangesProvided() {
+	body := []byte(`{"data": {"type": "vessel", "attributes": {}}}`)
+	route := "/vessels/" + suite.vesselID.String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) TestUpdateVessel_FailIfVersionDoesNotMatchIfMatch() {
+	body := []byte(`{"data": {"type": "vessel", "attributes": {"name": "Falcon Heavy"}}}`)
+	route := "/vessels/" + suite.vesselID.String()
+	headers := map[string]string{httpserver.HeaderIfMatch: httpserver.VersionETag(7)}
+	response := testutils.ExecuteJSONRequestWithHeaders(suite.T(), suite.common.Router, http.MethodPatch, route, body, headers)
+	suite.Equal(http.StatusPreconditionFailed, response.Code, "Expected status code 412 Precondition Failed")
+}
+
+func (suite *UpdateVesselAcceptanceTestSuite) TestUpdateVessel_FailIfVesselNotFound() {
+	body := []byte(`{"data": {"type": "vessel", "attributes": {"name": "Falcon Heavy"}}}`)
+	route := "/vessels/" + suite.common.ULIDProvider.New().String()
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPatch, route, body)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
@@ -19,11 +19,25 @@ func ExecuteJSONRequest(
 	verb,
 	path string,
 	body []byte,
+) *httptest.ResponseRecorder {
+	return ExecuteJSONRequestWithHeaders(t, router, verb, path, body, EmptyHTTPHeaders())
+}
+
+func ExecuteJSONRequestWithHeaders(
+	t *testing.T,
+	router *httpserver.Router,
+	verb,
+	path string,
+	body []byte,
+	headers map[string]string,
 ) *httptest.ResponseRecorder {
 	req, err := http.NewRequestWithContext(t.Context(), verb, path, bytes.NewBuffer(body))
 	require.NoError(t, err)
 
 	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
 
 	httpRecorder := httptest.NewRecorder()
 	router.GetMuxRouter().ServeHTTP(httpRecorder, req)
@@ -15,6 +15,25 @@ func WithVesselID(id string) VesselMotherOpt {
 	}
 }
 
+func WithCapacity(capacityInKilograms uint64) VesselMotherOpt {
+	return func(m *VesselMother) {
+		m.primitives.Capacity = capacityInKilograms
+	}
+}
+
+func WithLocation(latitude, longitude float64) VesselMotherOpt {
+	return func(m *VesselMother) {
+		m.primitives.Latitude = latitude
+		m.primitives.Longitude = longitude
+	}
+}
+
+func WithMMSI(mmsi string) VesselMotherOpt {
+	return func(m *VesselMother) {
+		m.primitives.MMSI = &mmsi
+	}
+}
+
 func WithSoftDeletion(at time.Time) VesselMotherOpt {
 	return func(m *VesselMother) {
 		m.primitives.DeletedAt = &at